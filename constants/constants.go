@@ -19,6 +19,9 @@ const (
 	PUBLIC_POWERAPPS_SCOPE          = "https://service.powerapps.com/.default"
 	PUBLIC_POWERPLATFORM_API_DOMAIN = "api.powerplatform.com"
 	PUBLIC_POWERPLATFORM_API_SCOPE  = "https://api.powerplatform.com/.default"
+	PUBLIC_POWERAPPS_ADVISOR_DOMAIN = "api.advisor.powerapps.com"
+	PUBLIC_POWERAPPS_ADVISOR_SCOPE  = "https://api.advisor.powerapps.com/.default"
+	PUBLIC_LICENSING_API_DOMAIN     = "licensing.powerplatform.microsoft.com"
 )
 
 const (
@@ -28,6 +31,9 @@ const (
 	USDOD_POWERAPPS_SCOPE          = "https://service.apps.appsplatform.us/.default"
 	USDOD_POWERPLATFORM_API_DOMAIN = "api.appsplatform.us"
 	USDOD_POWERPLATFORM_API_SCOPE  = "https://api.appsplatform.us/.default"
+	USDOD_POWERAPPS_ADVISOR_DOMAIN = "api.advisor.appsplatform.us"
+	USDOD_POWERAPPS_ADVISOR_SCOPE  = "https://api.advisor.appsplatform.us/.default"
+	USDOD_LICENSING_API_DOMAIN     = "licensing.appsplatform.us"
 )
 
 const (
@@ -37,6 +43,9 @@ const (
 	USGOV_POWERAPPS_SCOPE          = "https://service.powerapps.us/.default"
 	USGOV_POWERPLATFORM_API_DOMAIN = "api.gov.powerplatform.microsoft.us"
 	USGOV_POWERPLATFORM_API_SCOPE  = "https://api.gov.powerplatform.microsoft.us/.default"
+	USGOV_POWERAPPS_ADVISOR_DOMAIN = "gov.api.advisor.powerapps.us"
+	USGOV_POWERAPPS_ADVISOR_SCOPE  = "https://gov.api.advisor.powerapps.us/.default"
+	USGOV_LICENSING_API_DOMAIN     = "gov.licensing.powerplatform.microsoft.us"
 )
 
 const (
@@ -46,6 +55,9 @@ const (
 	USGOVHIGH_POWERAPPS_SCOPE          = "https://high.service.apps.appsplatform.us/.default"
 	USGOVHIGH_POWERPLATFORM_API_DOMAIN = "api.appsplatform.us"
 	USGOVHIGH_POWERPLATFORM_API_SCOPE  = "https://api.appsplatform.us/.default"
+	USGOVHIGH_POWERAPPS_ADVISOR_DOMAIN = "high.api.advisor.powerapps.us"
+	USGOVHIGH_POWERAPPS_ADVISOR_SCOPE  = "https://high.api.advisor.powerapps.us/.default"
+	USGOVHIGH_LICENSING_API_DOMAIN     = "high.licensing.powerplatform.microsoft.us"
 )
 
 const (
@@ -55,6 +67,9 @@ const (
 	CHINA_POWERAPPS_SCOPE          = "https://service.powerapps.cn/.default"
 	CHINA_POWERPLATFORM_API_DOMAIN = "api.powerplatform.partner.microsoftonline.cn"
 	CHINA_POWERPLATFORM_API_SCOPE  = "https://api.powerplatform.partner.microsoftonline.cn/.default"
+	CHINA_POWERAPPS_ADVISOR_DOMAIN = "api.advisor.powerapps.cn"
+	CHINA_POWERAPPS_ADVISOR_SCOPE  = "https://api.advisor.powerapps.cn/.default"
+	CHINA_LICENSING_API_DOMAIN     = "licensing.powerplatform.partner.microsoftonline.cn"
 )
 
 const (
@@ -64,6 +79,9 @@ const (
 	EX_POWERAPPS_SCOPE          = "https://service.powerapps.eaglex.ic.gov/.default"
 	EX_POWERPLATFORM_API_DOMAIN = "api.powerplatform.eaglex.ic.gov"
 	EX_POWERPLATFORM_API_SCOPE  = "https://api.powerplatform.eaglex.ic.gov/.default"
+	EX_POWERAPPS_ADVISOR_DOMAIN = "api.advisor.powerapps.eaglex.ic.gov"
+	EX_POWERAPPS_ADVISOR_SCOPE  = "https://api.advisor.powerapps.eaglex.ic.gov/.default"
+	EX_LICENSING_API_DOMAIN     = "licensing.powerplatform.eaglex.ic.gov"
 	EX_AUTHORITY_HOST           = "https://login.microsoftonline.eaglex.ic.gov/"
 )
 
@@ -74,6 +92,9 @@ const (
 	RX_POWERAPPS_SCOPE          = "https://service.powerapps.microsoft.scloud/.default"
 	RX_POWERPLATFORM_API_DOMAIN = "api.powerplatform.microsoft.scloud"
 	RX_POWERPLATFORM_API_SCOPE  = "https://api.powerplatform.microsoft.scloud/.default"
+	RX_POWERAPPS_ADVISOR_DOMAIN = "api.advisor.powerapps.microsoft.scloud"
+	RX_POWERAPPS_ADVISOR_SCOPE  = "https://api.advisor.powerapps.microsoft.scloud/.default"
+	RX_LICENSING_API_DOMAIN     = "licensing.powerplatform.microsoft.scloud"
 	RX_AUTHORITY_HOST           = "https://login.microsoftonline.microsoft.scloud/"
 )
 
@@ -84,3 +105,7 @@ const (
 	HEADER_RETRY_AFTER        = "Retry-After"
 	HEADER_OPERATION_LOCATION = "Operation-Location"
 )
+
+const (
+	MAX_CONCURRENT_REQUESTS = 8
+)
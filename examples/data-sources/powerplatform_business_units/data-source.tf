@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_business_units" "all" {
+  environment_id = var.environment_id
+}
+
+data "powerplatform_business_units" "sales" {
+  environment_id = var.environment_id
+  name           = "Sales"
+}
@@ -0,0 +1,9 @@
+output "business_unit_tree" {
+  description = "Business units of the environment with their parent business unit"
+  value       = { for business_unit in data.powerplatform_business_units.all.business_units : business_unit.name => business_unit.parent_business_unit_id }
+}
+
+output "sales_business_unit_id" {
+  description = "Id of the Sales business unit"
+  value       = one(data.powerplatform_business_units.sales.business_units).id
+}
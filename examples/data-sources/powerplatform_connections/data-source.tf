@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_connections" "all_connections" {
+  environment_id = var.environment_id
+}
@@ -0,0 +1,4 @@
+output "broken_connections" {
+  description = "Connections that are not connected"
+  value       = [for connection in data.powerplatform_connections.all_connections.connections : connection.display_name if connection.status != "Connected"]
+}
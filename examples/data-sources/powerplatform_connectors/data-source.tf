@@ -11,3 +11,14 @@ provider "powerplatform" {
 }
 
 data "powerplatform_connectors" "all_connectors" {}
+
+data "powerplatform_connectors" "sql" {
+  name_pattern    = "^shared_sql$"
+  include_actions = true
+}
+
+data "powerplatform_connectors" "environment_custom_connectors" {
+  environment_id = var.environment_id
+  include_custom = true
+  publisher      = var.custom_connectors_publisher
+}
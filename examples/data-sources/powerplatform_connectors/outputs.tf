@@ -2,3 +2,13 @@ output "all_connectors" {
   description = "All connectors avaiable in Power Platform"
   value       = data.powerplatform_connectors.all_connectors
 }
+
+output "sql_actions" {
+  description = "Actions of the SQL Server connector, to be used in the action rules of a DLP policy"
+  value       = data.powerplatform_connectors.sql.connectors[0].actions[*].id
+}
+
+output "environment_custom_connectors" {
+  description = "Ids of the custom connectors of the environment"
+  value       = [for connector in data.powerplatform_connectors.environment_custom_connectors.connectors : connector.id if connector.custom]
+}
@@ -0,0 +1,9 @@
+variable "environment_id" {
+  description = "Id of the environment to list the custom connectors of"
+  type        = string
+}
+
+variable "custom_connectors_publisher" {
+  description = "Publisher of the custom connectors"
+  type        = string
+}
@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_data_loss_prevention_effective_classification" "effective" {
+  environment_id = var.environment_id
+}
@@ -0,0 +1,4 @@
+output "blocked_connectors" {
+  description = "Connectors blocked in the environment and the policies blocking them"
+  value       = { for connector in data.powerplatform_data_loss_prevention_effective_classification.effective.connectors : connector.id => connector.policies if connector.classification == "Blocked" }
+}
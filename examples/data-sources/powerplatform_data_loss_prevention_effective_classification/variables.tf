@@ -0,0 +1,4 @@
+variable "environment_id" {
+  description = "Id of the environment to evaluate the DLP policies for"
+  type        = string
+}
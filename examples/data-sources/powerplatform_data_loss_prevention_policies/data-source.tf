@@ -12,3 +12,8 @@ provider "powerplatform" {
 
 data "powerplatform_data_loss_prevention_policies" "tenant_data_loss_prevention_policies" {
 }
+
+data "powerplatform_data_loss_prevention_policies" "sql_policies" {
+  environment_id = "00000000-0000-0000-0000-000000000001"
+  connector_id   = "/providers/Microsoft.PowerApps/apis/shared_sql"
+}
@@ -2,3 +2,8 @@ output "all_applications" {
   description = "All policies"
   value       = data.powerplatform_data_loss_prevention_policies.tenant_data_loss_prevention_policies.policies
 }
+
+output "sql_policies" {
+  description = "Policies that classify the SQL Server connector and apply to the environment"
+  value       = data.powerplatform_data_loss_prevention_policies.sql_policies.policies[*].display_name
+}
@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_data_loss_prevention_policy" "default_policy" {
+  display_name = "Default policy"
+}
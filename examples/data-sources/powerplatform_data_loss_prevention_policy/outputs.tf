@@ -0,0 +1,4 @@
+output "default_policy_business_connectors" {
+  description = "Business connectors of the default policy"
+  value       = data.powerplatform_data_loss_prevention_policy.default_policy.business_connectors[*].id
+}
@@ -0,0 +1,9 @@
+output "contoso_environment_id" {
+  description = "Id of the environment with the contoso domain name"
+  value       = data.powerplatform_environment.contoso.id
+}
+
+output "contoso_environment_url" {
+  description = "Dataverse url of the environment with the contoso domain name"
+  value       = data.powerplatform_environment.contoso.dataverse.url
+}
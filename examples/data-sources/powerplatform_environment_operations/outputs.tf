@@ -0,0 +1,9 @@
+output "last_operation" {
+  description = "Type and state of the most recent lifecycle operation of the environment"
+  value       = "${data.powerplatform_environment_operations.operations.operations[0].type}: ${data.powerplatform_environment_operations.operations.operations[0].state}"
+}
+
+output "failed_operations" {
+  description = "Ids of the lifecycle operations of the environment that failed"
+  value       = [for operation in data.powerplatform_environment_operations.operations.operations : operation.id if operation.state == "Failed"]
+}
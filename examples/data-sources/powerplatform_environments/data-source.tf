@@ -11,3 +11,9 @@ provider "powerplatform" {
 }
 
 data "powerplatform_environments" "all_environments" {}
+
+data "powerplatform_environments" "managed_sandboxes" {
+  environment_type = "Sandbox"
+  is_managed       = true
+  has_dataverse    = true
+}
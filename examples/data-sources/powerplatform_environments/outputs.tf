@@ -1,4 +1,8 @@
 output "all_environments" {
   description = "All environments in the tenant"
   value       = data.powerplatform_environments.all_environments
-}
\ No newline at end of file
+}
+output "managed_sandboxes" {
+  description = "Managed sandbox environments with Dataverse"
+  value       = data.powerplatform_environments.managed_sandboxes.environments[*].display_name
+}
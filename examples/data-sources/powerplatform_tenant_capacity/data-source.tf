@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_tenant_capacity" "capacity" {
+  tenant_id = var.tenant_id
+}
+
+locals {
+  database_capacity = one([for c in data.powerplatform_tenant_capacity.capacity.tenant_capacities : c if c.capacity_type == "Database"])
+}
+
+check "database_capacity" {
+  assert {
+    condition     = local.database_capacity.consumption.actual < local.database_capacity.total_capacity * 0.9
+    error_message = "More than 90% of the Dataverse database capacity of the tenant is used."
+  }
+}
@@ -0,0 +1,6 @@
+output "environments_database_used_mb" {
+  description = "Dataverse database storage used by each environment in MB"
+  value = {
+    for e in data.powerplatform_tenant_capacity.capacity.environments : e.display_name => e.database_used_mb if e.database_used_mb != null
+  }
+}
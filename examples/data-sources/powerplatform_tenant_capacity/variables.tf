@@ -0,0 +1,4 @@
+variable "tenant_id" {
+  description = "Id of the tenant to read the capacity of"
+  type        = string
+}
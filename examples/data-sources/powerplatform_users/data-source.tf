@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_users" "system_administrators" {
+  environment_ids    = var.environment_ids
+  disabled           = false
+  security_role_name = "System Administrator"
+}
+
+data "powerplatform_users" "application_users" {
+  environment_id         = var.environment_ids[0]
+  application_users_only = true
+}
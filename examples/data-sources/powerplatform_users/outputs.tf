@@ -0,0 +1,13 @@
+output "system_administrators" {
+  description = "User principal names of the enabled system administrators per environment"
+  value = {
+    for environment_id in var.environment_ids : environment_id => [
+      for user in data.powerplatform_users.system_administrators.users : user.user_principal_name if user.environment_id == environment_id
+    ]
+  }
+}
+
+output "application_users" {
+  description = "Security roles of the application users, keyed by application id"
+  value       = { for user in data.powerplatform_users.application_users.users : user.application_id => user.security_role_names }
+}
@@ -0,0 +1,4 @@
+variable "environment_ids" {
+  description = "Ids of the environments to review the users of"
+  type        = list(string)
+}
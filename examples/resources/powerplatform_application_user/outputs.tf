@@ -0,0 +1,4 @@
+output "new_application_user_systemuser_id" {
+  description = "The System User ID of the new application user"
+  value       = powerplatform_application_user.new_application_user.id
+}
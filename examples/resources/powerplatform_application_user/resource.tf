@@ -0,0 +1,45 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+    azuread = {
+      source = "hashicorp/azuread"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+provider "azuread" {
+  use_cli = true
+}
+
+resource "azuread_application_registration" "example_application" {
+  display_name = "application_user_example"
+}
+
+resource "azuread_service_principal" "example_service_principal" {
+  client_id = azuread_application_registration.example_application.client_id
+}
+
+resource "powerplatform_environment" "dataverse_application_user_example" {
+  display_name     = "application_user_example"
+  location         = "europe"
+  environment_type = "Sandbox"
+  dataverse = {
+    language_code     = "1033"
+    currency_code     = "USD"
+    security_group_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
+
+resource "powerplatform_application_user" "new_application_user" {
+  environment_id = powerplatform_environment.dataverse_application_user_example.id
+  application_id = azuread_service_principal.example_service_principal.client_id
+  security_roles = [
+    "e0d2794e-82f3-e811-a951-000d3a1bcf17", // bot author
+  ]
+}
@@ -0,0 +1,4 @@
+output "sales_emea_business_unit_id" {
+  description = "Id of the Sales EMEA business unit"
+  value       = powerplatform_business_unit.sales_emea.id
+}
@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_business_unit" "sales" {
+  environment_id = var.environment_id
+  name           = "Sales"
+  cost_center    = "CC-100"
+  description    = "Sales department"
+}
+
+resource "powerplatform_business_unit" "sales_emea" {
+  environment_id          = var.environment_id
+  name                    = "Sales EMEA"
+  parent_business_unit_id = powerplatform_business_unit.sales.id
+}
@@ -0,0 +1,4 @@
+variable "environment_id" {
+  description = "Id of the environment to create the business units in"
+  type        = string
+}
@@ -0,0 +1,4 @@
+output "sql_connection_status" {
+  description = "Status of the SQL connection"
+  value       = powerplatform_connection.sql.status
+}
@@ -0,0 +1,41 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_connection" "sql" {
+  environment_id = var.environment_id
+  connector_id   = "/providers/Microsoft.PowerApps/apis/shared_sql"
+  display_name   = "Orders database"
+  connection_parameters_set = jsonencode({
+    name = "sqlAuthentication"
+    values = {
+      server   = { value = "contoso.database.windows.net" }
+      database = { value = "orders" }
+      username = { value = var.sql_username }
+      password = { value = var.sql_password }
+    }
+  })
+}
+
+resource "powerplatform_connection" "dataverse" {
+  environment_id = var.environment_id
+  connector_id   = "/providers/Microsoft.PowerApps/apis/shared_commondataserviceforapps"
+  display_name   = "Dataverse (service principal)"
+  connection_parameters_set = jsonencode({
+    name = "oauthSP"
+    values = {
+      "token:clientId"     = { value = var.client_id }
+      "token:clientSecret" = { value = var.client_secret }
+      "token:TenantId"     = { value = var.tenant_id }
+      "token:grantType"    = { value = "client_credentials" }
+    }
+  })
+}
@@ -0,0 +1,31 @@
+variable "environment_id" {
+  description = "Id of the environment to create the connections in"
+  type        = string
+}
+
+variable "sql_username" {
+  description = "User name of the SQL connection"
+  type        = string
+}
+
+variable "sql_password" {
+  description = "Password of the SQL connection"
+  type        = string
+  sensitive   = true
+}
+
+variable "client_id" {
+  description = "Client id of the service principal used by the Dataverse connection"
+  type        = string
+}
+
+variable "client_secret" {
+  description = "Client secret of the service principal used by the Dataverse connection"
+  type        = string
+  sensitive   = true
+}
+
+variable "tenant_id" {
+  description = "Tenant id of the service principal used by the Dataverse connection"
+  type        = string
+}
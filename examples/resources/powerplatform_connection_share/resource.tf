@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_connection" "sql" {
+  environment_id = var.environment_id
+  connector_id   = "/providers/Microsoft.PowerApps/apis/shared_sql"
+  display_name   = "Orders database"
+}
+
+resource "powerplatform_connection_share" "makers" {
+  environment_id = powerplatform_connection.sql.environment_id
+  connector_id   = powerplatform_connection.sql.connector_id
+  connection_id  = powerplatform_connection.sql.id
+  role_name      = "CanView"
+  principal = {
+    object_id = var.makers_group_id
+    type      = "Group"
+  }
+}
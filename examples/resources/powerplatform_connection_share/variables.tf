@@ -0,0 +1,9 @@
+variable "environment_id" {
+  description = "Id of the environment of the connection"
+  type        = string
+}
+
+variable "makers_group_id" {
+  description = "Object id of the Entra ID group the connection is shared with"
+  type        = string
+}
@@ -0,0 +1,4 @@
+output "connector_id" {
+  description = "Id of the custom connector, used in data loss prevention policies"
+  value       = powerplatform_custom_connector.orders.id
+}
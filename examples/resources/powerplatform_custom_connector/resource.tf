@@ -0,0 +1,50 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_environment" "development" {
+  display_name     = "Custom Connector Example"
+  location         = "europe"
+  environment_type = "Sandbox"
+  dataverse = {
+    language_code     = "1033"
+    currency_code     = "USD"
+    security_group_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
+
+resource "powerplatform_custom_connector" "orders" {
+  environment_id   = powerplatform_environment.development.id
+  display_name     = "Contoso Orders"
+  description      = "Reads orders from the Contoso orders API"
+  swagger_file     = "${path.module}/swagger.json"
+  icon_file        = "${path.module}/icon.png"
+  icon_brand_color = "#007ee5"
+
+  api_key = {
+    display_name = "API Key"
+    description  = "API key of the Contoso orders API"
+  }
+
+  policy_templates = [
+    {
+      template_id = "setheader"
+      title       = "Set client header"
+      parameters = {
+        "x-ms-apimTemplateParameter.name"         = "X-Client"
+        "x-ms-apimTemplateParameter.value"        = "PowerPlatform"
+        "x-ms-apimTemplateParameter.existsAction" = "override"
+        "x-ms-apimTemplate-policySection"         = "Request"
+        "x-ms-apimTemplate-operationName"         = "[\"GetOrder\"]"
+      }
+    }
+  ]
+}
@@ -7,3 +7,8 @@ output "policy_display_name" {
   description = "Display name of the policy"
   value       = powerplatform_data_loss_prevention_policy.my_policy.display_name
 }
+
+output "exported_policy_canonical_json" {
+  description = "Canonical JSON of the exported policy definition"
+  value       = powerplatform_data_loss_prevention_policy.exported_policy.canonical_policy_definition_json
+}
@@ -99,3 +99,34 @@ resource "powerplatform_data_loss_prevention_policy" "my_policy" {
     }
   ])
 }
+
+resource "powerplatform_data_loss_prevention_policy" "environment_policy" {
+  scope                             = "environment"
+  display_name                      = "Environment Policy"
+  default_connectors_classification = "General"
+  environment_type                  = "OnlyEnvironments"
+  environments                      = [var.environment_id]
+
+  business_connectors     = []
+  non_business_connectors = []
+  blocked_connectors = [
+    {
+      id                           = "/providers/Microsoft.PowerApps/apis/shared_azureblob"
+      default_action_rule_behavior = ""
+      action_rules                 = []
+      endpoint_rules               = []
+    }
+  ]
+
+  custom_connectors_patterns = toset([
+    {
+      order            = 1
+      host_url_pattern = "*"
+      data_group       = "Ignore"
+    }
+  ])
+}
+
+resource "powerplatform_data_loss_prevention_policy" "exported_policy" {
+  policy_definition_json = file(var.exported_policy_path)
+}
@@ -0,0 +1,9 @@
+variable "environment_id" {
+  description = "Id of the environment managed by the environment-level policy"
+  type        = string
+}
+
+variable "exported_policy_path" {
+  description = "Path of a policy definition exported from the Power Platform admin center or with Get-DlpPolicy"
+  type        = string
+}
@@ -0,0 +1,4 @@
+output "organization_settings" {
+  description = "Organization settings of the Power Platform environment"
+  value       = powerplatform_environment_organization_settings.settings
+}
@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_environment" "example_environment" {
+  display_name     = "example_environment_organization_settings"
+  location         = "europe"
+  environment_type = "Sandbox"
+  dataverse = {
+    language_code     = "1033"
+    currency_code     = "USD"
+    security_group_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
+
+resource "powerplatform_environment_organization_settings" "settings" {
+  environment_id = powerplatform_environment.example_environment.id
+
+  settings = {
+    sessiontimeoutenabled = "true"
+    sessiontimeoutinmins  = "120"
+    maxuploadfilesize     = "10485760"
+  }
+
+  orgdb_settings = {
+    IsMailboxInactiveBackoffEnabled = "true"
+  }
+}
@@ -0,0 +1,4 @@
+output "makers_role_assignment_id" {
+  description = "Id of the Environment Maker role assignment of the makers group"
+  value       = powerplatform_environment_role_assignment.makers.id
+}
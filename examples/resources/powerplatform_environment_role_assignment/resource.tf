@@ -0,0 +1,35 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_environment" "no_dataverse" {
+  display_name     = "role_assignment_example"
+  location         = "europe"
+  environment_type = "Sandbox"
+}
+
+resource "powerplatform_environment_role_assignment" "admin" {
+  environment_id = powerplatform_environment.no_dataverse.id
+  role           = "EnvironmentAdmin"
+  principal = {
+    object_id = var.admin_user_object_id
+    type      = "User"
+  }
+}
+
+resource "powerplatform_environment_role_assignment" "makers" {
+  environment_id = powerplatform_environment.no_dataverse.id
+  role           = "EnvironmentMaker"
+  principal = {
+    object_id = var.makers_group_object_id
+    type      = "Group"
+  }
+}
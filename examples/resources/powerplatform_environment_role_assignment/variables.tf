@@ -0,0 +1,9 @@
+variable "admin_user_object_id" {
+  description = "Entra object id of the user that administers the environment"
+  type        = string
+}
+
+variable "makers_group_object_id" {
+  description = "Entra object id of the group whose members can make apps and flows in the environment"
+  type        = string
+}
@@ -30,6 +30,7 @@ resource "powerplatform_environment_settings" "settings" {
       is_audit_enabled             = true
       is_user_access_audit_enabled = true
       is_read_audit_enabled        = true
+      log_retention_period_in_days = 365
     }
   }
   email = {
@@ -45,4 +46,26 @@ resource "powerplatform_environment_settings" "settings" {
       power_apps_component_framework_for_canvas_apps = false
     }
   }
+  security = {
+    ip_firewall = {
+      enabled                 = true
+      audit_only              = true
+      allowed_ip_ranges       = ["10.0.0.0/16"]
+      allowed_service_tags    = ["AzureCloud"]
+      allow_application_users = true
+    }
+    ip_based_cookie_binding_enabled = true
+    session_timeout = {
+      enabled            = true
+      timeout_in_minutes = 480
+      warning_in_minutes = 20
+    }
+    inactivity_timeout = {
+      enabled            = true
+      timeout_in_minutes = 60
+      warning_in_minutes = 5
+    }
+    blocked_file_extensions = ["bat", "exe", "js"]
+    blocked_mime_types      = ["application/x-msdownload"]
+  }
 }
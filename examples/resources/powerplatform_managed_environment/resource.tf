@@ -22,15 +22,16 @@ resource "powerplatform_environment" "development" {
 }
 
 resource "powerplatform_managed_environment" "managed_development" {
-  environment_id             = powerplatform_environment.development.id
-  is_usage_insights_disabled = true
-  is_group_sharing_disabled  = true
-  limit_sharing_mode         = "ExcludeSharingToSecurityGroups"
-  max_limit_user_sharing     = 10
-  solution_checker_mode      = "None"
-  suppress_validation_emails = true
-  maker_onboarding_markdown  = "this is example markdown"
-  maker_onboarding_url       = "https://www.microsoft.com"
+  environment_id                     = powerplatform_environment.development.id
+  is_usage_insights_disabled         = true
+  is_group_sharing_disabled          = true
+  limit_sharing_mode                 = "ExcludeSharingToSecurityGroups"
+  max_limit_user_sharing             = 10
+  solution_checker_mode              = "None"
+  suppress_validation_emails         = true
+  solution_checker_rule_overrides    = ["meta-avoid-reg-no-attribute"]
+  ai_generated_descriptions_disabled = false
+  include_on_homepage_insights       = true
+  maker_onboarding_markdown          = "this is example markdown"
+  maker_onboarding_url               = "https://www.microsoft.com"
 }
-
-
@@ -0,0 +1,9 @@
+output "pipeline_id" {
+  description = "Unique identifier of the pipeline"
+  value       = powerplatform_pipeline.pipeline.id
+}
+
+output "stage_ids" {
+  description = "Unique identifiers of the pipeline stages in deployment order"
+  value       = powerplatform_pipeline.pipeline.stages[*].id
+}
@@ -0,0 +1,55 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+locals {
+  environments = {
+    host        = "Pipelines Host"
+    development = "Pipelines Development"
+    test        = "Pipelines Test"
+    production  = "Pipelines Production"
+  }
+}
+
+resource "powerplatform_environment" "environments" {
+  for_each = local.environments
+
+  display_name     = each.value
+  location         = "europe"
+  environment_type = "Sandbox"
+  dataverse = {
+    language_code     = "1033"
+    currency_code     = "USD"
+    security_group_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
+
+resource "powerplatform_environment_application_package_install" "pipelines" {
+  environment_id = powerplatform_environment.environments["host"].id
+  unique_name    = "msdyn_AppDeploymentAnchor"
+}
+
+resource "powerplatform_pipeline" "pipeline" {
+  environment_id              = powerplatform_environment_application_package_install.pipelines.environment_id
+  name                        = "Release Pipeline"
+  description                 = "Deploys solutions from development to test and production"
+  development_environment_ids = [powerplatform_environment.environments["development"].id]
+  stages = [
+    {
+      name                  = "Test"
+      target_environment_id = powerplatform_environment.environments["test"].id
+    },
+    {
+      name                  = "Production"
+      target_environment_id = powerplatform_environment.environments["production"].id
+    }
+  ]
+}
@@ -0,0 +1,4 @@
+output "artifact_version" {
+  description = "Version of the deployed solution artifact"
+  value       = powerplatform_pipeline_run.run.artifact_version
+}
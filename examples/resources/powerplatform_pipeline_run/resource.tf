@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_solution" "solution" {
+  environment_id = var.development_environment_id
+  solution_file  = "${path.module}/../powerplatform_solution/TerraformTestSolution_Complex_1_1_0_0.zip"
+  solution_name  = "TerraformTestSolution"
+}
+
+resource "powerplatform_pipeline_run" "run" {
+  environment_id             = var.host_environment_id
+  stage_id                   = var.stage_id
+  development_environment_id = var.development_environment_id
+  solution_name              = powerplatform_solution.solution.solution_name
+  deployment_notes           = "Deployed by Terraform"
+
+  triggers = {
+    solution_file_checksum = powerplatform_solution.solution.solution_file_checksum
+  }
+}
@@ -0,0 +1,14 @@
+variable "host_environment_id" {
+  description = "Id of the pipelines host environment"
+  type        = string
+}
+
+variable "development_environment_id" {
+  description = "Id of the development environment linked to the pipeline"
+  type        = string
+}
+
+variable "stage_id" {
+  description = "Id of the pipeline stage to deploy to"
+  type        = string
+}
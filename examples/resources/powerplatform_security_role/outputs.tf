@@ -0,0 +1,4 @@
+output "account_manager_security_role_id" {
+  description = "Id of the Account Manager security role"
+  value       = powerplatform_security_role.account_manager.id
+}
@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_security_role" "account_manager" {
+  environment_id = var.environment_id
+  name           = "Account Manager"
+  description    = "Reads all accounts and manages the accounts of the own business unit"
+
+  privileges = [
+    {
+      name  = "prvReadAccount"
+      depth = "Organization"
+    },
+    {
+      name  = "prvCreateAccount"
+      depth = "BusinessUnit"
+    },
+    {
+      name  = "prvWriteAccount"
+      depth = "BusinessUnit"
+    },
+    {
+      name  = "prvDeleteAccount"
+      depth = "User"
+    }
+  ]
+}
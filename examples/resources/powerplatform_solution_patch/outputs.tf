@@ -0,0 +1,4 @@
+output "solution_patch_name" {
+  description = "Unique name of the solution patch generated by Dataverse"
+  value       = powerplatform_solution_patch.hotfix.solution_name
+}
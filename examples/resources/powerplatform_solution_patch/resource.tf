@@ -0,0 +1,35 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_environment" "development" {
+  display_name     = "Solution Patch Example"
+  location         = "europe"
+  environment_type = "Sandbox"
+  dataverse = {
+    language_code     = "1033"
+    currency_code     = "USD"
+    security_group_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
+
+resource "powerplatform_solution" "solution" {
+  environment_id = powerplatform_environment.development.id
+  solution_file  = "${path.module}/../powerplatform_solution/TerraformTestSolution_Complex_1_1_0_0.zip"
+  solution_name  = "TerraformTestSolution"
+}
+
+resource "powerplatform_solution_patch" "hotfix" {
+  environment_id       = powerplatform_environment.development.id
+  parent_solution_name = powerplatform_solution.solution.solution_name
+  display_name         = "Terraform Test Solution Hotfix"
+  solution_version     = "1.1.1.0"
+}
@@ -0,0 +1,4 @@
+output "rolled_up_solution_id" {
+  description = "Id of the parent solution that the patches were rolled up into"
+  value       = powerplatform_solution_rollup.release.solution_id
+}
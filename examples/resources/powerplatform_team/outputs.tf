@@ -0,0 +1,9 @@
+output "owner_team_id" {
+  description = "The ID of the owner team"
+  value       = powerplatform_team.owner_team.id
+}
+
+output "group_team_id" {
+  description = "The ID of the Entra group team"
+  value       = powerplatform_team.group_team.id
+}
@@ -0,0 +1,55 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+    azuread = {
+      source = "hashicorp/azuread"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+provider "azuread" {
+  use_cli = true
+}
+
+resource "azuread_group" "example_group" {
+  display_name     = "team_example"
+  security_enabled = true
+}
+
+resource "powerplatform_environment" "dataverse_team_example" {
+  display_name     = "team_example"
+  location         = "europe"
+  environment_type = "Sandbox"
+  dataverse = {
+    language_code     = "1033"
+    currency_code     = "USD"
+    security_group_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
+
+resource "powerplatform_team" "owner_team" {
+  environment_id = powerplatform_environment.dataverse_team_example.id
+  name           = "Contoso Owners"
+  description    = "Owner team managed by Terraform"
+  team_type      = "Owner"
+  security_roles = [
+    "e0d2794e-82f3-e811-a951-000d3a1bcf17", // bot author
+  ]
+}
+
+resource "powerplatform_team" "group_team" {
+  environment_id  = powerplatform_environment.dataverse_team_example.id
+  name            = "Contoso Group"
+  team_type       = "EntraSecurityGroup"
+  entra_object_id = azuread_group.example_group.object_id
+  membership_type = "Members"
+  security_roles = [
+    "e0d2794e-82f3-e811-a951-000d3a1bcf17", // bot author
+  ]
+}
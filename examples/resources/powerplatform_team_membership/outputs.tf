@@ -0,0 +1,4 @@
+output "access_team_member_ids" {
+  description = "The system user IDs of the team members managed by Terraform"
+  value       = powerplatform_team_membership.access_team_members.user_ids
+}
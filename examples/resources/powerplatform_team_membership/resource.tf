@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_team" "access_team" {
+  environment_id = var.environment_id
+  name           = "Contoso Reviewers"
+  team_type      = "Access"
+}
+
+resource "powerplatform_team_membership" "access_team_members" {
+  environment_id = var.environment_id
+  team_id        = powerplatform_team.access_team.id
+  user_ids       = var.user_ids
+}
@@ -0,0 +1,9 @@
+variable "environment_id" {
+  description = "The ID of the environment containing the team"
+  type        = string
+}
+
+variable "user_ids" {
+  description = "The system user IDs of the team members"
+  type        = set(string)
+}
@@ -40,11 +40,15 @@ func NewApiClientBase(config *config.ProviderConfig, baseAuth *Auth) *ApiClient
 
 func TryGetScopeFromURL(url string, cloudConfig config.ProviderConfigUrls) (string, error) {
 	switch {
+	case strings.LastIndex(url, cloudConfig.PowerAppsAdvisorUrl) != -1:
+		return cloudConfig.PowerAppsAdvisorScope, nil
+
 	case strings.LastIndex(url, cloudConfig.BapiUrl) != -1,
 		strings.LastIndex(url, cloudConfig.PowerAppsUrl) != -1:
 		return cloudConfig.PowerAppsScope, nil
 
-	case strings.LastIndex(url, cloudConfig.PowerPlatformUrl) != -1:
+	case strings.LastIndex(url, cloudConfig.PowerPlatformUrl) != -1,
+		strings.LastIndex(url, cloudConfig.LicensingUrl) != -1:
 		return cloudConfig.PowerPlatformScope, nil
 
 	default:
@@ -65,24 +69,51 @@ func (client *ApiClient) Execute(ctx context.Context, method string, url string,
 		return nil, err
 	}
 
-	var bodyBuffer io.Reader = nil
-	if body != nil {
-		bodyBytes, err := json.Marshal(body)
-		if err != nil {
-			return nil, err
-		}
-		bodyBuffer = bytes.NewBuffer(bodyBytes)
-	}
-
-	request, err := http.NewRequestWithContext(ctx, method, url, bodyBuffer)
+	request, err := newRequest(ctx, method, url, body)
 	if err != nil {
 		return nil, err
 	}
+	//the response is returned with the error, so callers can check the status code of failed requests
 	apiResponse, err := client.doRequest(token, request, headers)
+	if err != nil {
+		return apiResponse, err
+	}
+	return handleResponse(apiResponse, acceptableStatusCodes, responseObj)
+}
+
+// ExecuteWithoutToken executes a request without the provider token. It is used for pre-signed urls (i.e. storage SAS urls)
+// that grant access on their own and must not receive the bearer token of the provider.
+func (client *ApiClient) ExecuteWithoutToken(ctx context.Context, method string, url string, headers http.Header, body interface{}, acceptableStatusCodes []int, responseObj interface{}) (*ApiHttpResponse, error) {
+	request, err := newRequest(ctx, method, url, body)
 	if err != nil {
 		return nil, err
 	}
+	if headers != nil {
+		request.Header = headers
+	}
+	apiResponse, err := client.sendRequest(request)
+	if err != nil {
+		return apiResponse, err
+	}
+	return handleResponse(apiResponse, acceptableStatusCodes, responseObj)
+}
+
+func newRequest(ctx context.Context, method string, url string, body interface{}) (*http.Request, error) {
+	var bodyBuffer io.Reader = nil
+	if rawBody, ok := body.([]byte); ok {
+		//raw content (i.e. file uploads) is sent as is
+		bodyBuffer = bytes.NewBuffer(rawBody)
+	} else if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		bodyBuffer = bytes.NewBuffer(bodyBytes)
+	}
+	return http.NewRequestWithContext(ctx, method, url, bodyBuffer)
+}
 
+func handleResponse(apiResponse *ApiHttpResponse, acceptableStatusCodes []int, responseObj interface{}) (*ApiHttpResponse, error) {
 	isStatusCodeValid := false
 	for _, statusCode := range acceptableStatusCodes {
 		if apiResponse.Response.StatusCode == statusCode {
@@ -91,10 +122,10 @@ func (client *ApiClient) Execute(ctx context.Context, method string, url string,
 		}
 	}
 	if !isStatusCodeValid {
-		return nil, fmt.Errorf("expected status code: %d, recieved: %d", acceptableStatusCodes, apiResponse.Response.StatusCode)
+		return apiResponse, fmt.Errorf("expected status code: %d, recieved: %d", acceptableStatusCodes, apiResponse.Response.StatusCode)
 	}
 	if responseObj != nil {
-		err = apiResponse.MarshallTo(responseObj)
+		err := apiResponse.MarshallTo(responseObj)
 		if err != nil {
 			return nil, err
 		}
@@ -111,3 +142,21 @@ func (client *ApiClient) Sleep(duration time.Duration) {
 		time.Sleep(duration)
 	}
 }
+
+// SleepWithContext sleeps for the given duration, returning early with the context error if the context is done first.
+func (client *ApiClient) SleepWithContext(ctx context.Context, duration time.Duration) error {
+	if client.Config.Credentials.TestMode {
+		//Don't sleep during testing
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
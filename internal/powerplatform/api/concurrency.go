@@ -0,0 +1,64 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"sync"
+)
+
+// ExecuteConcurrently calls fn for each item with at most maxConcurrency calls running at the same time.
+// The results are returned in the order of the items. When a call fails, the context passed to the
+// remaining calls is canceled, no new calls are started and the first error is returned.
+func ExecuteConcurrently[T any, R any](ctx context.Context, items []T, maxConcurrency int, fn func(ctx context.Context, item T) (R, error)) ([]R, error) {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]R, len(items))
+	semaphore := make(chan struct{}, maxConcurrency)
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+
+	for inx, item := range items {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(inx int, item T) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			result, err := fn(ctx, item)
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			results[inx] = result
+		}(inx, item)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if ctx.Err() != nil {
+		//the parent context was canceled before all items were processed
+		return nil, ctx.Err()
+	}
+	return results, nil
+}
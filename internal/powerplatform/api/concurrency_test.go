@@ -0,0 +1,117 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestUnitExecuteConcurrently_Keeps_Order(t *testing.T) {
+	items := []int{5, 4, 3, 2, 1, 0}
+
+	results, err := ExecuteConcurrently(context.Background(), items, 3, func(ctx context.Context, item int) (int, error) {
+		//later items finish first, so the results are completed out of order
+		time.Sleep(time.Duration(item) * time.Millisecond)
+		return item * 10, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(results) != len(items) {
+		t.Fatalf("expected %d results, got %d", len(items), len(results))
+	}
+	for inx, item := range items {
+		if results[inx] != item*10 {
+			t.Errorf("expected result %d at index %d, got %d", item*10, inx, results[inx])
+		}
+	}
+}
+
+func TestUnitExecuteConcurrently_Limits_Concurrency(t *testing.T) {
+	maxConcurrency := 3
+	items := make([]int, 20)
+
+	var running, maxRunning int32
+	_, err := ExecuteConcurrently(context.Background(), items, maxConcurrency, func(ctx context.Context, item int) (int, error) {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			observed := atomic.LoadInt32(&maxRunning)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxRunning, observed, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		return item, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if maxRunning > int32(maxConcurrency) {
+		t.Errorf("expected at most %d concurrent calls, got %d", maxConcurrency, maxRunning)
+	}
+	if maxRunning < 2 {
+		t.Errorf("expected calls to run concurrently, got at most %d at the same time", maxRunning)
+	}
+}
+
+func TestUnitExecuteConcurrently_First_Error_Cancels_Remaining(t *testing.T) {
+	expectedErr := errors.New("item failed")
+	items := make([]int, 50)
+	for inx := range items {
+		items[inx] = inx
+	}
+
+	var started, canceled int32
+	results, err := ExecuteConcurrently(context.Background(), items, 2, func(ctx context.Context, item int) (int, error) {
+		atomic.AddInt32(&started, 1)
+		if item == 1 {
+			return 0, expectedErr
+		}
+		select {
+		case <-ctx.Done():
+			atomic.AddInt32(&canceled, 1)
+			return 0, ctx.Err()
+		case <-time.After(time.Second):
+			return item, nil
+		}
+	})
+
+	if !errors.Is(err, expectedErr) {
+		t.Fatalf("expected error '%s', got '%v'", expectedErr, err)
+	}
+	if results != nil {
+		t.Errorf("expected no results, got %v", results)
+	}
+	if started >= int32(len(items)) {
+		t.Errorf("expected the remaining items not to be started, but %d of %d were started", started, len(items))
+	}
+	if canceled == 0 {
+		t.Errorf("expected the running calls to be canceled")
+	}
+}
+
+func TestUnitExecuteConcurrently_Parent_Context_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var started int32
+	_, err := ExecuteConcurrently(ctx, []int{1, 2, 3}, 1, func(ctx context.Context, item int) (int, error) {
+		atomic.AddInt32(&started, 1)
+		return item, nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled error, got '%v'", err)
+	}
+	if started != 0 {
+		t.Errorf("expected no calls to be started, got %d", started)
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	constants "github.com/microsoft/terraform-provider-power-platform/constants"
+)
+
+var entityIdRegex = regexp.MustCompile("[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}")
+
+// BuildDataverseUrl returns the url of a Dataverse web api path in the environment with the given url.
+func BuildDataverseUrl(environmentUrl, path string, values url.Values) string {
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   strings.TrimPrefix(environmentUrl, "https://"),
+		Path:   fmt.Sprintf("/api/data/%s/%s", constants.DATAVERSE_API_VERSION, path),
+	}
+	if values != nil {
+		apiUrl.RawQuery = values.Encode()
+	}
+	return apiUrl.String()
+}
+
+// GetCreatedEntityId returns the id of a record created in Dataverse, from the odata-entityid header of the create response.
+func GetCreatedEntityId(response *ApiHttpResponse, entityName string) (string, error) {
+	match := entityIdRegex.FindAllString(response.GetHeader(constants.HEADER_ODATA_ENTITY_ID), -1)
+	if len(match) == 0 {
+		return "", fmt.Errorf("no %s id returned from the odata-entityid header", entityName)
+	}
+	return match[len(match)-1], nil
+}
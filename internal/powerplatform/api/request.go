@@ -13,7 +13,6 @@ import (
 )
 
 func (client *ApiClient) doRequest(token *string, request *http.Request, headers http.Header) (*ApiHttpResponse, error) {
-	apiHttpResponse := &ApiHttpResponse{}
 	if headers != nil {
 		request.Header = headers
 	}
@@ -26,12 +25,17 @@ func (client *ApiClient) doRequest(token *string, request *http.Request, headers
 		request.Header.Set("Content-Type", "application/json")
 	}
 
-	httpClient := http.DefaultClient
-
 	if request.Header["Authorization"] == nil {
 		request.Header.Set("Authorization", "Bearer "+*token)
 	}
 
+	return client.sendRequest(request)
+}
+
+func (client *ApiClient) sendRequest(request *http.Request) (*ApiHttpResponse, error) {
+	apiHttpResponse := &ApiHttpResponse{}
+	httpClient := http.DefaultClient
+
 	if !client.GetConfig().TelemetryOptout {
 		request.Header.Set("User-Agent", "terraform-provider-power-platform")
 	}
@@ -53,7 +57,7 @@ func (client *ApiClient) doRequest(token *string, request *http.Request, headers
 		if len(body) != 0 {
 			return apiHttpResponse, fmt.Errorf("status: %d, message: %s", response.StatusCode, string(body))
 		} else {
-			return nil, fmt.Errorf("status: %d", response.StatusCode)
+			return apiHttpResponse, fmt.Errorf("status: %d", response.StatusCode)
 		}
 	}
 	return apiHttpResponse, nil
@@ -16,11 +16,14 @@ type ProviderConfig struct {
 }
 
 type ProviderConfigUrls struct {
-	BapiUrl            string
-	PowerAppsUrl       string
-	PowerAppsScope     string
-	PowerPlatformUrl   string
-	PowerPlatformScope string
+	BapiUrl               string
+	PowerAppsUrl          string
+	PowerAppsScope        string
+	PowerPlatformUrl      string
+	PowerPlatformScope    string
+	PowerAppsAdvisorUrl   string
+	PowerAppsAdvisorScope string
+	LicensingUrl          string
 }
 
 type ProviderCredentials struct {
@@ -0,0 +1,112 @@
+package powerplatform
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestAccBusinessUnitsDataSource_Validate_Read(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck_Basic(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment" "business_units_example" {
+					display_name      = "` + mock_helpers.TestName() + `"
+					location          = "europe"
+					environment_type  = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				data "powerplatform_business_units" "all" {
+					environment_id = powerplatform_environment.business_units_example.id
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.#", "1"),
+					resource.TestMatchResourceAttr("data.powerplatform_business_units.all", "business_units.0.id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.0.level", "0"),
+					resource.TestCheckNoResourceAttr("data.powerplatform_business_units.all", "business_units.0.parent_business_unit_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitBusinessUnitsDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/datasource/business_units/Validate_Read/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/businessunits?%24orderby=name&%24select=businessunitid%2Cname%2C_parentbusinessunitid_value%2Ccostcenter%2Cdescription%2Cisdisabled",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/datasource/business_units/Validate_Read/get_businessunits.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_business_units" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.#", "4"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.0.id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.0.name", "contoso"),
+					resource.TestCheckNoResourceAttr("data.powerplatform_business_units.all", "business_units.0.parent_business_unit_id"),
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.0.level", "0"),
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.0.child_business_unit_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.0.child_business_unit_ids.0", "00000000-0000-0000-0000-000000000013"),
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.0.child_business_unit_ids.1", "00000000-0000-0000-0000-000000000011"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.1.name", "Marketing"),
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.1.level", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.1.cost_center", "CC-200"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.2.name", "Sales"),
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.2.level", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.2.child_business_unit_ids.#", "1"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.3.name", "Sales EMEA"),
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.3.parent_business_unit_id", "00000000-0000-0000-0000-000000000011"),
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.3.level", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.3.disabled", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.all", "business_units.3.child_business_unit_ids.#", "0"),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_business_units" "sales" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Sales"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.sales", "business_units.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_business_units.sales", "business_units.0.id", "00000000-0000-0000-0000-000000000011"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestAccConnectionsDataSource_Validate_Read(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck_Basic(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment" "environment" {
+					display_name     = "` + mock_helpers.TestName() + `"
+					location         = "europe"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_connection" "connection" {
+					environment_id = powerplatform_environment.environment.id
+					connector_id   = "/providers/Microsoft.PowerApps/apis/shared_office365"
+					display_name   = "Office 365 Outlook"
+				}
+
+				data "powerplatform_connections" "all" {
+					environment_id = powerplatform_environment.environment.id
+
+					depends_on = [powerplatform_connection.connection]
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_connections.all", "connections.#", "1"),
+					resource.TestMatchResourceAttr("data.powerplatform_connections.all", "connections.0.id", regexp.MustCompile(powerplatform_helpers.StringRegex)),
+					resource.TestCheckResourceAttr("data.powerplatform_connections.all", "connections.0.display_name", "Office 365 Outlook"),
+					resource.TestCheckResourceAttr("data.powerplatform_connections.all", "connections.0.connector_id", "/providers/Microsoft.PowerApps/apis/shared_office365"),
+					resource.TestMatchResourceAttr("data.powerplatform_connections.all", "connections.0.status", regexp.MustCompile(powerplatform_helpers.StringRegex)),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitConnectionsDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	httpmock.RegisterResponder("GET", "https://api.powerapps.com/providers/Microsoft.PowerApps/connections?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connection/tests/Validate_Read/get_connections.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_connections" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_connections.all", "id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_connections.all", "connections.#", "2"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_connections.all", "connections.0.id", "3b0f3c0b1f3a4c2f9d1e5a7b8c9d0e1f"),
+					resource.TestCheckResourceAttr("data.powerplatform_connections.all", "connections.0.display_name", "Orders database"),
+					resource.TestCheckResourceAttr("data.powerplatform_connections.all", "connections.0.connector_id", "/providers/Microsoft.PowerApps/apis/shared_sql"),
+					resource.TestCheckResourceAttr("data.powerplatform_connections.all", "connections.0.status", "Connected"),
+					resource.TestCheckResourceAttr("data.powerplatform_connections.all", "connections.0.created_time", "2024-05-02T09:12:34.5678901Z"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_connections.all", "connections.1.connector_id", "/providers/Microsoft.PowerApps/apis/shared_office365"),
+					resource.TestCheckResourceAttr("data.powerplatform_connections.all", "connections.1.status", "Error"),
+				),
+			},
+		},
+	})
+}
@@ -87,4 +87,111 @@ func TestUnitConnectorsDataSource_Validate_Read(t *testing.T) {
 			},
 		},
 	})
-}
\ No newline at end of file
+}
+func TestUnitConnectorsDataSource_Validate_Read_Filters(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v1/connectors/metadata/virtual`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connectors/tests/Validate_Read/get_virtual.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v1/connectors/metadata/unblockable`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connectors/tests/Validate_Read/get_unblockable.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerapps.com/providers/Microsoft.PowerApps/apis?%24filter=environment+eq+%27~Default%27&api-version=2023-06-01&hideDlpExemptApis=true&showAllDlpEnforceableApis=true&showApisWithToS=true`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connectors/tests/Validate_Read/get_apis.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerapps.com/providers/Microsoft.PowerApps/apis?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2023-06-01&hideDlpExemptApis=true&showAllDlpEnforceableApis=true&showApisWithToS=true`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connectors/tests/Validate_Read_Filters/get_apis.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerapps.com/providers/Microsoft.PowerApps/apis/shared_sharepointonline?%24filter=environment+eq+%27~Default%27&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connectors/tests/Validate_Read_Filters/get_api_shared_sharepointonline.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_connectors" "environment" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}
+
+				data "powerplatform_connectors" "custom" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					include_custom = true
+					publisher      = "Contoso"
+				}
+
+				data "powerplatform_connectors" "sharepoint" {
+					name_pattern    = "^shared_share"
+					include_actions = true
+				}
+
+				data "powerplatform_connectors" "http" {
+					tier         = "Built-in"
+					name_pattern = "^HTTP$"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.environment", "connectors.#", "4"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.environment", "connectors.0.custom", "false"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.environment", "connectors.0.certification_status", "Certified"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.environment", "connectors.0.release_tag", "Production"),
+					resource.TestCheckNoResourceAttr("data.powerplatform_connectors.environment", "connectors.0.actions.#"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.custom", "connectors.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.custom", "connectors.0.display_name", "Contoso Invoices"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.custom", "connectors.0.custom", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.custom", "connectors.0.virtual", "false"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.custom", "connectors.0.built_in", "false"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.custom", "connectors.0.certification_status", "Uncertified"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.custom", "connectors.0.release_tag", "Preview"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.custom", "connectors.0.tier", "Premium"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.sharepoint", "connectors.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.sharepoint", "connectors.0.name", "shared_sharepointonline"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.sharepoint", "connectors.0.endpoint_filtering_supported", "false"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.sharepoint", "connectors.0.actions.#", "4"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.sharepoint", "connectors.0.actions.0.id", "DeleteItem"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.sharepoint", "connectors.0.actions.0.display_name", "Delete item"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.sharepoint", "connectors.0.actions.0.deprecated", "false"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.sharepoint", "connectors.0.actions.2.id", "GetOnNewItems"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.sharepoint", "connectors.0.actions.2.deprecated", "true"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.http", "connectors.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.http", "connectors.0.name", "Http"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.http", "connectors.0.virtual", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.http", "connectors.0.built_in", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.http", "connectors.0.endpoint_filtering_supported", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitConnectorsDataSource_Validate_Read_Invalid_Name_Pattern(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_connectors" "all" {
+					name_pattern = "shared_("
+				}`,
+				ExpectError: regexp.MustCompile("Invalid regular expression"),
+			},
+		},
+	})
+}
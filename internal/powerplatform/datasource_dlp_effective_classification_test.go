@@ -0,0 +1,189 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestAccDlpEffectiveClassificationDataSource_Validate_Read(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			TestAccPreCheck_Basic(t)
+		},
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mock_helpers.TestName() + `"
+					location         = "europe"
+					environment_type = "Sandbox"
+				}
+
+				resource "powerplatform_data_loss_prevention_policy" "my_policy" {
+					display_name                      = "Block All Policy_1"
+					default_connectors_classification = "Blocked"
+					environment_type                  = "OnlyEnvironments"
+					environments                      = [powerplatform_environment.env.id]
+
+					business_connectors = [
+						{
+							id                           = "/providers/Microsoft.PowerApps/apis/shared_sql"
+							default_action_rule_behavior = ""
+							action_rules                 = []
+							endpoint_rules               = []
+						}
+					]
+					non_business_connectors = []
+					blocked_connectors      = []
+
+					custom_connectors_patterns = toset([
+						{
+							order            = 1
+							host_url_pattern = "*"
+							data_group       = "Blocked"
+						}
+					])
+				}
+
+				data "powerplatform_data_loss_prevention_effective_classification" "effective" {
+					environment_id = powerplatform_environment.env.id
+
+					depends_on = [powerplatform_data_loss_prevention_policy.my_policy]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.powerplatform_data_loss_prevention_effective_classification.effective", "connectors.*", map[string]string{
+						"id": "/providers/Microsoft.PowerApps/apis/shared_sql",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDlpEffectiveClassificationDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/datasource/Validate_Read/get_policies.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies/00000000-0000-0000-0000-000000000001`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/datasource/Validate_Read/get_policy_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies/00000000-0000-0000-0000-000000000002`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/datasource/Validate_Read/get_policy_00000000-0000-0000-0000-000000000002.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_data_loss_prevention_effective_classification" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}
+
+				data "powerplatform_data_loss_prevention_effective_classification" "excepted" {
+					environment_id = "be0eb809-e58a-ec1b-8fce-ea40b0e53442"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "policies.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.#", "6"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.0.id", "/providers/Microsoft.PowerApps/apis/shared_azureblob"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.0.classification", "Business"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.0.policies.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.0.policies.0", "00000000-0000-0000-0000-000000000002"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.0.default_action_rule_behavior", "Block"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.0.action_rules.#", "13"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.0.endpoint_rules.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.0.endpoint_rules.0.order", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.0.endpoint_rules.0.behavior", "Deny"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.0.endpoint_rules.0.endpoint", "*"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.1.id", "/providers/Microsoft.PowerApps/apis/shared_bttn"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.1.classification", "NonBusiness"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.1.policies.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.1.default_action_rule_behavior", ""),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.1.action_rules.#", "0"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.3.id", "/providers/Microsoft.PowerApps/apis/shared_office365users"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.all", "connectors.3.classification", "Business"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.excepted", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.excepted", "policies.0", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.excepted", "connectors.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDlpEffectiveClassificationDataSource_Validate_Read_Environment_Scope(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/datasource/Validate_Read_Environment_Scope/get_policies.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies/00000000-0000-0000-0000-000000000001`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/datasource/Validate_Read_Environment_Scope/get_policy_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies/00000000-0000-0000-0000-000000000003`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/datasource/Validate_Read_Environment_Scope/get_policy_00000000-0000-0000-0000-000000000003.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_data_loss_prevention_effective_classification" "environment" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}
+
+				data "powerplatform_data_loss_prevention_effective_classification" "other" {
+					environment_id = "00000000-0000-0000-0000-000000000002"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.environment", "policies.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.environment", "connectors.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.environment", "connectors.0.id", "/providers/Microsoft.PowerApps/apis/shared_bttn"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.environment", "connectors.0.classification", "NonBusiness"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.environment", "connectors.0.policies.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.environment", "connectors.1.id", "/providers/Microsoft.PowerApps/apis/shared_sql"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.environment", "connectors.1.classification", "Blocked"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.environment", "connectors.1.policies.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.environment", "connectors.1.policies.0", "00000000-0000-0000-0000-000000000003"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.other", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.other", "policies.0", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.other", "connectors.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.other", "connectors.1.id", "/providers/Microsoft.PowerApps/apis/shared_sql"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_effective_classification.other", "connectors.1.classification", "Business"),
+				),
+			},
+		},
+	})
+}
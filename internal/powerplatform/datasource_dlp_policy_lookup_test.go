@@ -0,0 +1,138 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestUnitDlpPolicyLookupDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/datasource/Validate_Read/get_policies.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies/00000000-0000-0000-0000-000000000001`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/datasource/Validate_Read/get_policy_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies/00000000-0000-0000-0000-000000000002`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/datasource/Validate_Read/get_policy_00000000-0000-0000-0000-000000000002.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_data_loss_prevention_policy" "by_id" {
+					id = "00000000-0000-0000-0000-000000000002"
+				}
+
+				data "powerplatform_data_loss_prevention_policy" "by_display_name" {
+					display_name = "a1"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policy.by_id", "id", "00000000-0000-0000-0000-000000000002"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policy.by_id", "display_name", "a2"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policy.by_id", "environment_type", "ExceptEnvironments"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policy.by_id", "environments.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policy.by_id", "environments.0", "be0eb809-e58a-ec1b-8fce-ea40b0e53442"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policy.by_id", "business_connectors.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policy.by_id", "non_business_connectors.#", "4"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policy.by_id", "custom_connectors_patterns.#", "2"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policy.by_display_name", "id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policy.by_display_name", "display_name", "a1"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policy.by_display_name", "environment_type", "AllEnvironments"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policy.by_display_name", "default_connectors_classification", "General"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDlpPolicyLookupDataSource_Validate_Not_Found(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, `{"value":[]}`), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies/00000000-0000-0000-0000-000000000009`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNotFound, `{"error":{"code":"PolicyNotFound"}}`), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies/00000000-0000-0000-0000-000000000004`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusInternalServerError, `{"error":{"code":"InternalServerError"}}`), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_data_loss_prevention_policy" "by_display_name" {
+					display_name = "missing"
+				}`,
+
+				ExpectError: regexp.MustCompile("No policy with display name 'missing' exists"),
+			},
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_data_loss_prevention_policy" "by_id" {
+					id = "00000000-0000-0000-0000-000000000009"
+				}`,
+
+				ExpectError: regexp.MustCompile("No policy with id '00000000-0000-0000-0000-000000000009' exists"),
+			},
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_data_loss_prevention_policy" "by_id" {
+					id = "00000000-0000-0000-0000-000000000004"
+				}`,
+
+				ExpectError: regexp.MustCompile("Client error when reading"),
+			},
+		},
+	})
+}
+
+func TestUnitDlpPolicyLookupDataSource_Validate_Id_Or_Display_Name(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_data_loss_prevention_policy" "policy" {
+					id           = "00000000-0000-0000-0000-000000000001"
+					display_name = "a1"
+				}`,
+
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
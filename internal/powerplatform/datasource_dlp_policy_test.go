@@ -55,6 +55,7 @@ func TestUnitDlpPolicyDataSource_Validate_Read(t *testing.T) {
 					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.all", "policies.0.default_connectors_classification", "General"),
 					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.all", "policies.0.id", "00000000-0000-0000-0000-000000000001"),
 					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.all", "policies.0.display_name", "a1"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.all", "policies.0.scope", "tenant"),
 					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.all", "policies.0.last_modified_by", "admin"),
 					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.all", "policies.0.last_modified_time", "2023-10-02T07:38:50.3269899Z"),
 					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.all", "policies.0.environment_type", "AllEnvironments"),
@@ -102,3 +103,149 @@ func TestUnitDlpPolicyDataSource_Validate_Read(t *testing.T) {
 		},
 	})
 }
+
+func TestUnitDlpPolicyDataSource_Validate_Read_Filters(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/datasource/Validate_Read/get_policies.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies/00000000-0000-0000-0000-000000000001`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/datasource/Validate_Read/get_policy_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies/00000000-0000-0000-0000-000000000002`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/datasource/Validate_Read/get_policy_00000000-0000-0000-0000-000000000002.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_data_loss_prevention_policies" "by_display_name" {
+					display_name = "a2"
+				}
+
+				data "powerplatform_data_loss_prevention_policies" "by_display_name_regex" {
+					display_name_regex = "^a[0-9]$"
+				}
+
+				data "powerplatform_data_loss_prevention_policies" "by_environment_type" {
+					environment_type = "AllEnvironments"
+				}
+
+				data "powerplatform_data_loss_prevention_policies" "by_excluded_environment" {
+					environment_id = "be0eb809-e58a-ec1b-8fce-ea40b0e53442"
+				}
+
+				data "powerplatform_data_loss_prevention_policies" "by_environment" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}
+
+				data "powerplatform_data_loss_prevention_policies" "by_connector" {
+					connector_id = "/providers/Microsoft.PowerApps/apis/shared_sql"
+				}
+
+				data "powerplatform_data_loss_prevention_policies" "none" {
+					display_name_regex = "^b"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.by_display_name", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.by_display_name", "policies.0.id", "00000000-0000-0000-0000-000000000002"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.by_display_name_regex", "policies.#", "2"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.by_environment_type", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.by_environment_type", "policies.0.id", "00000000-0000-0000-0000-000000000001"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.by_excluded_environment", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.by_excluded_environment", "policies.0.id", "00000000-0000-0000-0000-000000000001"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.by_environment", "policies.#", "2"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.by_connector", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.by_connector", "policies.0.id", "00000000-0000-0000-0000-000000000002"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.none", "id", "0"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.none", "policies.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDlpPolicyDataSource_Validate_Read_Invalid_Regex(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_data_loss_prevention_policies" "all" {
+					display_name_regex = "a("
+				}`,
+
+				ExpectError: regexp.MustCompile("Invalid regular expression"),
+			},
+		},
+	})
+}
+
+func TestUnitDlpPolicyDataSource_Validate_Read_Scope(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/datasource/Validate_Read_Scope/get_policies.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies/00000000-0000-0000-0000-000000000001`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/datasource/Validate_Read_Scope/get_policy_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies/00000000-0000-0000-0000-000000000003`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/datasource/Validate_Read_Scope/get_policy_00000000-0000-0000-0000-000000000003.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_data_loss_prevention_policies" "all" {}
+
+				data "powerplatform_data_loss_prevention_policy" "environment" {
+					id = "00000000-0000-0000-0000-000000000003"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.all", "policies.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.all", "policies.0.id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.all", "policies.0.scope", "tenant"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.all", "policies.1.id", "00000000-0000-0000-0000-000000000003"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.all", "policies.1.scope", "environment"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.all", "policies.1.environments.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policies.all", "policies.1.environments.0", "00000000-0000-0000-0000-000000000001"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policy.environment", "display_name", "Environment policy"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_loss_prevention_policy.environment", "scope", "environment"),
+				),
+			},
+		},
+	})
+}
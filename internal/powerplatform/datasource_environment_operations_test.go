@@ -0,0 +1,120 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+func TestAccEnvironmentOperationsDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck_Basic(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_environments" "all" {}
+
+				data "powerplatform_environment_operations" "operations" {
+					environment_id = data.powerplatform_environments.all.environments[0].id
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.powerplatform_environment_operations.operations", "id", "data.powerplatform_environments.all", "environments.0.id"),
+					resource.TestMatchResourceAttr("data.powerplatform_environment_operations.operations", "operations.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestMatchResourceAttr("data.powerplatform_environment_operations.operations", "operations.0.id", regexp.MustCompile(powerplatform_helpers.StringRegex)),
+					resource.TestMatchResourceAttr("data.powerplatform_environment_operations.operations", "operations.0.type", regexp.MustCompile(powerplatform_helpers.StringRegex)),
+					resource.TestMatchResourceAttr("data.powerplatform_environment_operations.operations", "operations.0.state", regexp.MustCompile(powerplatform_helpers.StringRegex)),
+					resource.TestMatchResourceAttr("data.powerplatform_environment_operations.operations", "operations.0.created_time", regexp.MustCompile(powerplatform_helpers.StringRegex)),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitEnvironmentOperationsDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001/lifecycleOperations?api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/environment/tests/datasource/Validate_Read_Operations/get_lifecycle_operations_1.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001/lifecycleOperations?api-version=2023-06-01&skiptoken=2`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/environment/tests/datasource/Validate_Read_Operations/get_lifecycle_operations_2.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_environment_operations" "operations" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.#", "3"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.0.id", "5b7c1b9e-2f6e-4c64-9a5f-1d0a2f3e4b5c"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.0.type", "Reset"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.0.state", "Failed"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.0.created_time", "2024-01-20T10:15:00.1234567Z"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.0.last_action_time", "2024-01-20T10:17:12.7654321Z"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.0.requested_by.id", "f99f844b-ce3b-49ae-86f3-e374ecae789c"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.0.requested_by.display_name", "admin"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.0.requested_by.type", "User"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.0.stages.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.0.stages.1.name", "Run"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.0.stages.1.state", "Failed"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.0.stages.1.first_action_time", "2024-01-20T10:15:01.5000000Z"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.0.stages.1.last_action_time", "2024-01-20T10:17:12.7654321Z"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.1.type", "Copy"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.1.stages.#", "1"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.2.type", "Create"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.2.state", "Succeeded"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.2.requested_by.type", "ServicePrincipal"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.2.stages.#", "4"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_operations.operations", "operations.2.stages.0.name", "Validate"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitEnvironmentOperationsDataSource_Validate_Not_Found(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000002/lifecycleOperations?api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNotFound, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_environment_operations" "operations" {
+					environment_id = "00000000-0000-0000-0000-000000000002"
+				}`,
+				ExpectError: regexp.MustCompile("No environment with id '00000000-0000-0000-0000-000000000002' exists"),
+			},
+		},
+	})
+}
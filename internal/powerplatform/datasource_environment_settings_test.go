@@ -86,6 +86,17 @@ func TestUnitTestEnvironmentSettingsDataSource_Validate_Read(t *testing.T) {
 					resource.TestCheckResourceAttr("data.powerplatform_environment_settings.settings", "audit_and_logs.plugin_trace_log_setting", "Off"),
 					resource.TestCheckResourceAttr("data.powerplatform_environment_settings.settings", "product.behavior_settings.show_dashboard_cards_in_expanded_state", "true"),
 					resource.TestCheckResourceAttr("data.powerplatform_environment_settings.settings", "product.features.power_apps_component_framework_for_canvas_apps", "false"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_settings.settings", "audit_and_logs.audit_settings.log_retention_period_in_days", "30"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_settings.settings", "security.ip_firewall.enabled", "false"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_settings.settings", "security.ip_firewall.audit_only", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_settings.settings", "security.ip_firewall.allowed_ip_ranges.#", "0"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_settings.settings", "security.ip_firewall.allow_application_users", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_settings.settings", "security.ip_based_cookie_binding_enabled", "false"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_settings.settings", "security.session_timeout.enabled", "false"),
+					resource.TestCheckNoResourceAttr("data.powerplatform_environment_settings.settings", "security.session_timeout.timeout_in_minutes"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_settings.settings", "security.blocked_file_extensions.#", "96"),
+					resource.TestCheckTypeSetElemAttr("data.powerplatform_environment_settings.settings", "security.blocked_file_extensions.*", "exe"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_settings.settings", "security.blocked_mime_types.#", "0"),
 				),
 			},
 		},
@@ -0,0 +1,150 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestAccEnvironmentDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck_Basic(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment" "development" {
+					display_name     = "` + mock_helpers.TestName() + `"
+					location         = "europe"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				data "powerplatform_environment" "by_id" {
+					id = powerplatform_environment.development.id
+				}
+
+				data "powerplatform_environment" "by_domain_name" {
+					domain_name = powerplatform_environment.development.dataverse.domain
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.powerplatform_environment.by_id", "id", "powerplatform_environment.development", "id"),
+					resource.TestCheckResourceAttrPair("data.powerplatform_environment.by_id", "domain_name", "powerplatform_environment.development", "dataverse.domain"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "environment_type", "Sandbox"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "dataverse.currency_code", "USD"),
+					resource.TestMatchResourceAttr("data.powerplatform_environment.by_id", "tenant_id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("data.powerplatform_environment.by_id", "created_time", regexp.MustCompile(powerplatform_helpers.StringRegex)),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "provisioning_state", "Succeeded"),
+
+					resource.TestCheckResourceAttrPair("data.powerplatform_environment.by_domain_name", "id", "powerplatform_environment.development", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitEnvironmentDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments?%24expand=properties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/environment/tests/datasource/Validate_Read_Single/get_environments.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/environment/tests/datasource/Validate_Read_Single/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_environment" "by_id" {
+					id = "00000000-0000-0000-0000-000000000001"
+				}
+
+				data "powerplatform_environment" "by_domain_name" {
+					domain_name = "00000000-0000-0000-0000-000000000001"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "domain_name", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "display_name", "displayname"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "environment_type", "Sandbox"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "location", "europe"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "azure_region", "westeurope"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "billing_policy_id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "states.management", "Ready"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "states.runtime", "Enabled"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "provisioning_state", "Succeeded"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "tenant_id", "123"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "protection_level", "Basic"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "created_by", "admin"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "created_time", "2023-09-27T07:08:27.6057592Z"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "dataverse.url", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_id", "dataverse.currency_code", "PLN"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_domain_name", "id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_domain_name", "domain_name", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_domain_name", "display_name", "Admin AdminOnMicrosoft's Environment"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_domain_name", "environment_type", "Developer"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment.by_domain_name", "created_by", "SYSTEM"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitEnvironmentDataSource_Validate_Not_Found(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments?%24expand=properties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/environment/tests/datasource/Validate_Read_Single/get_environments.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_environment" "by_domain_name" {
+					domain_name = "contoso"
+				}`,
+				ExpectError: regexp.MustCompile("No environment with domain name 'contoso' exists"),
+			},
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_environment" "both" {
+					id          = "00000000-0000-0000-0000-000000000001"
+					domain_name = "00000000-0000-0000-0000-000000000001"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
@@ -99,6 +99,13 @@ func TestUnitEnvironmentsDataSource_Validate_Read(t *testing.T) {
 					resource.TestCheckNoResourceAttr("data.powerplatform_environments.all", "environments.0.dataverse.linked_app_url"),
 					resource.TestCheckNoResourceAttr("data.powerplatform_environments.all", "environments.0.dataverse.templates"),
 					resource.TestCheckNoResourceAttr("data.powerplatform_environments.all", "environments.0.dataverse.template_metadata"),
+					resource.TestCheckResourceAttr("data.powerplatform_environments.all", "environments.0.states.management", "NotSpecified"),
+					resource.TestCheckResourceAttr("data.powerplatform_environments.all", "environments.0.states.runtime", "Enabled"),
+					resource.TestCheckResourceAttr("data.powerplatform_environments.all", "environments.0.provisioning_state", "Succeeded"),
+					resource.TestCheckResourceAttr("data.powerplatform_environments.all", "environments.0.tenant_id", "00000000-0000-0000-0000-000000000002"),
+					resource.TestCheckResourceAttr("data.powerplatform_environments.all", "environments.0.protection_level", "Basic"),
+					resource.TestCheckResourceAttr("data.powerplatform_environments.all", "environments.0.created_by", "SYSTEM"),
+					resource.TestCheckResourceAttr("data.powerplatform_environments.all", "environments.0.created_time", "2023-02-15T08:02:36.1799125Z"),
 
 					resource.TestCheckResourceAttr("data.powerplatform_environments.all", "environments.1.display_name", "displayname"),
 					resource.TestCheckResourceAttr("data.powerplatform_environments.all", "environments.1.id", "00000000-0000-0000-0000-000000000002"),
@@ -113,8 +120,75 @@ func TestUnitEnvironmentsDataSource_Validate_Read(t *testing.T) {
 					resource.TestCheckNoResourceAttr("data.powerplatform_environments.all", "environments.1.dataverse.url"),
 					resource.TestCheckNoResourceAttr("data.powerplatform_environments.all", "environments.1.dataverse.version"),
 					resource.TestCheckNoResourceAttr("data.powerplatform_environments.all", "environments.1.dataverse.currency_code"),
+					resource.TestCheckResourceAttr("data.powerplatform_environments.all", "environments.1.states.management", "Ready"),
+					resource.TestCheckResourceAttr("data.powerplatform_environments.all", "environments.1.created_by", "admin"),
+					resource.TestCheckResourceAttr("data.powerplatform_environments.all", "environments.1.created_time", "2023-09-27T07:08:27.6057592Z"),
 				),
 			},
 		},
 	})
 }
+
+func TestUnitEnvironmentsDataSource_Validate_Read_With_Filters(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments?%24expand=properties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/environment/tests/datasource/Validate_Read_With_Filters/get_environments.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/environment/tests/datasource/Validate_Read_With_Filters/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_environments" "developer" {
+					environment_type   = "Developer"
+					location           = "europe"
+					display_name_regex = "^Admin"
+					has_dataverse      = true
+					billing_policy_id  = "00000000-0000-0000-0000-000000000001"
+				}
+
+				data "powerplatform_environments" "managed" {
+					is_managed    = true
+					has_dataverse = false
+					created_after = "2023-06-01T00:00:00Z"
+				}
+
+				data "powerplatform_environments" "none" {
+					environment_type = "Production"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_environments.developer", "environments.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_environments.developer", "environments.0.id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_environments.developer", "environments.0.dataverse.currency_code", "PLN"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_environments.managed", "environments.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_environments.managed", "environments.0.id", "00000000-0000-0000-0000-000000000002"),
+					resource.TestCheckResourceAttr("data.powerplatform_environments.managed", "environments.0.protection_level", "Standard"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_environments.none", "id", "0"),
+					resource.TestCheckResourceAttr("data.powerplatform_environments.none", "environments.#", "0"),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_environments" "invalid" {
+					created_after = "2023-06-01"
+				}`,
+				ExpectError: regexp.MustCompile("is not a valid RFC3339 time"),
+			},
+		},
+	})
+}
@@ -58,6 +58,7 @@ func TestUnitSolutionsDataSource_Validate_Read(t *testing.T) {
 					resource.TestCheckResourceAttr("data.powerplatform_solutions.all", "solutions.0.is_managed", "true"),
 					resource.TestCheckResourceAttr("data.powerplatform_solutions.all", "solutions.0.version", "9.2.1.1020"),
 					resource.TestCheckResourceAttr("data.powerplatform_solutions.all", "solutions.0.id", "70edca66-e4c2-4384-92e0-4300465c1894"),
+					resource.TestCheckNoResourceAttr("data.powerplatform_solutions.all", "solutions.0.parent_solution_name"),
 				),
 			},
 		},
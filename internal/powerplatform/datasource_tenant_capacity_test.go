@@ -0,0 +1,101 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+func TestAccTenantCapacityDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck_Basic(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_environments" "all" {}
+
+				data "powerplatform_tenant_capacity" "capacity" {
+					tenant_id = data.powerplatform_environments.all.environments[0].tenant_id
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.powerplatform_tenant_capacity.capacity", "license_model_type", regexp.MustCompile(powerplatform_helpers.StringRegex)),
+					resource.TestMatchResourceAttr("data.powerplatform_tenant_capacity.capacity", "tenant_capacities.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestMatchResourceAttr("data.powerplatform_tenant_capacity.capacity", "tenant_capacities.0.capacity_type", regexp.MustCompile(powerplatform_helpers.StringRegex)),
+					resource.TestMatchResourceAttr("data.powerplatform_tenant_capacity.capacity", "environments.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestMatchResourceAttr("data.powerplatform_tenant_capacity.capacity", "environments.0.id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitTenantCapacityDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://licensing.powerplatform.microsoft.com/v0.1-alpha/tenants/00000000-0000-0000-0000-000000000001/TenantCapacity`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/capacity/tests/datasource/Validate_Read/get_tenant_capacity.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments?%24expand=properties.capacity&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/capacity/tests/datasource/Validate_Read/get_environments.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_tenant_capacity" "capacity" {
+					tenant_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "tenant_id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "license_model_type", "StorageDriven"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "tenant_capacities.#", "3"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "tenant_capacities.0.capacity_type", "Database"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "tenant_capacities.0.capacity_units", "MB"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "tenant_capacities.0.total_capacity", "30720"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "tenant_capacities.0.max_capacity", "30720"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "tenant_capacities.0.status", "Available"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "tenant_capacities.0.consumption.actual", "3542.25"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "tenant_capacities.0.consumption.rated", "4096"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "tenant_capacities.0.consumption.actual_updated_on", "2024-05-10T03:00:35Z"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "tenant_capacities.0.overflow_capacity.#", "1"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "tenant_capacities.2.capacity_type", "Log"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "tenant_capacities.2.status", "Overflow"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "tenant_capacities.2.overflow_capacity.0.capacity_type", "Database"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "tenant_capacities.2.overflow_capacity.0.value", "105.5"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "environments.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "environments.0.id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "environments.0.display_name", "Production"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "environments.0.environment_type", "Production"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "environments.0.database_used_mb", "885.0391"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "environments.0.file_used_mb", "1187.142"),
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "environments.0.log_used_mb", "1536"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_tenant_capacity.capacity", "environments.1.id", "00000000-0000-0000-0000-000000000002"),
+					resource.TestCheckNoResourceAttr("data.powerplatform_tenant_capacity.capacity", "environments.1.database_used_mb"),
+					resource.TestCheckNoResourceAttr("data.powerplatform_tenant_capacity.capacity", "environments.1.file_used_mb"),
+					resource.TestCheckNoResourceAttr("data.powerplatform_tenant_capacity.capacity", "environments.1.log_used_mb"),
+				),
+			},
+		},
+	})
+}
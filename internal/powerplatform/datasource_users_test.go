@@ -0,0 +1,156 @@
+package powerplatform
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestAccUsersDataSource_Validate_Read(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck_Basic(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment" "users_example" {
+					display_name      = "` + mock_helpers.TestName() + `"
+					location          = "europe"
+					environment_type  = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				data "powerplatform_users" "administrators" {
+					environment_ids    = [powerplatform_environment.users_example.id]
+					disabled           = false
+					security_role_name = "System Administrator"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.powerplatform_users.administrators", "users.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestCheckResourceAttrPair("data.powerplatform_users.administrators", "users.0.environment_id", "powerplatform_environment.users_example", "id"),
+					resource.TestMatchResourceAttr("data.powerplatform_users.administrators", "users.0.id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.0.disabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitUsersDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/datasource/users/Validate_Read/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000002?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/datasource/users/Validate_Read/get_environment_00000000-0000-0000-0000-000000000002.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29&%24filter=isdisabled+eq+false",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/datasource/users/Validate_Read/get_systemusers_00000000-0000-0000-0000-000000000001_1.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers?%24skiptoken=2",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/datasource/users/Validate_Read/get_systemusers_00000000-0000-0000-0000-000000000001_2.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000002.crm4.dynamics.com/api/data/v9.2/systemusers?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29&%24filter=isdisabled+eq+false",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/datasource/users/Validate_Read/get_systemusers_00000000-0000-0000-0000-000000000002.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29&%24filter=accessmode+eq+4+and+applicationid+ne+null",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/datasource/users/Validate_Read/get_application_users.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_users" "all" {
+					environment_id  = "00000000-0000-0000-0000-000000000001"
+					environment_ids = ["00000000-0000-0000-0000-000000000002"]
+				}`,
+
+				ExpectError: regexp.MustCompile("Exactly one of `environment_id` and `environment_ids` has to be set"),
+			},
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_users" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					aad_object_id  = "not-a-guid"
+				}`,
+
+				ExpectError: regexp.MustCompile(`aad_object_id\s+must\s+be\s+a\s+valid\s+GUID`),
+			},
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_users" "administrators" {
+					environment_ids    = ["00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000002"]
+					disabled           = false
+					security_role_name = "system administrator"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "id", "00000000-0000-0000-0000-000000000001_00000000-0000-0000-0000-000000000002"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.#", "3"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.0.environment_id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.0.id", "00000000-0000-0000-0001-000000000101"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.0.aad_object_id", "00000000-0000-0000-0000-000000000201"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.0.user_principal_name", "adele.vance@contoso.onmicrosoft.com"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.0.full_name", "Adele Vance"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.0.business_unit_id", "00000000-0000-0000-0001-000000000010"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.0.disabled", "false"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.0.access_mode", "ReadWrite"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.0.application_id", ""),
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.0.security_roles.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.0.security_roles.0", "00000000-0000-0000-0001-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.0.security_role_names.1", "Basic User"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.1.environment_id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.1.id", "00000000-0000-0000-0001-000000000103"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.2.environment_id", "00000000-0000-0000-0000-000000000002"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.2.id", "00000000-0000-0000-0002-000000000104"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.administrators", "users.2.security_roles.0", "00000000-0000-0000-0002-000000000001"),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				data "powerplatform_users" "application_users" {
+					environment_id         = "00000000-0000-0000-0000-000000000001"
+					access_mode            = "NonInteractive"
+					application_users_only = true
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_users.application_users", "id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.application_users", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.application_users", "users.0.application_id", "00000000-0000-0000-0000-000000000300"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.application_users", "users.0.access_mode", "NonInteractive"),
+					resource.TestCheckResourceAttr("data.powerplatform_users.application_users", "users.0.full_name", "# Orders Integration"),
+				),
+			},
+		},
+	})
+}
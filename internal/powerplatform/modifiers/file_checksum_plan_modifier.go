@@ -0,0 +1,61 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform_modifiers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+func FileChecksumPlanModifier(fileAttribute string) planmodifier.String {
+	return &fileChecksumPlanModifier{
+		fileAttribute: fileAttribute,
+	}
+}
+
+type fileChecksumPlanModifier struct {
+	fileAttribute string
+}
+
+func (d *fileChecksumPlanModifier) Description(ctx context.Context) string {
+	return "Plans the checksum of the current file content, so that changes to the content of a file trigger an update."
+}
+
+func (d *fileChecksumPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return d.Description(ctx)
+}
+
+func (d *fileChecksumPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var file types.String
+	diags := req.Plan.GetAttribute(ctx, path.Root(d.fileAttribute), &file)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if file.IsNull() {
+		resp.PlanValue = types.StringNull()
+	} else if file.IsUnknown() {
+		resp.PlanValue = types.StringUnknown()
+	} else {
+		value, err := powerplatform_helpers.CalculateMd5(file.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error calculating MD5 checksum for %s", d.fileAttribute), err.Error())
+			return
+		}
+
+		if value == "" {
+			//file does not exist yet, it may be created during apply
+			resp.PlanValue = types.StringUnknown()
+		} else {
+			resp.PlanValue = types.StringValue(value)
+		}
+	}
+}
@@ -19,6 +19,8 @@ import (
 	config "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/config"
 	application "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/application"
 	auth "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/authorization"
+	capacity "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/capacity"
+	connection "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/connection"
 	connectors "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/connectors"
 	currencies "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/currencies"
 	data_record "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/data_record"
@@ -30,6 +32,7 @@ import (
 	licensing "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/licensing"
 	locations "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/locations"
 	managed_environment "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/managed_environment"
+	pipeline "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/pipeline"
 	powerapps "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/powerapps"
 	solution "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/solution"
 	tenant_settings "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/tenant_settings"
@@ -47,11 +50,14 @@ func NewPowerPlatformProvider(ctx context.Context, testModeEnabled ...bool) func
 	config := config.ProviderConfig{
 		Credentials: &cred,
 		Urls: config.ProviderConfigUrls{
-			BapiUrl:            constants.PUBLIC_BAPI_DOMAIN,
-			PowerAppsUrl:       constants.PUBLIC_POWERAPPS_API_DOMAIN,
-			PowerAppsScope:     constants.PUBLIC_POWERAPPS_SCOPE,
-			PowerPlatformUrl:   constants.PUBLIC_POWERPLATFORM_API_DOMAIN,
-			PowerPlatformScope: constants.PUBLIC_POWERPLATFORM_API_SCOPE,
+			BapiUrl:               constants.PUBLIC_BAPI_DOMAIN,
+			PowerAppsUrl:          constants.PUBLIC_POWERAPPS_API_DOMAIN,
+			PowerAppsScope:        constants.PUBLIC_POWERAPPS_SCOPE,
+			PowerPlatformUrl:      constants.PUBLIC_POWERPLATFORM_API_DOMAIN,
+			PowerPlatformScope:    constants.PUBLIC_POWERPLATFORM_API_SCOPE,
+			PowerAppsAdvisorUrl:   constants.PUBLIC_POWERAPPS_ADVISOR_DOMAIN,
+			PowerAppsAdvisorScope: constants.PUBLIC_POWERAPPS_ADVISOR_SCOPE,
+			LicensingUrl:          constants.PUBLIC_LICENSING_API_DOMAIN,
 		},
 		Cloud: azcloud.AzurePublic,
 	}
@@ -298,6 +304,9 @@ func (p *PowerPlatformProvider) Configure(ctx context.Context, req provider.Conf
 		p.Config.Urls.PowerAppsScope = constants.PUBLIC_POWERAPPS_SCOPE
 		p.Config.Urls.PowerPlatformUrl = constants.PUBLIC_POWERPLATFORM_API_DOMAIN
 		p.Config.Urls.PowerPlatformScope = constants.PUBLIC_POWERPLATFORM_API_SCOPE
+		p.Config.Urls.PowerAppsAdvisorUrl = constants.PUBLIC_POWERAPPS_ADVISOR_DOMAIN
+		p.Config.Urls.PowerAppsAdvisorScope = constants.PUBLIC_POWERAPPS_ADVISOR_SCOPE
+		p.Config.Urls.LicensingUrl = constants.PUBLIC_LICENSING_API_DOMAIN
 		p.Config.Cloud = azcloud.AzurePublic
 	case "gcc":
 		p.Config.Urls.BapiUrl = constants.USGOV_BAPI_DOMAIN
@@ -305,6 +314,9 @@ func (p *PowerPlatformProvider) Configure(ctx context.Context, req provider.Conf
 		p.Config.Urls.PowerAppsScope = constants.USGOV_POWERAPPS_SCOPE
 		p.Config.Urls.PowerPlatformUrl = constants.USGOV_POWERPLATFORM_API_DOMAIN
 		p.Config.Urls.PowerPlatformScope = constants.USGOV_POWERPLATFORM_API_SCOPE
+		p.Config.Urls.PowerAppsAdvisorUrl = constants.USGOV_POWERAPPS_ADVISOR_DOMAIN
+		p.Config.Urls.PowerAppsAdvisorScope = constants.USGOV_POWERAPPS_ADVISOR_SCOPE
+		p.Config.Urls.LicensingUrl = constants.USGOV_LICENSING_API_DOMAIN
 		p.Config.Cloud = azcloud.AzurePublic //GCC uses public cloud for authentication
 	case "gcchigh":
 		p.Config.Urls.BapiUrl = constants.USGOVHIGH_BAPI_DOMAIN
@@ -312,6 +324,9 @@ func (p *PowerPlatformProvider) Configure(ctx context.Context, req provider.Conf
 		p.Config.Urls.PowerAppsScope = constants.USGOVHIGH_POWERAPPS_SCOPE
 		p.Config.Urls.PowerPlatformUrl = constants.USGOVHIGH_POWERPLATFORM_API_DOMAIN
 		p.Config.Urls.PowerPlatformScope = constants.USGOVHIGH_POWERPLATFORM_API_SCOPE
+		p.Config.Urls.PowerAppsAdvisorUrl = constants.USGOVHIGH_POWERAPPS_ADVISOR_DOMAIN
+		p.Config.Urls.PowerAppsAdvisorScope = constants.USGOVHIGH_POWERAPPS_ADVISOR_SCOPE
+		p.Config.Urls.LicensingUrl = constants.USGOVHIGH_LICENSING_API_DOMAIN
 		p.Config.Cloud = azcloud.AzureGovernment
 	case "dod":
 		p.Config.Urls.BapiUrl = constants.USDOD_BAPI_DOMAIN
@@ -319,6 +334,9 @@ func (p *PowerPlatformProvider) Configure(ctx context.Context, req provider.Conf
 		p.Config.Urls.PowerAppsScope = constants.USDOD_POWERAPPS_SCOPE
 		p.Config.Urls.PowerPlatformUrl = constants.USDOD_POWERPLATFORM_API_DOMAIN
 		p.Config.Urls.PowerPlatformScope = constants.USDOD_POWERPLATFORM_API_SCOPE
+		p.Config.Urls.PowerAppsAdvisorUrl = constants.USDOD_POWERAPPS_ADVISOR_DOMAIN
+		p.Config.Urls.PowerAppsAdvisorScope = constants.USDOD_POWERAPPS_ADVISOR_SCOPE
+		p.Config.Urls.LicensingUrl = constants.USDOD_LICENSING_API_DOMAIN
 		p.Config.Cloud = azcloud.AzureGovernment
 	case "china":
 		p.Config.Urls.BapiUrl = constants.CHINA_BAPI_DOMAIN
@@ -326,6 +344,9 @@ func (p *PowerPlatformProvider) Configure(ctx context.Context, req provider.Conf
 		p.Config.Urls.PowerAppsScope = constants.CHINA_POWERAPPS_SCOPE
 		p.Config.Urls.PowerPlatformUrl = constants.CHINA_POWERPLATFORM_API_DOMAIN
 		p.Config.Urls.PowerPlatformScope = constants.CHINA_POWERPLATFORM_API_SCOPE
+		p.Config.Urls.PowerAppsAdvisorUrl = constants.CHINA_POWERAPPS_ADVISOR_DOMAIN
+		p.Config.Urls.PowerAppsAdvisorScope = constants.CHINA_POWERAPPS_ADVISOR_SCOPE
+		p.Config.Urls.LicensingUrl = constants.CHINA_LICENSING_API_DOMAIN
 		p.Config.Cloud = azcloud.AzureChina
 	case "ex":
 		p.Config.Urls.BapiUrl = constants.EX_BAPI_DOMAIN
@@ -333,6 +354,9 @@ func (p *PowerPlatformProvider) Configure(ctx context.Context, req provider.Conf
 		p.Config.Urls.PowerAppsScope = constants.EX_POWERAPPS_SCOPE
 		p.Config.Urls.PowerPlatformUrl = constants.EX_POWERPLATFORM_API_DOMAIN
 		p.Config.Urls.PowerPlatformScope = constants.EX_POWERPLATFORM_API_SCOPE
+		p.Config.Urls.PowerAppsAdvisorUrl = constants.EX_POWERAPPS_ADVISOR_DOMAIN
+		p.Config.Urls.PowerAppsAdvisorScope = constants.EX_POWERAPPS_ADVISOR_SCOPE
+		p.Config.Urls.LicensingUrl = constants.EX_LICENSING_API_DOMAIN
 		p.Config.Cloud = azcloud.Configuration{
 			ActiveDirectoryAuthorityHost: constants.EX_AUTHORITY_HOST,
 			Services:                     map[azcloud.ServiceName]azcloud.ServiceConfiguration{},
@@ -343,6 +367,9 @@ func (p *PowerPlatformProvider) Configure(ctx context.Context, req provider.Conf
 		p.Config.Urls.PowerAppsScope = constants.RX_POWERAPPS_SCOPE
 		p.Config.Urls.PowerPlatformUrl = constants.RX_POWERPLATFORM_API_DOMAIN
 		p.Config.Urls.PowerPlatformScope = constants.RX_POWERPLATFORM_API_SCOPE
+		p.Config.Urls.PowerAppsAdvisorUrl = constants.RX_POWERAPPS_ADVISOR_DOMAIN
+		p.Config.Urls.PowerAppsAdvisorScope = constants.RX_POWERAPPS_ADVISOR_SCOPE
+		p.Config.Urls.LicensingUrl = constants.RX_LICENSING_API_DOMAIN
 		p.Config.Cloud = azcloud.Configuration{
 			ActiveDirectoryAuthorityHost: constants.RX_AUTHORITY_HOST,
 			Services:                     map[azcloud.ServiceName]azcloud.ServiceConfiguration{},
@@ -374,25 +401,47 @@ func (p *PowerPlatformProvider) Resources(ctx context.Context) []func() resource
 		func() resource.Resource { return application.NewEnvironmentApplicationPackageInstallResource() },
 		func() resource.Resource { return dlp_policy.NewDataLossPreventionPolicyResource() },
 		func() resource.Resource { return solution.NewSolutionResource() },
+		func() resource.Resource { return solution.NewSolutionPatchResource() },
+		func() resource.Resource { return solution.NewSolutionRollupResource() },
+		func() resource.Resource { return pipeline.NewPipelineResource() },
+		func() resource.Resource { return pipeline.NewPipelineRunResource() },
+		func() resource.Resource { return connectors.NewCustomConnectorResource() },
+		func() resource.Resource { return connection.NewConnectionResource() },
+		func() resource.Resource { return connection.NewConnectionShareResource() },
 		func() resource.Resource { return tenant_settings.NewTenantSettingsResource() },
 		func() resource.Resource { return managed_environment.NewManagedEnvironmentResource() },
 		func() resource.Resource { return licensing.NewBillingPolicyEnvironmentResource() },
 		func() resource.Resource { return licensing.NewBillingPolicyResource() },
 		func() resource.Resource { return auth.NewUserResource() },
+		func() resource.Resource { return auth.NewApplicationUserResource() },
+		func() resource.Resource { return auth.NewTeamResource() },
+		func() resource.Resource { return auth.NewTeamMembershipResource() },
+		func() resource.Resource { return auth.NewBusinessUnitResource() },
+		func() resource.Resource { return auth.NewSecurityRoleResource() },
+		func() resource.Resource { return auth.NewEnvironmentRoleAssignmentResource() },
 		func() resource.Resource { return data_record.NewDataRecordResource() },
 		func() resource.Resource { return env_settings.NewEnvironmentSettingsResource() },
+		func() resource.Resource { return env_settings.NewEnvironmentOrganizationSettingsResource() },
 	}
 }
 
 func (p *PowerPlatformProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		func() datasource.DataSource { return connectors.NewConnectorsDataSource() },
+		func() datasource.DataSource { return connection.NewConnectionsDataSource() },
 		func() datasource.DataSource { return application.NewEnvironmentApplicationPackagesDataSource() },
 		func() datasource.DataSource { return powerapps.NewEnvironmentPowerAppsDataSource() },
 		func() datasource.DataSource { return environment.NewEnvironmentsDataSource() },
+		func() datasource.DataSource { return environment.NewEnvironmentDataSource() },
+		func() datasource.DataSource { return environment.NewEnvironmentOperationsDataSource() },
+		func() datasource.DataSource { return capacity.NewTenantCapacityDataSource() },
 		func() datasource.DataSource { return environment_templates.NewEnvironmentTemplatesDataSource() },
 		func() datasource.DataSource { return solution.NewSolutionsDataSource() },
 		func() datasource.DataSource { return dlp_policy.NewDataLossPreventionPolicyDataSource() },
+		func() datasource.DataSource { return dlp_policy.NewDataLossPreventionPolicyLookupDataSource() },
+		func() datasource.DataSource {
+			return dlp_policy.NewDataLossPreventionEffectiveClassificationDataSource()
+		},
 		func() datasource.DataSource { return tenant_settings.NewTenantSettingsDataSource() },
 		func() datasource.DataSource { return licensing.NewBillingPoliciesDataSource() },
 		func() datasource.DataSource { return licensing.NewBillingPoliciesEnvironmetsDataSource() },
@@ -401,6 +450,8 @@ func (p *PowerPlatformProvider) DataSources(ctx context.Context) []func() dataso
 		func() datasource.DataSource { return languages.NewLanguagesDataSource() },
 		func() datasource.DataSource { return currencies.NewCurrenciesDataSource() },
 		func() datasource.DataSource { return auth.NewSecurityRolesDataSource() },
+		func() datasource.DataSource { return auth.NewBusinessUnitsDataSource() },
+		func() datasource.DataSource { return auth.NewUsersDataSource() },
 		func() datasource.DataSource { return application.NewTenantApplicationPackagesDataSource() },
 		func() datasource.DataSource { return data_record.NewDataRecordDataSource() },
 	}
@@ -17,6 +17,8 @@ import (
 	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
 	application "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/application"
 	auth "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/authorization"
+	capacity "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/capacity"
+	connection "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/connection"
 	connectors "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/connectors"
 	currencies "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/currencies"
 	data_record "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/data_record"
@@ -28,6 +30,7 @@ import (
 	licensing "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/licensing"
 	locations "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/locations"
 	managed_environment "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/managed_environment"
+	pipeline "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/pipeline"
 	powerapps "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/powerapps"
 	solution "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/solution"
 	tenant_settings "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/tenant_settings"
@@ -58,11 +61,17 @@ func TestUnitPowerPlatformProviderHasChildDataSources_Basic(t *testing.T) {
 	expectedDataSources := []datasource.DataSource{
 		powerapps.NewEnvironmentPowerAppsDataSource(),
 		environment.NewEnvironmentsDataSource(),
+		environment.NewEnvironmentDataSource(),
+		environment.NewEnvironmentOperationsDataSource(),
+		capacity.NewTenantCapacityDataSource(),
 		environment_templates.NewEnvironmentTemplatesDataSource(),
 		application.NewEnvironmentApplicationPackagesDataSource(),
 		connectors.NewConnectorsDataSource(),
+		connection.NewConnectionsDataSource(),
 		solution.NewSolutionsDataSource(),
 		dlp_policy.NewDataLossPreventionPolicyDataSource(),
+		dlp_policy.NewDataLossPreventionPolicyLookupDataSource(),
+		dlp_policy.NewDataLossPreventionEffectiveClassificationDataSource(),
 		tenant_settings.NewTenantSettingsDataSource(),
 		licensing.NewBillingPoliciesDataSource(),
 		licensing.NewBillingPoliciesEnvironmetsDataSource(),
@@ -70,6 +79,8 @@ func TestUnitPowerPlatformProviderHasChildDataSources_Basic(t *testing.T) {
 		languages.NewLanguagesDataSource(),
 		currencies.NewCurrenciesDataSource(),
 		auth.NewSecurityRolesDataSource(),
+		auth.NewBusinessUnitsDataSource(),
+		auth.NewUsersDataSource(),
 		env_settings.NewEnvironmentSettingsDataSource(),
 		application.NewTenantApplicationPackagesDataSource(),
 		data_record.NewDataRecordDataSource(),
@@ -88,12 +99,26 @@ func TestUnitPowerPlatformProviderHasChildResources_Basic(t *testing.T) {
 		application.NewEnvironmentApplicationPackageInstallResource(),
 		dlp_policy.NewDataLossPreventionPolicyResource(),
 		solution.NewSolutionResource(),
+		solution.NewSolutionPatchResource(),
+		solution.NewSolutionRollupResource(),
+		pipeline.NewPipelineResource(),
+		pipeline.NewPipelineRunResource(),
+		connectors.NewCustomConnectorResource(),
+		connection.NewConnectionResource(),
+		connection.NewConnectionShareResource(),
 		tenant_settings.NewTenantSettingsResource(),
 		managed_environment.NewManagedEnvironmentResource(),
 		licensing.NewBillingPolicyResource(),
 		licensing.NewBillingPolicyEnvironmentResource(),
 		auth.NewUserResource(),
+		auth.NewApplicationUserResource(),
+		auth.NewTeamResource(),
+		auth.NewTeamMembershipResource(),
+		auth.NewBusinessUnitResource(),
+		auth.NewSecurityRoleResource(),
+		auth.NewEnvironmentRoleAssignmentResource(),
 		env_settings.NewEnvironmentSettingsResource(),
+		env_settings.NewEnvironmentOrganizationSettingsResource(),
 		data_record.NewDataRecordResource(),
 	}
 	resources := NewPowerPlatformProvider(context.Background())().(*PowerPlatformProvider).Resources(context.Background())
@@ -0,0 +1,241 @@
+package powerplatform
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestAccApplicationUserResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck_Basic(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				//lintignore:AT004
+				Config: TestsProviderConfig + `
+
+				terraform {
+					required_providers {
+					  azuread = {
+						source = "hashicorp/azuread"
+					  }
+					}
+				}
+
+				provider "azuread" {
+					use_cli = true
+				}
+
+				resource "azuread_application_registration" "test_application" {
+					display_name = "` + mock_helpers.TestName() + `"
+				}
+
+				resource "azuread_service_principal" "test_service_principal" {
+					client_id = azuread_application_registration.test_application.client_id
+				}
+
+				resource "powerplatform_environment" "application_user_example" {
+					display_name      = "` + mock_helpers.TestName() + `"
+					location          = "europe"
+					environment_type  = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_application_user" "new_application_user" {
+					environment_id = powerplatform_environment.application_user_example.id
+					application_id = azuread_service_principal.test_service_principal.client_id
+					security_roles = [
+					  "e0d2794e-82f3-e811-a951-000d3a1bcf17", // bot author
+					]
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_application_user.new_application_user", "id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("powerplatform_application_user.new_application_user", "environment_id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("powerplatform_application_user.new_application_user", "application_id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("powerplatform_application_user.new_application_user", "business_unit_id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestCheckResourceAttr("powerplatform_application_user.new_application_user", "security_roles.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.new_application_user", "security_roles.0", "e0d2794e-82f3-e811-a951-000d3a1bcf17"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitApplicationUserResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	systemUserGetInx := 1
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/application_user/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/businessunits?%24filter=_parentbusinessunitid_value+eq+null&%24select=businessunitid",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/application_user/Validate_Create_And_Update/get_businessunits.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusNoContent, "")
+			resp.Header.Add("OData-EntityId", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers(00000000-0000-0000-0000-000000000002)")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29/systemuserroles_association/$ref",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29/systemuserroles_association/$ref?%24id=https%3A%2F%2F00000000-0000-0000-0000-000000000001.crm4.dynamics.com%2Fapi%2Fdata%2Fv9.2%2Froles%2800000000-0000-0000-0000-000000000001%29",
+		func(req *http.Request) (*http.Response, error) {
+			systemUserGetInx = 2
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29",
+		func(req *http.Request) (*http.Response, error) {
+			url := fmt.Sprintf("services/authorization/tests/resource/application_user/Validate_Create_And_Update/get_systemuser_00000000-0000-0000-0000-000000000002_%d.json", systemUserGetInx)
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(url).String()), nil
+		})
+
+	httpmock.RegisterResponder("PATCH", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_application_user" "new_application_user" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					application_id = "00000000-0000-0000-0000-000000000003"
+					security_roles = [
+					  "00000000-0000-0000-0000-000000000001",
+					]
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_application_user.new_application_user", "id", "00000000-0000-0000-0000-000000000002"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.new_application_user", "environment_id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.new_application_user", "application_id", "00000000-0000-0000-0000-000000000003"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.new_application_user", "business_unit_id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.new_application_user", "full_name", "# contoso-app"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.new_application_user", "security_roles.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.new_application_user", "security_roles.0", "00000000-0000-0000-0000-000000000001"),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_application_user" "new_application_user" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					application_id = "00000000-0000-0000-0000-000000000003"
+					security_roles = [
+					  "00000000-0000-0000-0000-000000000002",
+					]
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_application_user.new_application_user", "id", "00000000-0000-0000-0000-000000000002"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.new_application_user", "security_roles.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.new_application_user", "security_roles.0", "00000000-0000-0000-0000-000000000002"),
+				),
+			},
+			{
+				ResourceName:      "powerplatform_application_user.new_application_user",
+				ImportState:       true,
+				ImportStateId:     "00000000-0000-0000-0000-000000000001_00000000-0000-0000-0000-000000000002",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitApplicationUserResource_Validate_Create_Without_Security_Roles(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/application_user/Validate_Create_Without_Security_Roles/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/businessunits?%24filter=_parentbusinessunitid_value+eq+null&%24select=businessunitid",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/application_user/Validate_Create_Without_Security_Roles/get_businessunits.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusNoContent, "")
+			resp.Header.Add("OData-EntityId", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers(00000000-0000-0000-0000-000000000002)")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/application_user/Validate_Create_Without_Security_Roles/get_systemuser_00000000-0000-0000-0000-000000000002.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("PATCH", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_application_user" "new_application_user" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					application_id = "00000000-0000-0000-0000-000000000003"
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_application_user.new_application_user", "id", "00000000-0000-0000-0000-000000000002"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.new_application_user", "business_unit_id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.new_application_user", "security_roles.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "powerplatform_application_user.new_application_user",
+				ImportState:       true,
+				ImportStateId:     "00000000-0000-0000-0000-000000000001_00000000-0000-0000-0000-000000000002",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,155 @@
+package powerplatform
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestAccBusinessUnitResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck_Basic(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment" "business_unit_example" {
+					display_name      = "` + mock_helpers.TestName() + `"
+					location          = "europe"
+					environment_type  = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_business_unit" "sales" {
+					environment_id = powerplatform_environment.business_unit_example.id
+					name           = "Sales"
+					cost_center    = "CC-100"
+				}
+
+				resource "powerplatform_business_unit" "sales_emea" {
+					environment_id          = powerplatform_environment.business_unit_example.id
+					name                    = "Sales EMEA"
+					parent_business_unit_id = powerplatform_business_unit.sales.id
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_business_unit.sales", "id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("powerplatform_business_unit.sales", "parent_business_unit_id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestCheckResourceAttr("powerplatform_business_unit.sales", "name", "Sales"),
+					resource.TestCheckResourceAttr("powerplatform_business_unit.sales", "cost_center", "CC-100"),
+					resource.TestCheckResourceAttr("powerplatform_business_unit.sales", "disabled", "false"),
+					resource.TestCheckResourceAttrPair("powerplatform_business_unit.sales_emea", "parent_business_unit_id", "powerplatform_business_unit.sales", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitBusinessUnitResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	businessUnitGetInx := 1
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/business_unit/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/businessunits?%24filter=_parentbusinessunitid_value+eq+null&%24select=businessunitid",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/business_unit/Validate_Create_And_Update/get_businessunits.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/businessunits",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusNoContent, "")
+			resp.Header.Add("OData-EntityId", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/businessunits(00000000-0000-0000-0000-000000000012)")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("PATCH", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/businessunits%2800000000-0000-0000-0000-000000000012%29",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			if strings.Contains(string(body), "isdisabled") {
+				businessUnitGetInx = 3
+			} else {
+				businessUnitGetInx = 2
+			}
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/businessunits%2800000000-0000-0000-0000-000000000012%29?%24select=businessunitid%2Cname%2C_parentbusinessunitid_value%2Ccostcenter%2Cdescription%2Cisdisabled",
+		func(req *http.Request) (*http.Response, error) {
+			url := fmt.Sprintf("services/authorization/tests/resource/business_unit/Validate_Create_And_Update/get_businessunit_00000000-0000-0000-0000-000000000012_%d.json", businessUnitGetInx)
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(url).String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/businessunits%2800000000-0000-0000-0000-000000000012%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_business_unit" "sales" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Sales"
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_business_unit.sales", "id", "00000000-0000-0000-0000-000000000012"),
+					resource.TestCheckResourceAttr("powerplatform_business_unit.sales", "name", "Sales"),
+					resource.TestCheckResourceAttr("powerplatform_business_unit.sales", "parent_business_unit_id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_business_unit.sales", "cost_center", ""),
+					resource.TestCheckResourceAttr("powerplatform_business_unit.sales", "description", ""),
+					resource.TestCheckResourceAttr("powerplatform_business_unit.sales", "disabled", "false"),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_business_unit" "sales" {
+					environment_id          = "00000000-0000-0000-0000-000000000001"
+					name                    = "Sales EMEA"
+					parent_business_unit_id = "00000000-0000-0000-0000-000000000011"
+					cost_center             = "CC-100"
+					description             = "Sales in Europe, Middle East and Africa"
+					disabled                = true
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_business_unit.sales", "id", "00000000-0000-0000-0000-000000000012"),
+					resource.TestCheckResourceAttr("powerplatform_business_unit.sales", "name", "Sales EMEA"),
+					resource.TestCheckResourceAttr("powerplatform_business_unit.sales", "parent_business_unit_id", "00000000-0000-0000-0000-000000000011"),
+					resource.TestCheckResourceAttr("powerplatform_business_unit.sales", "cost_center", "CC-100"),
+					resource.TestCheckResourceAttr("powerplatform_business_unit.sales", "description", "Sales in Europe, Middle East and Africa"),
+					resource.TestCheckResourceAttr("powerplatform_business_unit.sales", "disabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "powerplatform_business_unit.sales",
+				ImportState:       true,
+				ImportStateId:     "00000000-0000-0000-0000-000000000001_00000000-0000-0000-0000-000000000012",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
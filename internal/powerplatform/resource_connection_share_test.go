@@ -0,0 +1,97 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestUnitConnectionShareResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	roleName := ""
+	unshared := false
+
+	httpmock.RegisterResponder("POST", "https://api.powerapps.com/providers/Microsoft.PowerApps/apis/shared_sql/connections/3b0f3c0b1f3a4c2f9d1e5a7b8c9d0e1f/modifyPermissions?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			switch {
+			case strings.Contains(string(body), `"delete":[{"id":"/providers/Microsoft.PowerApps/apis/shared_sql/connections/3b0f3c0b1f3a4c2f9d1e5a7b8c9d0e1f/permissions/00000000-0000-0000-0000-000000000011"}]`):
+				unshared = true
+			case strings.Contains(string(body), `"roleName":"CanView","principal":{"id":"00000000-0000-0000-0000-000000000011","type":"Group"}`):
+				roleName = "CanView"
+			case strings.Contains(string(body), `"roleName":"CanEdit","principal":{"id":"00000000-0000-0000-0000-000000000011","type":"Group"}`):
+				roleName = "CanEdit"
+			default:
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://api.powerapps.com/providers/Microsoft.PowerApps/apis/shared_sql/connections/3b0f3c0b1f3a4c2f9d1e5a7b8c9d0e1f/permissions?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			if unshared {
+				return httpmock.NewStringResponse(http.StatusOK, `{"value":[]}`), nil
+			}
+			if roleName == "CanEdit" {
+				return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connection/tests/resource/connection_share/Validate_Update/get_permissions.json").String()), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connection/tests/resource/connection_share/Validate_Create/get_permissions.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_connection_share" "share" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					connector_id   = "/providers/Microsoft.PowerApps/apis/shared_sql"
+					connection_id  = "3b0f3c0b1f3a4c2f9d1e5a7b8c9d0e1f"
+					role_name      = "CanView"
+					principal = {
+						object_id = "00000000-0000-0000-0000-000000000011"
+						type      = "Group"
+					}
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_connection_share.share", "id", "/providers/Microsoft.PowerApps/apis/shared_sql/connections/3b0f3c0b1f3a4c2f9d1e5a7b8c9d0e1f/permissions/00000000-0000-0000-0000-000000000011"),
+					resource.TestCheckResourceAttr("powerplatform_connection_share.share", "role_name", "CanView"),
+					resource.TestCheckResourceAttr("powerplatform_connection_share.share", "principal.object_id", "00000000-0000-0000-0000-000000000011"),
+					resource.TestCheckResourceAttr("powerplatform_connection_share.share", "principal.type", "Group"),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_connection_share" "share" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					connector_id   = "/providers/Microsoft.PowerApps/apis/shared_sql"
+					connection_id  = "3b0f3c0b1f3a4c2f9d1e5a7b8c9d0e1f"
+					role_name      = "CanEdit"
+					principal = {
+						object_id = "00000000-0000-0000-0000-000000000011"
+						type      = "Group"
+					}
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_connection_share.share", "id", "/providers/Microsoft.PowerApps/apis/shared_sql/connections/3b0f3c0b1f3a4c2f9d1e5a7b8c9d0e1f/permissions/00000000-0000-0000-0000-000000000011"),
+					resource.TestCheckResourceAttr("powerplatform_connection_share.share", "role_name", "CanEdit"),
+				),
+			},
+		},
+	})
+}
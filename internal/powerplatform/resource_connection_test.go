@@ -0,0 +1,198 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestAccConnectionResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck_Basic(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment" "environment" {
+					display_name     = "` + mock_helpers.TestName() + `"
+					location         = "europe"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_connection" "connection" {
+					environment_id = powerplatform_environment.environment.id
+					connector_id   = "/providers/Microsoft.PowerApps/apis/shared_office365"
+					display_name   = "Office 365 Outlook"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_connection.connection", "id", regexp.MustCompile(powerplatform_helpers.StringRegex)),
+					resource.TestCheckResourceAttr("powerplatform_connection.connection", "connector_id", "/providers/Microsoft.PowerApps/apis/shared_office365"),
+					resource.TestCheckResourceAttr("powerplatform_connection.connection", "display_name", "Office 365 Outlook"),
+					resource.TestCheckResourceAttrSet("powerplatform_connection.connection", "status"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitConnectionResource_Validate_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	httpmock.RegisterResponder("PUT", `=~^https://api\.powerapps\.com/providers/Microsoft\.PowerApps/apis/shared_sql/connections/[0-9a-f]{32}\?%24filter=environment\+eq\+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01$`,
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(body), `"displayName":"Orders database"`) ||
+				!strings.Contains(string(body), `"connectionParametersSet":{"name":"sqlAuthentication","values":{"database":{"value":"orders"},"password":{"value":"secret"},"server":{"value":"contoso.database.windows.net"},"username":{"value":"sqladmin"}}}`) ||
+				!strings.Contains(string(body), `"environment":{"id":"/providers/Microsoft.PowerApps/environments/00000000-0000-0000-0000-000000000001","name":"00000000-0000-0000-0000-000000000001"}`) {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			return httpmock.NewStringResponse(http.StatusCreated, httpmock.File("services/connection/tests/resource/connection/Validate_Create/get_connection.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://api.powerapps.com/providers/Microsoft.PowerApps/apis/shared_sql/connections/3b0f3c0b1f3a4c2f9d1e5a7b8c9d0e1f?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connection/tests/resource/connection/Validate_Create/get_connection.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://api.powerapps.com/providers/Microsoft.PowerApps/apis/shared_sql/connections/3b0f3c0b1f3a4c2f9d1e5a7b8c9d0e1f?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_connection" "connection" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					connector_id   = "/providers/Microsoft.PowerApps/apis/shared_sql"
+					display_name   = "Orders database"
+					connection_parameters_set = jsonencode({
+						name = "sqlAuthentication"
+						values = {
+							server   = { value = "contoso.database.windows.net" }
+							database = { value = "orders" }
+							username = { value = "sqladmin" }
+							password = { value = "secret" }
+						}
+					})
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_connection.connection", "id", "3b0f3c0b1f3a4c2f9d1e5a7b8c9d0e1f"),
+					resource.TestCheckResourceAttr("powerplatform_connection.connection", "connector_id", "/providers/Microsoft.PowerApps/apis/shared_sql"),
+					resource.TestCheckResourceAttr("powerplatform_connection.connection", "display_name", "Orders database"),
+					resource.TestCheckResourceAttr("powerplatform_connection.connection", "status", "Connected"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitConnectionResource_Validate_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	updated := false
+
+	httpmock.RegisterResponder("PUT", `=~^https://api\.powerapps\.com/providers/Microsoft\.PowerApps/apis/shared_sql/connections/[0-9a-f]{32}\?`,
+		func(req *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.URL.Path, "/3b0f3c0b1f3a4c2f9d1e5a7b8c9d0e1f") {
+				updated = true
+				return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connection/tests/resource/connection/Validate_Update/get_connection.json").String()), nil
+			}
+			return httpmock.NewStringResponse(http.StatusCreated, httpmock.File("services/connection/tests/resource/connection/Validate_Create/get_connection.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://api.powerapps.com/providers/Microsoft.PowerApps/apis/shared_sql/connections/3b0f3c0b1f3a4c2f9d1e5a7b8c9d0e1f?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			if updated {
+				return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connection/tests/resource/connection/Validate_Update/get_connection.json").String()), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connection/tests/resource/connection/Validate_Create/get_connection.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://api.powerapps.com/providers/Microsoft.PowerApps/apis/shared_sql/connections/3b0f3c0b1f3a4c2f9d1e5a7b8c9d0e1f?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_connection" "connection" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					connector_id   = "/providers/Microsoft.PowerApps/apis/shared_sql"
+					display_name   = "Orders database"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_connection.connection", "display_name", "Orders database"),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_connection" "connection" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					connector_id   = "/providers/Microsoft.PowerApps/apis/shared_sql"
+					display_name   = "Orders database (production)"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_connection.connection", "id", "3b0f3c0b1f3a4c2f9d1e5a7b8c9d0e1f"),
+					resource.TestCheckResourceAttr("powerplatform_connection.connection", "display_name", "Orders database (production)"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitConnectionResource_Validate_Invalid_Connector_Id(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_connection" "connection" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					connector_id   = "shared_sql"
+					display_name   = "Orders database"
+				}`,
+
+				ExpectError: regexp.MustCompile("connector_id must have the format"),
+			},
+		},
+	})
+}
@@ -0,0 +1,280 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestAccCustomConnectorResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck_Basic(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment" "environment" {
+					display_name     = "` + mock_helpers.TestName() + `"
+					location         = "europe"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_custom_connector" "connector" {
+					environment_id = powerplatform_environment.environment.id
+					display_name   = "Terraform Test Connector"
+					swagger_file   = "../../examples/resources/powerplatform_custom_connector/swagger.json"
+					icon_file      = "../../examples/resources/powerplatform_custom_connector/icon.png"
+
+					api_key = {
+						display_name = "API Key"
+					}
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_custom_connector.connector", "id", regexp.MustCompile(`^/providers/Microsoft.PowerApps/apis/shared_`)),
+					resource.TestMatchResourceAttr("powerplatform_custom_connector.connector", "name", regexp.MustCompile(`^shared_`)),
+					resource.TestCheckResourceAttr("powerplatform_custom_connector.connector", "display_name", "Terraform Test Connector"),
+					resource.TestCheckResourceAttr("powerplatform_custom_connector.connector", "icon_brand_color", "#007ee5"),
+					resource.TestCheckResourceAttrSet("powerplatform_custom_connector.connector", "swagger_file_checksum"),
+					resource.TestCheckResourceAttrSet("powerplatform_custom_connector.connector", "icon_file_checksum"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCustomConnectorResource_Validate_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	httpmock.RegisterResponder("POST", `=~^https://api\.powerapps\.com/providers/Microsoft\.PowerApps/objectIds/[0-9a-f-]+/generateResourceStorage\?`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connectors/tests/resource/Validate_Create/post_generate_resource_storage.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("PUT", `=~^https://pafeblobprodam\.blob\.core\.windows\.net/20240101t000000z0a1b2c3d4e5f4a1b8c9d0e1f2a3b4c5d/icon\.png\?`,
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("x-ms-blob-type") != "BlockBlob" {
+				return httpmock.NewStringResponse(http.StatusBadRequest, ""), nil
+			}
+			if req.Header.Get("Authorization") != "" {
+				return httpmock.NewStringResponse(http.StatusForbidden, ""), nil
+			}
+			return httpmock.NewStringResponse(http.StatusCreated, ""), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://api.powerapps.com/providers/Microsoft.PowerApps/apis?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(body), `"iconUri":"https://pafeblobprodam.blob.core.windows.net/20240101t000000z0a1b2c3d4e5f4a1b8c9d0e1f2a3b4c5d/icon.png?`) ||
+				!strings.Contains(string(body), `"serviceUrl":"https://api.contoso.com/v1"`) ||
+				!strings.Contains(string(body), `"api_key":{"type":"securestring"`) {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			return httpmock.NewStringResponse(http.StatusCreated, httpmock.File("services/connectors/tests/resource/Validate_Create/get_custom_connector.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://api.powerapps.com/providers/Microsoft.PowerApps/apis/shared_contoso-20orders-5f5d8b2e1f0c4a7b9e?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connectors/tests/resource/Validate_Create/get_custom_connector.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://api.powerapps.com/providers/Microsoft.PowerApps/apis/shared_contoso-20orders-5f5d8b2e1f0c4a7b9e?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_custom_connector" "connector" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					display_name   = "Contoso Orders"
+					description    = "Reads orders from the Contoso orders API"
+					swagger_file   = "../../examples/resources/powerplatform_custom_connector/swagger.json"
+					icon_file      = "../../examples/resources/powerplatform_custom_connector/icon.png"
+
+					api_key = {
+						display_name = "API Key"
+						description  = "API key of the Contoso orders API"
+					}
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_custom_connector.connector", "id", "/providers/Microsoft.PowerApps/apis/shared_contoso-20orders-5f5d8b2e1f0c4a7b9e"),
+					resource.TestCheckResourceAttr("powerplatform_custom_connector.connector", "name", "shared_contoso-20orders-5f5d8b2e1f0c4a7b9e"),
+					resource.TestCheckResourceAttr("powerplatform_custom_connector.connector", "display_name", "Contoso Orders"),
+					resource.TestCheckResourceAttr("powerplatform_custom_connector.connector", "description", "Reads orders from the Contoso orders API"),
+					resource.TestCheckResourceAttr("powerplatform_custom_connector.connector", "icon_brand_color", "#007ee5"),
+					resource.TestCheckResourceAttrSet("powerplatform_custom_connector.connector", "swagger_file_checksum"),
+					resource.TestCheckResourceAttrSet("powerplatform_custom_connector.connector", "icon_file_checksum"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCustomConnectorResource_Validate_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	updated := false
+
+	httpmock.RegisterResponder("POST", "https://api.powerapps.com/providers/Microsoft.PowerApps/apis?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusCreated, httpmock.File("services/connectors/tests/resource/Validate_Create/get_custom_connector.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("PATCH", "https://api.powerapps.com/providers/Microsoft.PowerApps/apis/shared_contoso-20orders-5f5d8b2e1f0c4a7b9e?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			updated = true
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connectors/tests/resource/Validate_Update/get_custom_connector.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://api.powerapps.com/providers/Microsoft.PowerApps/apis/shared_contoso-20orders-5f5d8b2e1f0c4a7b9e?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			if updated {
+				return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connectors/tests/resource/Validate_Update/get_custom_connector.json").String()), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connectors/tests/resource/Validate_Create/get_custom_connector.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://api.powerapps.com/providers/Microsoft.PowerApps/apis/shared_contoso-20orders-5f5d8b2e1f0c4a7b9e?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_custom_connector" "connector" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					display_name   = "Contoso Orders"
+					description    = "Reads orders from the Contoso orders API"
+					swagger_file   = "../../examples/resources/powerplatform_custom_connector/swagger.json"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_custom_connector.connector", "display_name", "Contoso Orders"),
+					resource.TestCheckResourceAttr("powerplatform_custom_connector.connector", "icon_brand_color", "#007ee5"),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_custom_connector" "connector" {
+					environment_id   = "00000000-0000-0000-0000-000000000001"
+					display_name     = "Contoso Orders v2"
+					description      = "Reads orders from the Contoso orders API"
+					swagger_file     = "../../examples/resources/powerplatform_custom_connector/swagger.json"
+					icon_brand_color = "#da3b01"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_custom_connector.connector", "id", "/providers/Microsoft.PowerApps/apis/shared_contoso-20orders-5f5d8b2e1f0c4a7b9e"),
+					resource.TestCheckResourceAttr("powerplatform_custom_connector.connector", "display_name", "Contoso Orders v2"),
+					resource.TestCheckResourceAttr("powerplatform_custom_connector.connector", "icon_brand_color", "#da3b01"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCustomConnectorResource_Validate_Remove_Icon(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	httpmock.RegisterResponder("POST", `=~^https://api\.powerapps\.com/providers/Microsoft\.PowerApps/objectIds/[0-9a-f-]+/generateResourceStorage\?`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connectors/tests/resource/Validate_Create/post_generate_resource_storage.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("PUT", `=~^https://pafeblobprodam\.blob\.core\.windows\.net/20240101t000000z0a1b2c3d4e5f4a1b8c9d0e1f2a3b4c5d/icon\.png\?`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusCreated, ""), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://api.powerapps.com/providers/Microsoft.PowerApps/apis?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusCreated, httpmock.File("services/connectors/tests/resource/Validate_Create/get_custom_connector.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("PATCH", "https://api.powerapps.com/providers/Microsoft.PowerApps/apis/shared_contoso-20orders-5f5d8b2e1f0c4a7b9e?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(body), `"iconUri":""`) {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connectors/tests/resource/Validate_Create/get_custom_connector.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://api.powerapps.com/providers/Microsoft.PowerApps/apis/shared_contoso-20orders-5f5d8b2e1f0c4a7b9e?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connectors/tests/resource/Validate_Create/get_custom_connector.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://api.powerapps.com/providers/Microsoft.PowerApps/apis/shared_contoso-20orders-5f5d8b2e1f0c4a7b9e?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_custom_connector" "connector" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					display_name   = "Contoso Orders"
+					description    = "Reads orders from the Contoso orders API"
+					swagger_file   = "../../examples/resources/powerplatform_custom_connector/swagger.json"
+					icon_file      = "../../examples/resources/powerplatform_custom_connector/icon.png"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("powerplatform_custom_connector.connector", "icon_file_checksum"),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_custom_connector" "connector" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					display_name   = "Contoso Orders"
+					description    = "Reads orders from the Contoso orders API"
+					swagger_file   = "../../examples/resources/powerplatform_custom_connector/swagger.json"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("powerplatform_custom_connector.connector", "icon_file"),
+					resource.TestCheckNoResourceAttr("powerplatform_custom_connector.connector", "icon_file_checksum"),
+				),
+			},
+		},
+	})
+}
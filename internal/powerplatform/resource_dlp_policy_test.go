@@ -6,6 +6,7 @@ package powerplatform
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -917,6 +918,340 @@ func TestUnitDataLossPreventionPolicyResource_Validate_Create(t *testing.T) {
 	})
 }
 
+func TestUnitDataLossPreventionPolicyResource_Validate_Create_Environment_Scope(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/environments/00000000-0000-0000-0000-000000000000/policies`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusCreated, httpmock.File("services/dlp_policy/tests/resource/Validate_Create_Environment_Scope/get_policy_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/environments/00000000-0000-0000-0000-000000000000/policies/00000000-0000-0000-0000-000000000001`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/resource/Validate_Create_Environment_Scope/get_policy_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v1/environments/00000000-0000-0000-0000-000000000000/policies/00000000-0000-0000-0000-000000000001`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_data_loss_prevention_policy" "my_policy" {
+					scope                             = "environment"
+					display_name                      = "Block All Policy"
+					default_connectors_classification = "Blocked"
+					environment_type                  = "OnlyEnvironments"
+					environments = [ "00000000-0000-0000-0000-000000000000" ]
+
+					non_business_connectors = toset([
+						{
+							id                           = "/providers/Microsoft.PowerApps/apis/shared_sql"
+							default_action_rule_behavior = "Allow",
+							action_rules = [
+							  {
+								action_id = "DeleteItem_V2",
+								behavior  = "Block",
+							  },
+							  {
+								action_id = "ExecutePassThroughNativeQuery_V2",
+								behavior  = "Block",
+							  }
+							],
+							endpoint_rules = [
+							  {
+								order    = 1,
+								behavior = "Allow",
+								endpoint = "contoso.com"
+							  },
+							  {
+								order    = 2,
+								behavior = "Deny",
+								endpoint = "*"
+							  }
+							]
+						  }
+					])
+					business_connectors = toset([
+						{
+							id                           = "/providers/Microsoft.PowerApps/apis/shared_sharepointonline",
+							default_action_rule_behavior = "",
+							action_rules                 = [],
+							endpoint_rules               = []
+						},
+					])
+					blocked_connectors      = toset([
+						{
+							id                           = "/providers/Microsoft.PowerApps/apis/shared_azureblob",
+							default_action_rule_behavior = "",
+							action_rules                 = []
+							endpoint_rules               = []
+						  },
+					])
+					custom_connectors_patterns = toset([
+					  {
+						order            = 1
+						host_url_pattern = "https://*.contoso.com"
+						data_group       = "Blocked"
+					  },
+					  {
+						order            = 2
+						host_url_pattern = "*"
+						data_group       = "Ignore"
+					  }
+					])
+				  }`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy.my_policy", "id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy.my_policy", "scope", "environment"),
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy.my_policy", "environment_type", "OnlyEnvironments"),
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy.my_policy", "environments.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy.my_policy", "environments.0", "00000000-0000-0000-0000-000000000000"),
+				),
+			},
+			{
+				ResourceName:      "powerplatform_data_loss_prevention_policy.my_policy",
+				ImportState:       true,
+				ImportStateId:     "00000000-0000-0000-0000-000000000000_00000000-0000-0000-0000-000000000001",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitDataLossPreventionPolicyResource_Validate_Environment_Scope_Requires_Single_Environment(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_data_loss_prevention_policy" "my_policy" {
+					scope                             = "environment"
+					display_name                      = "Block All Policy"
+					default_connectors_classification = "Blocked"
+					environment_type                  = "ExceptEnvironments"
+					environments                      = ["00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000002"]
+
+					business_connectors        = []
+					non_business_connectors    = []
+					blocked_connectors         = []
+					custom_connectors_patterns = []
+				}`,
+				ExpectError: regexp.MustCompile("Environment-level policies"),
+			},
+		},
+	})
+}
+
+func TestUnitDataLossPreventionPolicyResource_Validate_Create_From_Policy_Definition_Json(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusCreated, httpmock.File("services/dlp_policy/tests/resource/Validate_Create/get_policy_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v2/policies/00000000-0000-0000-0000-000000000001`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/resource/Validate_Create/get_policy_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v1/policies/00000000-0000-0000-0000-000000000001`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_data_loss_prevention_policy" "my_policy" {
+					policy_definition_json = <<EOT
+` + httpmock.File("services/dlp_policy/tests/resource/Validate_Create/get_policy_00000000-0000-0000-0000-000000000001.json").String() + `
+EOT
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy.my_policy", "id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy.my_policy", "display_name", "Block All Policy"),
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy.my_policy", "default_connectors_classification", "Blocked"),
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy.my_policy", "environment_type", "OnlyEnvironments"),
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy.my_policy", "environments.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy.my_policy", "business_connectors.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy.my_policy", "non_business_connectors.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy.my_policy", "non_business_connectors.0.action_rules.#", "2"),
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy.my_policy", "non_business_connectors.0.endpoint_rules.#", "2"),
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy.my_policy", "blocked_connectors.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy.my_policy", "custom_connectors_patterns.#", "2"),
+					resource.TestMatchResourceAttr("powerplatform_data_loss_prevention_policy.my_policy", "canonical_policy_definition_json", regexp.MustCompile(`^\{"connectorConfigurationsDefinition":.*"displayName":"Block All Policy".*\}$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataLossPreventionPolicyResource_Validate_Policy_Definition_Json_Or_Attributes_Required(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_data_loss_prevention_policy" "my_policy" {
+					display_name = "Block All Policy"
+				}`,
+				ExpectError: regexp.MustCompile(`required\s+when\s+.policy_definition_json.\s+is\s+not\s+set`),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_data_loss_prevention_policy" "my_policy" {
+					policy_definition_json = jsonencode({ policyDefinition = {} })
+				}`,
+				ExpectError: regexp.MustCompile("Invalid policy definition"),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_data_loss_prevention_policy" "my_policy" {
+					policy_definition_json = jsonencode({ policyDefinition = {} })
+					display_name           = "Block All Policy"
+				}`,
+				ExpectError: regexp.MustCompile(`can't\s+be\s+set\s+together\s+with\s+.policy_definition_json.`),
+			},
+		},
+	})
+}
+
+func TestUnitDataLossPreventionPolicyResource_Validate_Connectors_Catalog(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v1/connectors/metadata/virtual`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connectors/tests/Validate_Read/get_virtual.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v1/connectors/metadata/unblockable`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connectors/tests/Validate_Read/get_unblockable.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerapps.com/providers/Microsoft.PowerApps/apis?%24filter=environment+eq+%27~Default%27&api-version=2023-06-01&hideDlpExemptApis=true&showAllDlpEnforceableApis=true&showApisWithToS=true`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/dlp_policy/tests/resource/Validate_Catalog/get_apis.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerapps.com/providers/Microsoft.PowerApps/apis?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2023-06-01&hideDlpExemptApis=true&showAllDlpEnforceableApis=true&showApisWithToS=true`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connectors/tests/Validate_Read_Filters/get_apis.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerapps.com/providers/Microsoft.PowerApps/apis/shared_sharepointonline?%24filter=environment+eq+%27~Default%27&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/connectors/tests/Validate_Read_Filters/get_api_shared_sharepointonline.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_data_loss_prevention_policy" "my_policy" {
+					display_name                      = "Catalog Policy"
+					default_connectors_classification = "General"
+					environment_type                  = "OnlyEnvironments"
+					environments                      = ["00000000-0000-0000-0000-000000000001"]
+
+					business_connectors = [
+						{
+							id                           = "/providers/Microsoft.PowerApps/apis/shared_sharepointonline"
+							default_action_rule_behavior = ""
+							action_rules                 = []
+							endpoint_rules               = []
+						}
+					]
+					non_business_connectors = []
+					blocked_connectors = [
+						{
+							id                           = "/providers/Microsoft.PowerApps/apis/shared_approvals"
+							default_action_rule_behavior = ""
+							action_rules                 = []
+							endpoint_rules               = []
+						}
+					]
+					custom_connectors_patterns = []
+				}`,
+				ExpectError: regexp.MustCompile(`Connector\s+'/providers/Microsoft.PowerApps/apis/shared_approvals'\s+can't\s+be\s+blocked`),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_data_loss_prevention_policy" "my_policy" {
+					display_name                      = "Catalog Policy"
+					default_connectors_classification = "General"
+					environment_type                  = "OnlyEnvironments"
+					environments                      = ["00000000-0000-0000-0000-000000000001"]
+
+					business_connectors = [
+						{
+							id                           = "/providers/Microsoft.PowerApps/apis/shared_sharepointonline"
+							default_action_rule_behavior = ""
+							action_rules                 = [
+								{
+									action_id = "GetItems"
+									behavior  = "Allow"
+								},
+								{
+									action_id = "DeleteAllItems"
+									behavior  = "Block"
+								}
+							]
+							endpoint_rules               = []
+						}
+					]
+					non_business_connectors = []
+					blocked_connectors = []
+					custom_connectors_patterns = []
+				}`,
+				ExpectError: regexp.MustCompile(`has\s+no\s+action\s+'DeleteAllItems'`),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_data_loss_prevention_policy" "my_policy" {
+					scope                             = "environment"
+					display_name                      = "Catalog Policy"
+					default_connectors_classification = "General"
+					environment_type                  = "OnlyEnvironments"
+					environments                      = ["00000000-0000-0000-0000-000000000001"]
+
+					business_connectors = [
+						{
+							id                           = "/providers/Microsoft.PowerApps/apis/shared_approvals"
+							default_action_rule_behavior = ""
+							action_rules                 = []
+							endpoint_rules               = []
+						}
+					]
+					non_business_connectors = []
+					blocked_connectors = []
+					custom_connectors_patterns = []
+				}`,
+				ExpectError: regexp.MustCompile(`Connector\s+'/providers/Microsoft.PowerApps/apis/shared_approvals'\s+was\s+not\s+found`),
+			},
+		},
+	})
+}
+
 func TestAccDataLossPreventionPolicyResource_Validate_Create(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		IsUnitTest:               false,
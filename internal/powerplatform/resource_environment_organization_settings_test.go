@@ -0,0 +1,283 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestAccEnvironmentOrganizationSettingsResource_Validate_Create_And_Update(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck_Basic(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment" "env" {
+					display_name      = "` + mock_helpers.TestName() + `"
+					location          = "europe"
+					environment_type  = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_environment_organization_settings" "settings" {
+					environment_id = powerplatform_environment.env.id
+					settings = {
+						maxuploadfilesize    = "10485760"
+						sessiontimeoutinmins = "120"
+					}
+					orgdb_settings = {
+						IsMailboxInactiveBackoffEnabled    = "true"
+						AllowRoleAssignmentOnDisabledUsers = "false"
+					}
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "settings.maxuploadfilesize", "10485760"),
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "settings.sessiontimeoutinmins", "120"),
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "orgdb_settings.IsMailboxInactiveBackoffEnabled", "true"),
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "orgdb_settings.AllowRoleAssignmentOnDisabledUsers", "false"),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment" "env" {
+					display_name      = "` + mock_helpers.TestName() + `"
+					location          = "europe"
+					environment_type  = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_environment_organization_settings" "settings" {
+					environment_id = powerplatform_environment.env.id
+					settings = {
+						maxuploadfilesize    = "20971520"
+						sessiontimeoutinmins = "120"
+					}
+					orgdb_settings = {
+						IsMailboxInactiveBackoffEnabled = "true"
+					}
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "settings.maxuploadfilesize", "20971520"),
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "orgdb_settings.%", "1"),
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "orgdb_settings.IsMailboxInactiveBackoffEnabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitEnvironmentOrganizationSettingsResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	patchCount := 0
+	expectedPatches := []map[string]any{
+		{
+			"maxuploadfilesize":     float64(10485760),
+			"isauditenabled":        true,
+			"sessiontimeoutinmins":  float64(120),
+			"plugintracelogsetting": float64(2),
+			"orgdborgsettings":      "<OrgSettings><IsCommandingModifiedOnEnabled>true</IsCommandingModifiedOnEnabled><AllowRoleAssignmentOnDisabledUsers>false</AllowRoleAssignmentOnDisabledUsers><IsMailboxInactiveBackoffEnabled>true</IsMailboxInactiveBackoffEnabled></OrgSettings>",
+		},
+		{
+			"maxuploadfilesize":     float64(20971520),
+			"isauditenabled":        true,
+			"sessiontimeoutinmins":  float64(120),
+			"plugintracelogsetting": float64(2),
+			"orgdborgsettings":      "<OrgSettings><IsCommandingModifiedOnEnabled>true</IsCommandingModifiedOnEnabled><IsMailboxInactiveBackoffEnabled>true</IsMailboxInactiveBackoffEnabled></OrgSettings>",
+		},
+		{
+			"orgdborgsettings": "<OrgSettings><IsCommandingModifiedOnEnabled>true</IsCommandingModifiedOnEnabled></OrgSettings>",
+		},
+	}
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/environment_settings/tests/resources/organization_settings/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/EntityDefinitions%28LogicalName=%27organization%27%29/Attributes`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/environment_settings/tests/resources/organization_settings/Validate_Create_And_Update/get_organization_attributes.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/organizations`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(fmt.Sprintf("services/environment_settings/tests/resources/organization_settings/Validate_Create_And_Update/get_organization_%d.json", min(patchCount+1, 3))).String()), nil
+		})
+
+	httpmock.RegisterResponder("PATCH", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/organizations%2843f51247-aee6-ee11-9048-000d3a688755%29`,
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			patch := map[string]any{}
+			if err := json.Unmarshal(body, &patch); err != nil || patchCount >= len(expectedPatches) || fmt.Sprint(patch) != fmt.Sprint(expectedPatches[patchCount]) {
+				return httpmock.NewStringResponse(http.StatusBadRequest, fmt.Sprintf("unexpected organization update: %s", string(body))), nil
+			}
+			patchCount++
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment_organization_settings" "settings" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					settings = {
+						maxuploadfilesize     = "10485760"
+						isauditenabled        = "true"
+						sessiontimeoutinmins  = "120"
+						plugintracelogsetting = "2"
+					}
+					orgdb_settings = {
+						IsMailboxInactiveBackoffEnabled    = "true"
+						AllowRoleAssignmentOnDisabledUsers = "false"
+					}
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "settings.%", "4"),
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "settings.maxuploadfilesize", "10485760"),
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "settings.isauditenabled", "true"),
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "settings.sessiontimeoutinmins", "120"),
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "settings.plugintracelogsetting", "2"),
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "orgdb_settings.%", "2"),
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "orgdb_settings.IsMailboxInactiveBackoffEnabled", "true"),
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "orgdb_settings.AllowRoleAssignmentOnDisabledUsers", "false"),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment_organization_settings" "settings" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					settings = {
+						maxuploadfilesize     = "20971520"
+						isauditenabled        = "true"
+						sessiontimeoutinmins  = "120"
+						plugintracelogsetting = "2"
+					}
+					orgdb_settings = {
+						IsMailboxInactiveBackoffEnabled = "true"
+					}
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "settings.maxuploadfilesize", "20971520"),
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "orgdb_settings.%", "1"),
+					resource.TestCheckResourceAttr("powerplatform_environment_organization_settings.settings", "orgdb_settings.IsMailboxInactiveBackoffEnabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitEnvironmentOrganizationSettingsResource_Validate_Invalid_Settings(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/environment_settings/tests/resources/organization_settings/Validate_Invalid_Settings/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/EntityDefinitions%28LogicalName=%27organization%27%29/Attributes`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/environment_settings/tests/resources/organization_settings/Validate_Invalid_Settings/get_organization_attributes.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/organizations`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/environment_settings/tests/resources/organization_settings/Validate_Invalid_Settings/get_organization.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment_organization_settings" "settings" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					settings = {
+						doesnotexist = "true"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`column\s+'doesnotexist'\s+does\s+not\s+exist\s+in\s+the\s+organization\s+table`),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment_organization_settings" "settings" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					settings = {
+						maxuploadfilesize = "ten megabytes"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`column\s+'maxuploadfilesize'\s+expects\s+an\s+integer\s+value,\s+got\s+'ten\s+megabytes'`),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment_organization_settings" "settings" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					settings = {
+						createdon = "2024-01-01T00:00:00Z"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`column\s+'createdon'\s+is\s+read-only`),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment_organization_settings" "settings" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					settings = {
+						basecurrencyid = "00000000-0000-0000-0000-000000000002"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`column\s+'basecurrencyid'\s+of\s+type\s+'Lookup'\s+is\s+not\s+supported`),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment_organization_settings" "settings" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					settings = {
+						orgdborgsettings = "<OrgSettings></OrgSettings>"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`use\s+.orgdb_settings.\s+to\s+manage\s+its\s+keys`),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment_organization_settings" "settings" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					orgdb_settings = {
+						"Not a key" = "true"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`'Not\s+a\s+key'\s+is\s+not\s+a\s+valid\s+orgdborgsettings\s+key\s+name`),
+			},
+		},
+	})
+}
@@ -0,0 +1,161 @@
+package powerplatform
+
+import (
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestAccEnvironmentRoleAssignmentResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck_Basic(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				//lintignore:AT004
+				Config: TestsProviderConfig + `
+				terraform {
+					required_providers {
+					  azuread = {
+						source = "hashicorp/azuread"
+					  }
+					}
+				}
+
+				provider "azuread" {
+					use_cli = true
+				}
+
+				resource "azuread_group" "makers" {
+					display_name     = "` + mock_helpers.TestName() + `"
+					security_enabled = true
+				}
+
+				resource "powerplatform_environment" "role_assignment_example" {
+					display_name     = "` + mock_helpers.TestName() + `"
+					location         = "europe"
+					environment_type = "Sandbox"
+				}
+
+				resource "powerplatform_environment_role_assignment" "makers" {
+					environment_id = powerplatform_environment.role_assignment_example.id
+					role           = "EnvironmentMaker"
+					principal = {
+						object_id = azuread_group.makers.object_id
+						type      = "Group"
+					}
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_environment_role_assignment.makers", "id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestCheckResourceAttr("powerplatform_environment_role_assignment.makers", "role", "EnvironmentMaker"),
+					resource.TestCheckResourceAttrPair("powerplatform_environment_role_assignment.makers", "principal.object_id", "azuread_group.makers", "object_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitEnvironmentRoleAssignmentResource_Validate_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	roleAssignmentsGetInx := "1"
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/environment_role_assignment/Validate_Create/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001/modifyRoleAssignments?api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			switch {
+			case strings.Contains(string(body), `"add":[{"properties":{"roleDefinition":{"id":"/providers/Microsoft.BusinessAppPlatform/environments/00000000-0000-0000-0000-000000000001/roleDefinitions/EnvironmentMaker"},"principal":{"id":"00000000-0000-0000-0000-000000000202","type":"Group"}}}]`):
+				roleAssignmentsGetInx = "2"
+				return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/environment_role_assignment/Validate_Create/post_modify_role_assignments.json").String()), nil
+			case strings.Contains(string(body), `"remove":[{"id":"/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001/roleAssignments/00000000-0000-0000-0000-000000000102"}]`):
+				roleAssignmentsGetInx = "1"
+				return httpmock.NewStringResponse(http.StatusOK, `{"add":[],"remove":[{"httpStatus":"OK"}]}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001/roleAssignments?api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/environment_role_assignment/Validate_Create/get_role_assignments_"+roleAssignmentsGetInx+".json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment_role_assignment" "makers" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					role           = "EnvironmentMaker"
+					principal = {
+						object_id = "00000000-0000-0000-0000-000000000202"
+						type      = "Group"
+					}
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_environment_role_assignment.makers", "id", "00000000-0000-0000-0000-000000000102"),
+					resource.TestCheckResourceAttr("powerplatform_environment_role_assignment.makers", "role", "EnvironmentMaker"),
+					resource.TestCheckResourceAttr("powerplatform_environment_role_assignment.makers", "principal.object_id", "00000000-0000-0000-0000-000000000202"),
+					resource.TestCheckResourceAttr("powerplatform_environment_role_assignment.makers", "principal.type", "Group"),
+					resource.TestCheckResourceAttr("powerplatform_environment_role_assignment.makers", "principal_display_name", "Makers"),
+				),
+			},
+			{
+				ResourceName:      "powerplatform_environment_role_assignment.makers",
+				ImportState:       true,
+				ImportStateId:     "00000000-0000-0000-0000-000000000001_00000000-0000-0000-0000-000000000102",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitEnvironmentRoleAssignmentResource_Validate_Dataverse_Environment(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/environment_role_assignment/Validate_Dataverse_Environment/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment_role_assignment" "admin" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					role           = "EnvironmentAdmin"
+					principal = {
+						object_id = "00000000-0000-0000-0000-000000000201"
+						type      = "User"
+					}
+				}`,
+
+				ExpectError: regexp.MustCompile("has a Dataverse database"),
+			},
+		},
+	})
+}
@@ -5,8 +5,10 @@ package powerplatform
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"regexp"
+	"strings"
 
 	"testing"
 
@@ -283,3 +285,164 @@ func TestUnitTestEnvironmentSettingsResource_Validate_No_Dataverse(t *testing.T)
 		},
 	})
 }
+
+func TestUnitTestEnvironmentSettingsResource_Validate_Security(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	patched := false
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/environment_settings/tests/resources/Validate_Security/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.0/organizations`,
+		func(req *http.Request) (*http.Response, error) {
+			if patched {
+				return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/environment_settings/tests/resources/Validate_Security/get_organisations_2.json").String()), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/environment_settings/tests/resources/Validate_Security/get_organisations_1.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("PATCH", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.0/organizations%2843f51247-aee6-ee11-9048-000d3a688755%29`,
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			for _, expected := range []string{
+				`"auditretentionperiodv2":365`,
+				`"enableipbasedfirewallrule":true`,
+				`"enableipbasedfirewallruleinauditmode":false`,
+				`"allowediprangeforfirewall":"10.0.0.0/16,2001:db8::/32,52.10.10.10"`,
+				`"allowedservicetagsforfirewall":"AzureCloud"`,
+				`"enableipbasedcookiebinding":true`,
+				`"sessiontimeoutenabled":true`,
+				`"sessiontimeoutinmins":480`,
+				`"sessiontimeoutreminderinmins":20`,
+				`"inactivitytimeoutenabled":true`,
+				`"inactivitytimeoutinmins":60`,
+				`"inactivitytimeoutreminderinmins":5`,
+				`"blockedattachments":"bat;exe;js"`,
+				`"blockedmimetypes":"application/x-msdownload"`,
+			} {
+				if !strings.Contains(string(body), expected) {
+					return httpmock.NewStringResponse(http.StatusBadRequest, fmt.Sprintf("expected %s in request body %s", expected, string(body))), nil
+				}
+			}
+			patched = true
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment_settings" "settings" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					audit_and_logs = {
+						audit_settings = {
+							log_retention_period_in_days = 365
+						}
+					}
+					security = {
+						ip_firewall = {
+							enabled                          = true
+							audit_only                       = false
+							allowed_ip_ranges                = ["52.10.10.10", "10.0.0.0/16", "2001:db8::/32"]
+							allowed_service_tags             = ["AzureCloud"]
+							allow_application_users          = true
+							allow_microsoft_trusted_services = true
+						}
+						ip_based_cookie_binding_enabled = true
+						session_timeout = {
+							enabled            = true
+							timeout_in_minutes = 480
+							warning_in_minutes = 20
+						}
+						inactivity_timeout = {
+							enabled            = true
+							timeout_in_minutes = 60
+							warning_in_minutes = 5
+						}
+						blocked_file_extensions = ["exe", "bat", "js"]
+						blocked_mime_types      = ["application/x-msdownload"]
+					}
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "audit_and_logs.audit_settings.log_retention_period_in_days", "365"),
+					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "security.ip_firewall.enabled", "true"),
+					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "security.ip_firewall.audit_only", "false"),
+					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "security.ip_firewall.allowed_ip_ranges.#", "3"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_environment_settings.settings", "security.ip_firewall.allowed_ip_ranges.*", "2001:db8::/32"),
+					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "security.ip_firewall.allowed_service_tags.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "security.ip_based_cookie_binding_enabled", "true"),
+					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "security.session_timeout.timeout_in_minutes", "480"),
+					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "security.session_timeout.warning_in_minutes", "20"),
+					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "security.inactivity_timeout.timeout_in_minutes", "60"),
+					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "security.blocked_file_extensions.#", "3"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_environment_settings.settings", "security.blocked_mime_types.*", "application/x-msdownload"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitTestEnvironmentSettingsResource_Validate_Security_Invalid_Values(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment_settings" "settings" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					security = {
+						ip_firewall = {
+							allowed_ip_ranges = ["10.0.0.0/33"]
+						}
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`'10.0.0.0/33'\s+is\s+not\s+a\s+valid\s+IP\s+range\s+in\s+CIDR\s+notation`),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment_settings" "settings" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					security = {
+						session_timeout = {
+							enabled            = true
+							timeout_in_minutes = 30
+						}
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`Attribute\s+security.session_timeout.timeout_in_minutes\s+value\s+must\s+be\s+between\s+60`),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment_settings" "settings" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					security = {
+						inactivity_timeout = {
+							enabled            = true
+							timeout_in_minutes = 15
+							warning_in_minutes = 15
+						}
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`has\s+to\s+be\s+lower\s+than\s+.timeout_in_minutes.`),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment_settings" "settings" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					security = {
+						blocked_file_extensions = [".exe"]
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`must\s+be\s+a\s+file\s+extension\s+without\s+the\s+leading\s+dot`),
+			},
+		},
+	})
+}
@@ -5,8 +5,10 @@ package powerplatform
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -606,3 +608,91 @@ func TestAccManagedEnvironmentsResource_Validate_No_Dataverse(t *testing.T) {
 		},
 	})
 }
+
+func TestUnitManagedEnvironmentsResource_Validate_Extended_Settings(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	httpmock.RegisterResponder("POST", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/environments/00000000-0000-0000-0000-000000000001/governanceConfiguration?api-version=2021-04-01",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Add("Location", "https://europe.api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/lifecycleOperations/b03e1e6d-73db-4367-90e1-2e378bf7e2fc?api-version=2023-06-01")
+			if strings.Contains(string(body), `"protectionLevel":"Basic"`) {
+				return resp, nil
+			}
+			for _, expected := range []string{
+				`"excludeEnvironmentFromAnalysis":"true"`,
+				`"disableAiGeneratedDescriptions":"true"`,
+				`"includeOnHomepageInsights":"true"`,
+				`"solutionCheckerMode":"block"`,
+				`"solutionCheckerRuleOverrides":"il-avoid-specialized-update-ops,meta-avoid-reg-no-attribute"`,
+			} {
+				if !strings.Contains(string(body), expected) {
+					return httpmock.NewStringResponse(http.StatusBadRequest, fmt.Sprintf("expected %s in request body %s", expected, string(body))), nil
+				}
+			}
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://europe.api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/lifecycleOperations/b03e1e6d-73db-4367-90e1-2e378bf7e2fc?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/managed_environment/tests/resource/Validate_Extended_Settings/get_lifecycle.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/managed_environment/tests/resource/Validate_Extended_Settings/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_managed_environment" "managed_development" {
+					environment_id                     = "00000000-0000-0000-0000-000000000001"
+					is_usage_insights_disabled         = true
+					is_group_sharing_disabled          = true
+					limit_sharing_mode                 = "ExcludeSharingToSecurityGroups"
+					max_limit_user_sharing             = 10
+					solution_checker_mode              = "Block"
+					suppress_validation_emails         = true
+					solution_checker_rule_overrides    = ["Meta Avoid Reg No Attribute"]
+					maker_onboarding_markdown          = "this is test markdown"
+					maker_onboarding_url               = "http://www.example.com"
+				}`,
+				ExpectError: regexp.MustCompile("must be a solution checker rule id"),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_managed_environment" "managed_development" {
+					environment_id                     = "00000000-0000-0000-0000-000000000001"
+					is_usage_insights_disabled         = true
+					is_group_sharing_disabled          = true
+					limit_sharing_mode                 = "ExcludeSharingToSecurityGroups"
+					max_limit_user_sharing             = 10
+					solution_checker_mode              = "Block"
+					suppress_validation_emails         = true
+					solution_checker_rule_overrides    = ["meta-avoid-reg-no-attribute", "il-avoid-specialized-update-ops"]
+					ai_generated_descriptions_disabled = true
+					include_on_homepage_insights       = true
+					maker_onboarding_markdown          = "this is test markdown"
+					maker_onboarding_url               = "http://www.example.com"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_managed_environment.managed_development", "solution_checker_mode", "Block"),
+					resource.TestCheckResourceAttr("powerplatform_managed_environment.managed_development", "solution_checker_rule_overrides.#", "2"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_managed_environment.managed_development", "solution_checker_rule_overrides.*", "meta-avoid-reg-no-attribute"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_managed_environment.managed_development", "solution_checker_rule_overrides.*", "il-avoid-specialized-update-ops"),
+					resource.TestCheckResourceAttr("powerplatform_managed_environment.managed_development", "ai_generated_descriptions_disabled", "true"),
+					resource.TestCheckResourceAttr("powerplatform_managed_environment.managed_development", "include_on_homepage_insights", "true"),
+				),
+			},
+		},
+	})
+}
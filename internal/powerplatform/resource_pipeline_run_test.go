@@ -0,0 +1,85 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestUnitPipelineRunResource_Validate_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/pipeline/tests/resource/pipeline_run/Validate_Create/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000002?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/pipeline/tests/resource/pipeline_run/Validate_Create/get_environment_00000000-0000-0000-0000-000000000002.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/deploymentenvironments?%24filter=environmentid+eq+%2700000000-0000-0000-0000-000000000002%27+and+environmenttype+eq+200000000&%24select=deploymentenvironmentid%2Cname%2Cenvironmentid%2Cenvironmenttype%2Cvalidationstatus%2Cerrormessage",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/pipeline/tests/resource/pipeline_run/Validate_Create/get_deployment_environment_00000000-0000-0000-0000-000000000002.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000002.crm4.dynamics.com/api/data/v9.2/solutions?%24expand=publisherid&%24filter=%28isvisible+eq+true%29&%24orderby=createdon+desc",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/pipeline/tests/resource/pipeline_run/Validate_Create/get_solutions.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/deploymentstageruns",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusNoContent, "")
+			resp.Header.Set("OData-EntityId", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/deploymentstageruns(00000000-0000-0000-0000-000000000040)")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/ValidatePackageAsync",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/DeployPackageAsync",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/deploymentstageruns%2800000000-0000-0000-0000-000000000040%29?%24select=deploymentstagerunid%2Cartifactname%2Cartifactversion%2Csolutionid%2Cdeploymentnotes%2C_deploymentstageid_value%2C_devdeploymentenvironment_value%2Cstagerunstatus%2Cvalidationstatus%2Cerrormessage",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/pipeline/tests/resource/pipeline_run/Validate_Create/get_pipeline_run.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_pipeline_run" "run" {
+					environment_id             = "00000000-0000-0000-0000-000000000001"
+					stage_id                   = "00000000-0000-0000-0000-000000000031"
+					development_environment_id = "00000000-0000-0000-0000-000000000002"
+					solution_name              = "TerraformTestSolution"
+					deployment_notes           = "Deployed by Terraform"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_pipeline_run.run", "id", "00000000-0000-0000-0000-000000000040"),
+					resource.TestCheckResourceAttr("powerplatform_pipeline_run.run", "artifact_version", "1.2.0.0"),
+					resource.TestCheckResourceAttr("powerplatform_pipeline_run.run", "status", "Succeeded"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,205 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestAccPipelineResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck_Basic(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment" "host" {
+					display_name     = "` + mock_helpers.TestName() + `_host"
+					location         = "europe"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_environment" "development" {
+					display_name     = "` + mock_helpers.TestName() + `_dev"
+					location         = "europe"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_environment" "test" {
+					display_name     = "` + mock_helpers.TestName() + `_test"
+					location         = "europe"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_environment_application_package_install" "pipelines" {
+					environment_id = powerplatform_environment.host.id
+					unique_name    = "msdyn_AppDeploymentAnchor"
+				}
+
+				resource "powerplatform_pipeline" "pipeline" {
+					environment_id              = powerplatform_environment_application_package_install.pipelines.environment_id
+					name                        = "Terraform Test Pipeline"
+					development_environment_ids = [powerplatform_environment.development.id]
+					stages = [
+						{
+							name                  = "Test"
+							target_environment_id = powerplatform_environment.test.id
+						}
+					]
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_pipeline.pipeline", "id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestCheckResourceAttr("powerplatform_pipeline.pipeline", "name", "Terraform Test Pipeline"),
+					resource.TestCheckResourceAttr("powerplatform_pipeline.pipeline", "development_environment_ids.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_pipeline.pipeline", "stages.#", "1"),
+					resource.TestMatchResourceAttr("powerplatform_pipeline.pipeline", "stages.0.id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestCheckResourceAttr("powerplatform_pipeline.pipeline", "stages.0.name", "Test"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitPipelineResource_Validate_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/pipeline/tests/resource/pipeline/Validate_Create/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/deploymentpipelines",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusNoContent, "")
+			resp.Header.Set("OData-EntityId", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/deploymentpipelines(00000000-0000-0000-0000-000000000020)")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/deploymentpipelines%2800000000-0000-0000-0000-000000000020%29?%24select=deploymentpipelineid%2Cname%2Cdescription",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/pipeline/tests/resource/pipeline/Validate_Create/get_pipeline.json").String()), nil
+		})
+
+	for _, environmentId := range []string{"00000000-0000-0000-0000-000000000002", "00000000-0000-0000-0000-000000000003", "00000000-0000-0000-0000-000000000004"} {
+		environmentType := "200000001"
+		if environmentId == "00000000-0000-0000-0000-000000000002" {
+			environmentType = "200000000"
+		}
+		fileName := fmt.Sprintf("services/pipeline/tests/resource/pipeline/Validate_Create/get_deployment_environment_%s.json", environmentId)
+		httpmock.RegisterResponder("GET", fmt.Sprintf("https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/deploymentenvironments?%%24filter=environmentid+eq+%%27%s%%27+and+environmenttype+eq+%s&%%24select=deploymentenvironmentid%%2Cname%%2Cenvironmentid%%2Cenvironmenttype%%2Cvalidationstatus%%2Cerrormessage", environmentId, environmentType),
+			func(req *http.Request) (*http.Response, error) {
+				return httpmock.NewStringResponse(http.StatusOK, httpmock.File(fileName).String()), nil
+			})
+	}
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/deploymentenvironments?%24select=deploymentenvironmentid%2Cname%2Cenvironmentid%2Cenvironmenttype%2Cvalidationstatus%2Cerrormessage",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/pipeline/tests/resource/pipeline/Validate_Create/get_deployment_environments.json").String()), nil
+		})
+
+	stageIds := []string{"00000000-0000-0000-0000-000000000031", "00000000-0000-0000-0000-000000000032"}
+	stageCount := 0
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/deploymentstages",
+		func(req *http.Request) (*http.Response, error) {
+			body := make([]byte, req.ContentLength)
+			req.Body.Read(body)
+			if stageCount > 0 && !strings.Contains(string(body), fmt.Sprintf(`"PreviousDeploymentStageId@odata.bind":"/deploymentstages(%s)"`, stageIds[stageCount-1])) {
+				return httpmock.NewStringResponse(http.StatusBadRequest, "previous stage is missing"), nil
+			}
+
+			resp := httpmock.NewStringResponse(http.StatusNoContent, "")
+			resp.Header.Set("OData-EntityId", fmt.Sprintf("https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/deploymentstages(%s)", stageIds[stageCount]))
+			stageCount++
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/deploymentstages?%24filter=_deploymentpipelineid_value+eq+00000000-0000-0000-0000-000000000020&%24select=deploymentstageid%2Cname%2Cdescription%2C_deploymentpipelineid_value%2C_targetdeploymentenvironmentid_value%2C_previousdeploymentstageid_value",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/pipeline/tests/resource/pipeline/Validate_Create/get_deployment_stages.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/deploymentpipelines%2800000000-0000-0000-0000-000000000020%29/deploymentpipeline_deploymentenvironment/$ref",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/deploymentpipelines%2800000000-0000-0000-0000-000000000020%29/deploymentpipeline_deploymentenvironment?%24select=deploymentenvironmentid%2Cname%2Cenvironmentid%2Cenvironmenttype",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/pipeline/tests/resource/pipeline/Validate_Create/get_pipeline_development_environments.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/deploymentpipelines%2800000000-0000-0000-0000-000000000020%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_pipeline" "pipeline" {
+					environment_id              = "00000000-0000-0000-0000-000000000001"
+					name                        = "Terraform Test Pipeline"
+					description                 = "Deploys solutions from development to test and production"
+					development_environment_ids = ["00000000-0000-0000-0000-000000000002"]
+					stages = [
+						{
+							name                  = "Test"
+							description           = "Deploys to the test environment"
+							target_environment_id = "00000000-0000-0000-0000-000000000003"
+						},
+						{
+							name                  = "Production"
+							target_environment_id = "00000000-0000-0000-0000-000000000004"
+						}
+					]
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_pipeline.pipeline", "id", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckResourceAttr("powerplatform_pipeline.pipeline", "name", "Terraform Test Pipeline"),
+					resource.TestCheckResourceAttr("powerplatform_pipeline.pipeline", "development_environment_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_pipeline.pipeline", "development_environment_ids.*", "00000000-0000-0000-0000-000000000002"),
+					resource.TestCheckResourceAttr("powerplatform_pipeline.pipeline", "stages.#", "2"),
+					resource.TestCheckResourceAttr("powerplatform_pipeline.pipeline", "stages.0.id", "00000000-0000-0000-0000-000000000031"),
+					resource.TestCheckResourceAttr("powerplatform_pipeline.pipeline", "stages.0.name", "Test"),
+					resource.TestCheckResourceAttr("powerplatform_pipeline.pipeline", "stages.0.target_environment_id", "00000000-0000-0000-0000-000000000003"),
+					resource.TestCheckResourceAttr("powerplatform_pipeline.pipeline", "stages.1.id", "00000000-0000-0000-0000-000000000032"),
+					resource.TestCheckResourceAttr("powerplatform_pipeline.pipeline", "stages.1.name", "Production"),
+					resource.TestCheckResourceAttr("powerplatform_pipeline.pipeline", "stages.1.target_environment_id", "00000000-0000-0000-0000-000000000004"),
+				),
+			},
+		},
+	})
+}
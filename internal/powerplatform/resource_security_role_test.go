@@ -0,0 +1,327 @@
+package powerplatform
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestAccSecurityRoleResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck_Basic(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment" "security_role_example" {
+					display_name      = "` + mock_helpers.TestName() + `"
+					location          = "europe"
+					environment_type  = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_security_role" "account_reader" {
+					environment_id = powerplatform_environment.security_role_example.id
+					name           = "Account Reader"
+					privileges = [
+						{
+							name  = "prvReadAccount"
+							depth = "Organization"
+						},
+						{
+							name  = "prvWriteAccount"
+							depth = "User"
+						}
+					]
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_security_role.account_reader", "id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("powerplatform_security_role.account_reader", "business_unit_id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestCheckResourceAttr("powerplatform_security_role.account_reader", "name", "Account Reader"),
+					resource.TestCheckResourceAttr("powerplatform_security_role.account_reader", "privileges.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("powerplatform_security_role.account_reader", "privileges.*", map[string]string{
+						"name":  "prvReadAccount",
+						"depth": "Organization",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitSecurityRoleResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	roleGetInx := 1
+	privilegesGetInx := 1
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/security_role/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/businessunits?%24filter=_parentbusinessunitid_value+eq+null&%24select=businessunitid",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/security_role/Validate_Create_And_Update/get_businessunits.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/privileges",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/security_role/Validate_Create_And_Update/get_privileges.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusNoContent, "")
+			resp.Header.Add("OData-EntityId", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles(00000000-0000-0000-0000-000000000020)")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("PATCH", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles%2800000000-0000-0000-0000-000000000020%29",
+		func(req *http.Request) (*http.Response, error) {
+			roleGetInx = 2
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles%2800000000-0000-0000-0000-000000000020%29?%24select=roleid%2Cname%2Cdescription%2Cismanaged%2C_businessunitid_value",
+		func(req *http.Request) (*http.Response, error) {
+			url := fmt.Sprintf("services/authorization/tests/resource/security_role/Validate_Create_And_Update/get_role_00000000-0000-0000-0000-000000000020_%d.json", roleGetInx)
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(url).String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles%2800000000-0000-0000-0000-000000000020%29/Microsoft.Dynamics.CRM.ReplacePrivilegesRole",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(body), `{"Depth":"Global","PrivilegeId":"886b280c-6396-4d56-a0a3-2c1b0a50ceb0","BusinessUnitId":"00000000-0000-0000-0000-000000000010","PrivilegeName":"prvReadAccount"}`) ||
+				!strings.Contains(string(body), `{"Depth":"Basic","PrivilegeId":"41e3a9a7-a543-4c14-8f6f-0e9a8b3c2f6a","BusinessUnitId":"00000000-0000-0000-0000-000000000010","PrivilegeName":"prvWriteAccount"}`) {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles%2800000000-0000-0000-0000-000000000020%29/Microsoft.Dynamics.CRM.AddPrivilegesRole",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(body), `{"Depth":"Local","PrivilegeId":"886b280c-6396-4d56-a0a3-2c1b0a50ceb0","BusinessUnitId":"00000000-0000-0000-0000-000000000010","PrivilegeName":"prvReadAccount"}`) ||
+				!strings.Contains(string(body), `{"Depth":"Basic","PrivilegeId":"d26fe964-230b-42dd-ad93-5cc879de411e","BusinessUnitId":"00000000-0000-0000-0000-000000000010","PrivilegeName":"prvCreateAccount"}`) ||
+				strings.Contains(string(body), "prvWriteAccount") {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			privilegesGetInx = 2
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles%2800000000-0000-0000-0000-000000000020%29/Microsoft.Dynamics.CRM.RemovePrivilegeRole",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			if string(body) != `{"PrivilegeId":"41e3a9a7-a543-4c14-8f6f-0e9a8b3c2f6a"}` {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles%2800000000-0000-0000-0000-000000000020%29/Microsoft.Dynamics.CRM.RetrieveRolePrivilegesRole%28%29",
+		func(req *http.Request) (*http.Response, error) {
+			url := fmt.Sprintf("services/authorization/tests/resource/security_role/Validate_Create_And_Update/retrieve_role_privileges_%d.json", privilegesGetInx)
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(url).String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles%2800000000-0000-0000-0000-000000000020%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_security_role" "role" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Account Reader"
+					privileges = [
+						{
+							name  = "prvReadAccount"
+							depth = "Organization"
+						},
+						{
+							name  = "prvWriteAccount"
+							depth = "User"
+						}
+					]
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "id", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "name", "Account Reader"),
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "description", ""),
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "business_unit_id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "privileges.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("powerplatform_security_role.role", "privileges.*", map[string]string{
+						"name":  "prvReadAccount",
+						"depth": "Organization",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("powerplatform_security_role.role", "privileges.*", map[string]string{
+						"name":  "prvWriteAccount",
+						"depth": "User",
+					}),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_security_role" "role" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Account Editor"
+					description    = "Creates and reads accounts in the business unit"
+					privileges = [
+						{
+							name  = "prvReadAccount"
+							depth = "BusinessUnit"
+						},
+						{
+							name  = "prvCreateAccount"
+							depth = "User"
+						}
+					]
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "name", "Account Editor"),
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "description", "Creates and reads accounts in the business unit"),
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "privileges.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("powerplatform_security_role.role", "privileges.*", map[string]string{
+						"name":  "prvReadAccount",
+						"depth": "BusinessUnit",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("powerplatform_security_role.role", "privileges.*", map[string]string{
+						"name":  "prvCreateAccount",
+						"depth": "User",
+					}),
+				),
+			},
+			{
+				ResourceName:      "powerplatform_security_role.role",
+				ImportState:       true,
+				ImportStateId:     "00000000-0000-0000-0000-000000000001_00000000-0000-0000-0000-000000000020",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitSecurityRoleResource_Validate_Create_Deletes_Role_When_Privileges_Fail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	roleDeleted := false
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/security_role/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/businessunits?%24filter=_parentbusinessunitid_value+eq+null&%24select=businessunitid",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/security_role/Validate_Create_And_Update/get_businessunits.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/privileges",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/security_role/Validate_Create_And_Update/get_privileges.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusNoContent, "")
+			resp.Header.Add("OData-EntityId", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles(00000000-0000-0000-0000-000000000020)")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles%2800000000-0000-0000-0000-000000000020%29?%24select=roleid%2Cname%2Cdescription%2Cismanaged%2C_businessunitid_value",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/security_role/Validate_Create_And_Update/get_role_00000000-0000-0000-0000-000000000020_1.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles%2800000000-0000-0000-0000-000000000020%29/Microsoft.Dynamics.CRM.ReplacePrivilegesRole",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusInternalServerError, ""), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles%2800000000-0000-0000-0000-000000000020%29",
+		func(req *http.Request) (*http.Response, error) {
+			roleDeleted = true
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_security_role" "role" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Account Reader"
+					privileges = [
+						{
+							name  = "prvReadAccount"
+							depth = "Organization"
+						}
+					]
+				}`,
+
+				ExpectError: regexp.MustCompile("Client error when setting privileges of"),
+			},
+		},
+	})
+
+	if !roleDeleted {
+		t.Errorf("expected the security role to be deleted after failing to set its privileges")
+	}
+}
+
+func TestUnitSecurityRoleResource_Validate_Duplicate_Privileges(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_security_role" "role" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Account Reader"
+					privileges = [
+						{
+							name  = "prvReadAccount"
+							depth = "Organization"
+						},
+						{
+							name  = "prvReadAccount"
+							depth = "User"
+						}
+					]
+				}`,
+				ExpectError: regexp.MustCompile("Duplicate privilege"),
+			},
+		},
+	})
+}
@@ -0,0 +1,199 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestAccSolutionPatchResource_Validate_Create(t *testing.T) {
+	solutionName := "TerraformTestSolution"
+	solutionFileName := solutionName + "_Complex_1_1_0_0.zip"
+
+	solutionFileBytes, err := os.ReadFile(filepath.Join("../../examples/resources/powerplatform_solution", solutionFileName))
+	if err != nil {
+		t.Fatalf("Failed to read solution file: %v", err)
+	}
+
+	err = os.WriteFile(solutionFileName, solutionFileBytes, 0644)
+	if err != nil {
+		t.Fatalf("Failed to write solution file: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck_Basic(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment" "environment" {
+					display_name                              = "` + mock_helpers.TestName() + `"
+					location                                  = "europe"
+					environment_type                          = "Sandbox"
+					dataverse = {
+						language_code                             = "1033"
+						currency_code                           = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_solution" "solution" {
+					environment_id = powerplatform_environment.environment.id
+					solution_name    = "` + solutionName + `"
+					solution_file    = "` + solutionFileName + `"
+				}
+
+				resource "powerplatform_solution_patch" "patch" {
+					environment_id       = powerplatform_environment.environment.id
+					parent_solution_name = powerplatform_solution.solution.solution_name
+					display_name         = "Terraform Test Solution Hotfix"
+					solution_version     = "1.1.1.0"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_solution_patch.patch", "solution_id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("powerplatform_solution_patch.patch", "solution_name", regexp.MustCompile(`^`+solutionName+`_Patch_`)),
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "parent_solution_name", solutionName),
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "solution_version", "1.1.1.0"),
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "is_managed", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitSolutionPatchResource_Validate_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/solution_patch/Validate_Create/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/solutions?%24expand=publisherid&%24filter=%28isvisible+eq+true%29&%24orderby=createdon+desc",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/solution_patch/Validate_Create/get_solutions.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/CloneAsPatch",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/solution_patch/Validate_Create/post_clone_as_patch.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/solutions%2800000000-0000-0000-0000-000000000003%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_solution_patch" "patch" {
+					environment_id       = "00000000-0000-0000-0000-000000000001"
+					parent_solution_name = "TerraformTestSolution"
+					display_name         = "Terraform Test Solution Hotfix"
+					solution_version     = "1.1.1.0"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "id", "00000000-0000-0000-0000-000000000001_00000000-0000-0000-0000-000000000003"),
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "solution_id", "00000000-0000-0000-0000-000000000003"),
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "solution_name", "TerraformTestSolution_Patch_a1b2c3"),
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "parent_solution_name", "TerraformTestSolution"),
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "display_name", "Terraform Test Solution Hotfix"),
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "solution_version", "1.1.1.0"),
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "is_managed", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitSolutionPatchResource_Validate_Create_Keeps_Configured_Names(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/solution_patch/Validate_Create/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/solutions?%24expand=publisherid&%24filter=%28isvisible+eq+true%29&%24orderby=createdon+desc",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/solution_patch/Validate_Create/get_solutions.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/CloneAsPatch",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/solution_patch/Validate_Create/post_clone_as_patch.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/solutions%2800000000-0000-0000-0000-000000000003%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_solution_patch" "patch" {
+					environment_id       = "00000000-0000-0000-0000-000000000001"
+					parent_solution_name = "terraformtestsolution"
+					display_name         = "Hotfix"
+					solution_version     = "1.1.1.0"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "id", "00000000-0000-0000-0000-000000000001_00000000-0000-0000-0000-000000000003"),
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "solution_id", "00000000-0000-0000-0000-000000000003"),
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "solution_name", "TerraformTestSolution_Patch_a1b2c3"),
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "parent_solution_name", "terraformtestsolution"),
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "display_name", "Hotfix"),
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "solution_version", "1.1.1.0"),
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "is_managed", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitSolutionPatchResource_Validate_Invalid_Version(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_solution_patch" "patch" {
+					environment_id       = "00000000-0000-0000-0000-000000000001"
+					parent_solution_name = "TerraformTestSolution"
+					display_name         = "Terraform Test Solution Hotfix"
+					solution_version     = "1.1"
+				}`,
+
+				ExpectError: regexp.MustCompile(`solution_version must be in the format`),
+			},
+		},
+	})
+}
@@ -560,6 +560,153 @@ func TestUnitSolutionResource_Validate_Create_No_Dataverse(t *testing.T) {
 	})
 }
 
+func TestUnitSolutionResource_Validate_Create_With_Solution_Checker(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	solution_checksum := createFile("test_solution.zip", "test_solution")
+
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/Validate_Create_With_Solution_Checker/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://europe.api.advisor.powerapps.com/api/upload?api-version=1.0",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/Validate_Create_With_Solution_Checker/post_upload.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://europe.api.advisor.powerapps.com/api/analyze?api-version=1.0",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Add("Location", "https://europe.api.advisor.powerapps.com/api/status/00000000-0000-0000-0000-000000000003?api-version=1.0")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://europe.api.advisor.powerapps.com/api/status/00000000-0000-0000-0000-000000000003?api-version=1.0",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/Validate_Create_With_Solution_Checker/get_analyze_status.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/StageSolution",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/Validate_Create_With_Solution_Checker/post_stage_solution.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/ImportSolutionAsync",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/Validate_Create_With_Solution_Checker/post_import_solution_async.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/asyncoperations%28310799b8-dc6c-ee11-9ae7-000d3aaae21d%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/Validate_Create_With_Solution_Checker/get_async_operations.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.0/RetrieveSolutionImportResult%28ImportJobId=1b1fa80d-aa0f-4291-b60c-b0745304ce24%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/Validate_Create_With_Solution_Checker/get_solution_import_result.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/solutions?%24expand=publisherid&%24filter=%28isvisible+eq+true%29&%24orderby=createdon+desc",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/Validate_Create_With_Solution_Checker/get_solution.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/solutions%2886928ed8-df37-4ce2-add5-47030a833bff%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, httpmock.File("services/solution/tests/resource/Validate_Create_With_Solution_Checker/get_solution.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_solution" "solution" {
+					environment_id                    = "00000000-0000-0000-0000-000000000001"
+					solution_name                     = "TerraformTestSolution"
+					solution_file                     = "test_solution.zip"
+					run_solution_checker              = true
+					solution_checker_fail_on_severity = "High"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "solution_file_checksum", solution_checksum),
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "solution_name", "TerraformTestSolution"),
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "solution_checker_results.run_correlation_id", "00000000-0000-0000-0000-000000000003"),
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "solution_checker_results.critical_issue_count", "0"),
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "solution_checker_results.high_issue_count", "0"),
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "solution_checker_results.medium_issue_count", "1"),
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "solution_checker_results.low_issue_count", "0"),
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "solution_checker_results.informational_issue_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitSolutionResource_Validate_Create_With_Solution_Checker_Blocked(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	createFile("test_solution.zip", "test_solution")
+
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/Validate_Create_With_Solution_Checker_Blocked/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://europe.api.advisor.powerapps.com/api/upload?api-version=1.0",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/Validate_Create_With_Solution_Checker_Blocked/post_upload.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://europe.api.advisor.powerapps.com/api/analyze?api-version=1.0",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Add("Location", "https://europe.api.advisor.powerapps.com/api/status/00000000-0000-0000-0000-000000000003?api-version=1.0")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://europe.api.advisor.powerapps.com/api/status/00000000-0000-0000-0000-000000000003?api-version=1.0",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/Validate_Create_With_Solution_Checker_Blocked/get_analyze_status.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://europe.blob.core.windows.net/solutionchecker/00000000-0000-0000-0000-000000000003/results.sarif",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Authorization") != "" {
+				return httpmock.NewStringResponse(http.StatusForbidden, ""), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/solution/tests/resource/Validate_Create_With_Solution_Checker_Blocked/get_results.sarif").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_solution" "solution" {
+					environment_id                    = "00000000-0000-0000-0000-000000000001"
+					solution_name                     = "TerraformTestSolution"
+					solution_file                     = "test_solution.zip"
+					run_solution_checker              = true
+					solution_checker_fail_on_severity = "High"
+				}`,
+
+				ExpectError: regexp.MustCompile(`(?s)Solution checker reported 1 issue\(s\) of severity 'High' or\s+higher.*meta-avoid-reg-no-attribute`),
+			},
+		},
+	})
+}
+
 func createFile(fileName string, content string) string {
 	file, err := os.Create(fileName)
 
@@ -0,0 +1,91 @@
+package powerplatform
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestUnitTeamMembershipResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	membersGetInx := 0
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/team_membership/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000020%29/teammembership_association?%24select=systemuserid",
+		func(req *http.Request) (*http.Response, error) {
+			url := fmt.Sprintf("services/authorization/tests/resource/team_membership/Validate_Create_And_Update/get_team_members_%d.json", membersGetInx)
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(url).String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams(00000000-0000-0000-0000-000000000020)/teammembership_association?%24select=systemuserid&%24skiptoken=2",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/team_membership/Validate_Create_And_Update/get_team_members_1_page_2.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000020%29/teammembership_association/$ref",
+		func(req *http.Request) (*http.Response, error) {
+			membersGetInx++
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000020%29/teammembership_association/$ref?%24id=https%3A%2F%2F00000000-0000-0000-0000-000000000001.crm4.dynamics.com%2Fapi%2Fdata%2Fv9.2%2Fsystemusers%2800000000-0000-0000-0000-000000000031%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000020%29/teammembership_association/$ref?%24id=https%3A%2F%2F00000000-0000-0000-0000-000000000001.crm4.dynamics.com%2Fapi%2Fdata%2Fv9.2%2Fsystemusers%2800000000-0000-0000-0000-000000000032%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_team_membership" "members" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					team_id        = "00000000-0000-0000-0000-000000000020"
+					user_ids = [
+					  "00000000-0000-0000-0000-000000000031",
+					]
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_team_membership.members", "id", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckResourceAttr("powerplatform_team_membership.members", "team_id", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckResourceAttr("powerplatform_team_membership.members", "user_ids.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_team_membership.members", "user_ids.0", "00000000-0000-0000-0000-000000000031"),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_team_membership" "members" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					team_id        = "00000000-0000-0000-0000-000000000020"
+					user_ids = [
+					  "00000000-0000-0000-0000-000000000032",
+					]
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_team_membership.members", "user_ids.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_team_membership.members", "user_ids.0", "00000000-0000-0000-0000-000000000032"),
+				),
+			},
+		},
+	})
+}
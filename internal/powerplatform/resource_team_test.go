@@ -0,0 +1,268 @@
+package powerplatform
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
+)
+
+func TestAccTeamResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck_Basic(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_environment" "team_example" {
+					display_name      = "` + mock_helpers.TestName() + `"
+					location          = "europe"
+					environment_type  = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_team" "owner_team" {
+					environment_id = powerplatform_environment.team_example.id
+					name           = "` + mock_helpers.TestName() + `"
+					team_type      = "Owner"
+					security_roles = [
+					  "e0d2794e-82f3-e811-a951-000d3a1bcf17", // bot author
+					]
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_team.owner_team", "id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("powerplatform_team.owner_team", "business_unit_id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("powerplatform_team.owner_team", "administrator_id", regexp.MustCompile(powerplatform_helpers.GuidRegex)),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "name", mock_helpers.TestName()),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "team_type", "Owner"),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "security_roles.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "security_roles.0", "e0d2794e-82f3-e811-a951-000d3a1bcf17"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitTeamResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	teamGetInx := 1
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/team/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/businessunits?%24filter=_parentbusinessunitid_value+eq+null&%24select=businessunitid",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/team/Validate_Create_And_Update/get_businessunits.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusNoContent, "")
+			resp.Header.Add("OData-EntityId", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams(00000000-0000-0000-0000-000000000020)")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("PATCH", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000020%29",
+		func(req *http.Request) (*http.Response, error) {
+			teamGetInx = 2
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000020%29/teamroles_association/$ref",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000020%29/teamroles_association/$ref?%24id=https%3A%2F%2F00000000-0000-0000-0000-000000000001.crm4.dynamics.com%2Fapi%2Fdata%2Fv9.2%2Froles%2800000000-0000-0000-0000-000000000001%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000020%29?%24expand=teamroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29",
+		func(req *http.Request) (*http.Response, error) {
+			url := fmt.Sprintf("services/authorization/tests/resource/team/Validate_Create_And_Update/get_team_00000000-0000-0000-0000-000000000020_%d.json", teamGetInx)
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(url).String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000020%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_team" "owner_team" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Contoso Owners"
+					team_type      = "Owner"
+					security_roles = [
+					  "00000000-0000-0000-0000-000000000001",
+					]
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "id", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "name", "Contoso Owners"),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "description", ""),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "team_type", "Owner"),
+					resource.TestCheckNoResourceAttr("powerplatform_team.owner_team", "entra_object_id"),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "business_unit_id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "administrator_id", "00000000-0000-0000-0000-000000000030"),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "security_roles.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "security_roles.0", "00000000-0000-0000-0000-000000000001"),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_team" "owner_team" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Contoso Administrators"
+					description    = "Administrators of Contoso"
+					team_type      = "Owner"
+					security_roles = [
+					  "00000000-0000-0000-0000-000000000002",
+					]
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "id", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "name", "Contoso Administrators"),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "description", "Administrators of Contoso"),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "security_roles.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "security_roles.0", "00000000-0000-0000-0000-000000000002"),
+				),
+			},
+			{
+				ResourceName:      "powerplatform_team.owner_team",
+				ImportState:       true,
+				ImportStateId:     "00000000-0000-0000-0000-000000000001_00000000-0000-0000-0000-000000000020",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitTeamResource_Validate_Create_Without_Security_Roles(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	teamGetInx := 1
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/team/Validate_Create_Without_Security_Roles/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/businessunits?%24filter=_parentbusinessunitid_value+eq+null&%24select=businessunitid",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/team/Validate_Create_Without_Security_Roles/get_businessunits.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusNoContent, "")
+			resp.Header.Add("OData-EntityId", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams(00000000-0000-0000-0000-000000000020)")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("PATCH", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000020%29",
+		func(req *http.Request) (*http.Response, error) {
+			teamGetInx = 2
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000020%29?%24expand=teamroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29",
+		func(req *http.Request) (*http.Response, error) {
+			url := fmt.Sprintf("services/authorization/tests/resource/team/Validate_Create_Without_Security_Roles/get_team_00000000-0000-0000-0000-000000000020_%d.json", teamGetInx)
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(url).String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000020%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_team" "owner_team" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Contoso Owners"
+					team_type      = "Owner"
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "id", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "name", "Contoso Owners"),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "security_roles.#", "0"),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_team" "owner_team" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Contoso Administrators"
+					team_type      = "Owner"
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "name", "Contoso Administrators"),
+					resource.TestCheckResourceAttr("powerplatform_team.owner_team", "security_roles.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitTeamResource_Validate_Entra_Team_Attributes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_team" "group_team" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Contoso Group"
+					team_type      = "EntraSecurityGroup"
+				}`,
+				ExpectError: regexp.MustCompile(`The argument "entra_object_id" is required for EntraSecurityGroup\s+teams`),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_team" "owner_team" {
+					environment_id  = "00000000-0000-0000-0000-000000000001"
+					name            = "Contoso Owners"
+					team_type       = "Owner"
+					membership_type = "Members"
+				}`,
+				ExpectError: regexp.MustCompile(`The argument "membership_type" can only be set for EntraSecurityGroup and\s+EntraOfficeGroup teams`),
+			},
+		},
+	})
+}
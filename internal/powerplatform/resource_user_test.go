@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/jarcoal/httpmock"
 	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
 	mock_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/mocks"
@@ -453,3 +454,216 @@ func TestUnitUserResource_Validate_Disable_Delete(t *testing.T) {
 	})
 
 }
+
+func TestUnitUserResource_Validate_Move_Business_Unit(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	systemUserGetInx := 1
+
+	httpmock.RegisterResponder("POST", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001/addUser?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/user/Validate_Move_Business_Unit/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29&%24filter=azureactivedirectoryobjectid+eq+00000000-0000-0000-0000-000000000002",
+		func(req *http.Request) (*http.Response, error) {
+			url := fmt.Sprintf("services/authorization/tests/resource/user/Validate_Move_Business_Unit/get_systemusers_%d.json", systemUserGetInx)
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(url).String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29",
+		func(req *http.Request) (*http.Response, error) {
+			url := fmt.Sprintf("services/authorization/tests/resource/user/Validate_Move_Business_Unit/get_systemuser_00000000-0000-0000-0000-000000000002_%d.json", systemUserGetInx)
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(url).String()), nil
+		})
+
+	httpmock.RegisterResponder("PATCH", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29",
+		func(req *http.Request) (*http.Response, error) {
+			systemUserGetInx = 2
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29/systemuserroles_association/$ref",
+		func(req *http.Request) (*http.Response, error) {
+			if systemUserGetInx == 2 {
+				systemUserGetInx = 3
+			}
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_user" "new_user" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					security_roles = [
+					  "d58407f2-48d5-e711-a82c-000d3a37c848",
+					]
+					aad_id         = "00000000-0000-0000-0000-000000000002"
+					disable_delete = false
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_user.new_user", "business_unit_id", "1360fdcb-b6e1-ee11-904c-002248dad9c1"),
+					resource.TestCheckResourceAttr("powerplatform_user.new_user", "security_roles.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_user.new_user", "security_roles.0", "d58407f2-48d5-e711-a82c-000d3a37c848"),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_user" "new_user" {
+					environment_id   = "00000000-0000-0000-0000-000000000001"
+					business_unit_id = "00000000-0000-0000-0000-000000000011"
+					security_roles = [
+					  "00000000-0000-0000-0000-000000000005",
+					]
+					aad_id         = "00000000-0000-0000-0000-000000000002"
+					disable_delete = false
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("powerplatform_user.new_user", plancheck.ResourceActionUpdate),
+					},
+				},
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_user.new_user", "id", "00000000-0000-0000-0000-000000000002"),
+					resource.TestCheckResourceAttr("powerplatform_user.new_user", "business_unit_id", "00000000-0000-0000-0000-000000000011"),
+					resource.TestCheckResourceAttr("powerplatform_user.new_user", "security_roles.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_user.new_user", "security_roles.0", "00000000-0000-0000-0000-000000000005"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitUserResource_Validate_Security_Role_Names(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mock_helpers.ActivateEnvironmentHttpMocks()
+
+	userInx := 1
+
+	httpmock.RegisterResponder("POST", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001/addUser?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/user/Validate_Security_Role_Names/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/businessunits?%24filter=_parentbusinessunitid_value+eq+null&%24select=businessunitid",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/user/Validate_Security_Role_Names/get_businessunits.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles?%24filter=_businessunitid_value+eq+00000000-0000-0000-0000-000000000010",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("services/authorization/tests/resource/user/Validate_Security_Role_Names/get_roles.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29&%24filter=azureactivedirectoryobjectid+eq+00000000-0000-0000-0000-000000000002",
+		func(req *http.Request) (*http.Response, error) {
+			url := fmt.Sprintf("services/authorization/tests/resource/user/Validate_Security_Role_Names/get_systemusers_%d.json", userInx)
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(url).String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29",
+		func(req *http.Request) (*http.Response, error) {
+			url := fmt.Sprintf("services/authorization/tests/resource/user/Validate_Security_Role_Names/get_systemuser_00000000-0000-0000-0000-000000000002_%d.json", userInx)
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(url).String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29/systemuserroles_association/$ref",
+		func(req *http.Request) (*http.Response, error) {
+			userInx = 2
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29/systemuserroles_association/$ref?%24id=https%3A%2F%2F00000000-0000-0000-0000-000000000001.crm4.dynamics.com%2Fapi%2Fdata%2Fv9.2%2Froles%2800000000-0000-0000-0000-000000000022%29",
+		func(req *http.Request) (*http.Response, error) {
+			userInx = 3
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_user" "new_user" {
+					environment_id      = "00000000-0000-0000-0000-000000000001"
+					aad_id              = "00000000-0000-0000-0000-000000000002"
+					security_roles      = ["00000000-0000-0000-0000-000000000021"]
+					security_role_names = ["Basic User"]
+					disable_delete      = false
+				}`,
+				ExpectError: regexp.MustCompile(`Only\s+one\s+of\s+.security_roles.\s+and\s+.security_role_names.\s+can\s+be\s+set`),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_user" "new_user" {
+					environment_id      = "00000000-0000-0000-0000-000000000001"
+					aad_id              = "00000000-0000-0000-0000-000000000002"
+					security_role_names = ["Basic User", "system customizer"]
+					disable_delete      = false
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_user.new_user", "business_unit_id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_user.new_user", "security_role_names.#", "2"),
+					resource.TestCheckResourceAttr("powerplatform_user.new_user", "security_roles.#", "2"),
+					resource.TestCheckResourceAttr("powerplatform_user.new_user", "security_roles.0", "00000000-0000-0000-0000-000000000021"),
+					resource.TestCheckResourceAttr("powerplatform_user.new_user", "security_roles.1", "00000000-0000-0000-0000-000000000022"),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_user" "new_user" {
+					environment_id      = "00000000-0000-0000-0000-000000000001"
+					aad_id              = "00000000-0000-0000-0000-000000000002"
+					security_role_names = ["Basic User"]
+					disable_delete      = false
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_user.new_user", "security_roles.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_user.new_user", "security_roles.0", "00000000-0000-0000-0000-000000000021"),
+				),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_user" "new_user" {
+					environment_id      = "00000000-0000-0000-0000-000000000001"
+					aad_id              = "00000000-0000-0000-0000-000000000002"
+					security_role_names = ["Environment Maker"]
+					disable_delete      = false
+				}`,
+				ExpectError: regexp.MustCompile(`security\s+role\s+name\s+'Environment\s+Maker'\s+is\s+ambiguous`),
+			},
+			{
+				Config: TestsProviderConfig + `
+				resource "powerplatform_user" "new_user" {
+					environment_id      = "00000000-0000-0000-0000-000000000001"
+					aad_id              = "00000000-0000-0000-0000-000000000002"
+					security_role_names = ["Basic User", "Sales Manager"]
+					disable_delete      = false
+				}`,
+				ExpectError: regexp.MustCompile(`security\s+roles\s+not\s+found\s+in\s+business\s+unit\s+00000000-0000-0000-0000-000000000010:\s+Sales\s+Manager`),
+			},
+		},
+	})
+}
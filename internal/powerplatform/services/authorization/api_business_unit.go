@@ -0,0 +1,123 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+func NewBusinessUnitClient(api *api.ApiClient) BusinessUnitClient {
+	return BusinessUnitClient{
+		Api:        api,
+		userClient: NewUserClient(api),
+	}
+}
+
+type BusinessUnitClient struct {
+	Api        *api.ApiClient
+	userClient UserClient
+}
+
+func (client *BusinessUnitClient) GetBusinessUnits(ctx context.Context, environmentId string) ([]BusinessUnitDto, error) {
+	values := url.Values{}
+	values.Add("$select", "businessunitid,name,_parentbusinessunitid_value,costcenter,description,isdisabled")
+	values.Add("$orderby", "name")
+	apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "businessunits", values)
+	if err != nil {
+		return nil, err
+	}
+
+	businessUnits := BusinessUnitDtoArray{}
+	_, err = client.Api.Execute(ctx, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &businessUnits)
+	if err != nil {
+		return nil, err
+	}
+	return businessUnits.Value, nil
+}
+
+func (client *BusinessUnitClient) GetBusinessUnit(ctx context.Context, environmentId, businessUnitId string) (*BusinessUnitDto, error) {
+	values := url.Values{}
+	values.Add("$select", "businessunitid,name,_parentbusinessunitid_value,costcenter,description,isdisabled")
+	apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "businessunits("+businessUnitId+")", values)
+	if err != nil {
+		return nil, err
+	}
+
+	businessUnit := BusinessUnitDto{}
+	_, err = client.Api.Execute(ctx, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &businessUnit)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil, powerplatform_helpers.WrapIntoProviderError(err, powerplatform_helpers.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("Business unit with id %s not found", businessUnitId))
+		}
+		return nil, err
+	}
+	return &businessUnit, nil
+}
+
+func (client *BusinessUnitClient) CreateBusinessUnit(ctx context.Context, environmentId string, businessUnitToCreate CreateBusinessUnitDto) (*BusinessUnitDto, error) {
+	apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "businessunits", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.Api.Execute(ctx, "POST", apiUrl, nil, businessUnitToCreate, []int{http.StatusOK, http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	businessUnitId, err := api.GetCreatedEntityId(response, "business unit")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetBusinessUnit(ctx, environmentId, businessUnitId)
+}
+
+func (client *BusinessUnitClient) UpdateBusinessUnit(ctx context.Context, environmentId, businessUnitId string, businessUnitToUpdate UpdateBusinessUnitDto) (*BusinessUnitDto, error) {
+	apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "businessunits("+businessUnitId+")", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = client.Api.Execute(ctx, "PATCH", apiUrl, nil, businessUnitToUpdate, []int{http.StatusOK, http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetBusinessUnit(ctx, environmentId, businessUnitId)
+}
+
+func (client *BusinessUnitClient) SetBusinessUnitDisabled(ctx context.Context, environmentId, businessUnitId string, disabled bool) (*BusinessUnitDto, error) {
+	apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "businessunits("+businessUnitId+")", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	businessUnitUpdate := map[string]interface{}{
+		"isdisabled": disabled,
+	}
+	_, err = client.Api.Execute(ctx, "PATCH", apiUrl, nil, businessUnitUpdate, []int{http.StatusOK, http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetBusinessUnit(ctx, environmentId, businessUnitId)
+}
+
+func (client *BusinessUnitClient) DeleteBusinessUnit(ctx context.Context, environmentId, businessUnitId string) error {
+	apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "businessunits("+businessUnitId+")", nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Api.Execute(ctx, "DELETE", apiUrl, nil, nil, []int{http.StatusNoContent}, nil)
+	return err
+}
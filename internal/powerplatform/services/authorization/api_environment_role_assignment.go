@@ -0,0 +1,147 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+const ENVIRONMENT_ROLE_ASSIGNMENT_API_VERSION = "2016-11-01"
+
+func NewEnvironmentRoleAssignmentClient(api *api.ApiClient) EnvironmentRoleAssignmentClient {
+	return EnvironmentRoleAssignmentClient{
+		Api:        api,
+		userClient: NewUserClient(api),
+	}
+}
+
+type EnvironmentRoleAssignmentClient struct {
+	Api        *api.ApiClient
+	userClient UserClient
+}
+
+func (client *EnvironmentRoleAssignmentClient) GetEnvironmentRoleAssignments(ctx context.Context, environmentId string) ([]EnvironmentRoleAssignmentDto, error) {
+	apiUrl := client.buildEnvironmentRoleAssignmentUrl(environmentId, "roleAssignments")
+
+	assignmentArray := EnvironmentRoleAssignmentDtoArray{}
+	_, err := client.Api.Execute(ctx, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &assignmentArray)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil, powerplatform_helpers.WrapIntoProviderError(err, powerplatform_helpers.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("environment %s not found", environmentId))
+		}
+		return nil, err
+	}
+	return assignmentArray.Value, nil
+}
+
+func (client *EnvironmentRoleAssignmentClient) GetEnvironmentRoleAssignment(ctx context.Context, environmentId, assignmentId string) (*EnvironmentRoleAssignmentDto, error) {
+	assignments, err := client.GetEnvironmentRoleAssignments(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+	for _, assignment := range assignments {
+		if strings.EqualFold(assignment.Name, assignmentId) {
+			return &assignment, nil
+		}
+	}
+	return nil, powerplatform_helpers.NewProviderError(powerplatform_helpers.ERROR_OBJECT_NOT_FOUND, "role assignment %s not found in environment %s", assignmentId, environmentId)
+}
+
+// CreateEnvironmentRoleAssignment assigns an environment role to a principal. Environments with Dataverse manage their permissions
+// with security roles, so the assignment is rejected for them.
+func (client *EnvironmentRoleAssignmentClient) CreateEnvironmentRoleAssignment(ctx context.Context, environmentId, roleName string, principal EnvironmentRolePrincipalDto) (*EnvironmentRoleAssignmentDto, error) {
+	dataverseExists, err := client.userClient.DataverseExists(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+	if dataverseExists {
+		return nil, fmt.Errorf("environment %s has a Dataverse database, its permissions are managed with security roles of powerplatform_user instead", environmentId)
+	}
+
+	assignmentToCreate := EnvironmentRoleAssignmentModifyDto{
+		Add: []EnvironmentRoleAssignmentModifyAddDto{
+			{
+				Properties: EnvironmentRoleAssignmentPropertiesDto{
+					RoleDefinition: EnvironmentRoleDefinitionDto{
+						Id: fmt.Sprintf("/providers/Microsoft.BusinessAppPlatform/environments/%s/roleDefinitions/%s", environmentId, roleName),
+					},
+					Principal: principal,
+				},
+			},
+		},
+		Remove: []EnvironmentRoleAssignmentModifyRemoveDto{},
+	}
+	response, err := client.modifyEnvironmentRoleAssignments(ctx, environmentId, assignmentToCreate)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Add) > 0 && response.Add[0].RoleAssignment != nil {
+		return response.Add[0].RoleAssignment, nil
+	}
+
+	//the assignment isn't always returned, so it is looked up by principal and role
+	assignments, err := client.GetEnvironmentRoleAssignments(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+	for _, assignment := range assignments {
+		if strings.EqualFold(assignment.Properties.Principal.Id, principal.Id) && assignment.RoleName() == roleName {
+			return &assignment, nil
+		}
+	}
+	return nil, fmt.Errorf("role assignment of %s for principal %s not found in environment %s after it was created", roleName, principal.Id, environmentId)
+}
+
+func (client *EnvironmentRoleAssignmentClient) DeleteEnvironmentRoleAssignment(ctx context.Context, environmentId, assignmentId string) error {
+	assignmentToDelete := EnvironmentRoleAssignmentModifyDto{
+		Add: []EnvironmentRoleAssignmentModifyAddDto{},
+		Remove: []EnvironmentRoleAssignmentModifyRemoveDto{
+			{
+				Id: fmt.Sprintf("/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/%s/roleAssignments/%s", environmentId, assignmentId),
+			},
+		},
+	}
+	_, err := client.modifyEnvironmentRoleAssignments(ctx, environmentId, assignmentToDelete)
+	if err != nil && !strings.Contains(err.Error(), "404") {
+		return err
+	}
+	return nil
+}
+
+// modifyEnvironmentRoleAssignments applies the changes and fails if any of them was rejected, because the endpoint reports errors per change.
+func (client *EnvironmentRoleAssignmentClient) modifyEnvironmentRoleAssignments(ctx context.Context, environmentId string, assignments EnvironmentRoleAssignmentModifyDto) (*EnvironmentRoleAssignmentModifyResponseDto, error) {
+	apiUrl := client.buildEnvironmentRoleAssignmentUrl(environmentId, "modifyRoleAssignments")
+
+	response := EnvironmentRoleAssignmentModifyResponseDto{}
+	_, err := client.Api.Execute(ctx, "POST", apiUrl, nil, assignments, []int{http.StatusOK}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range append(response.Add, response.Remove...) {
+		if result.Error != nil {
+			return nil, fmt.Errorf("role assignment change failed with status %s: %s %s", result.HttpStatus, result.Error.Code, result.Error.Message)
+		}
+	}
+	return &response, nil
+}
+
+func (client *EnvironmentRoleAssignmentClient) buildEnvironmentRoleAssignmentUrl(environmentId, action string) string {
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   client.Api.GetConfig().Urls.BapiUrl,
+		Path:   fmt.Sprintf("/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/%s/%s", environmentId, action),
+	}
+	values := url.Values{}
+	values.Add("api-version", ENVIRONMENT_ROLE_ASSIGNMENT_API_VERSION)
+	apiUrl.RawQuery = values.Encode()
+	return apiUrl.String()
+}
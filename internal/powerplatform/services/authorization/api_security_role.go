@@ -0,0 +1,211 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+func NewSecurityRoleClient(api *api.ApiClient) SecurityRoleClient {
+	return SecurityRoleClient{
+		Api:        api,
+		userClient: NewUserClient(api),
+	}
+}
+
+type SecurityRoleClient struct {
+	Api        *api.ApiClient
+	userClient UserClient
+}
+
+func (client *SecurityRoleClient) GetSecurityRole(ctx context.Context, environmentId, roleId string) (*SecurityRoleDetailsDto, error) {
+	values := url.Values{}
+	values.Add("$select", "roleid,name,description,ismanaged,_businessunitid_value")
+	apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "roles("+roleId+")", values)
+	if err != nil {
+		return nil, err
+	}
+
+	role := SecurityRoleDetailsDto{}
+	_, err = client.Api.Execute(ctx, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &role)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil, powerplatform_helpers.WrapIntoProviderError(err, powerplatform_helpers.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("Security role with id %s not found", roleId))
+		}
+		return nil, err
+	}
+	return &role, nil
+}
+
+func (client *SecurityRoleClient) CreateSecurityRole(ctx context.Context, environmentId string, roleToCreate CreateSecurityRoleDto) (*SecurityRoleDetailsDto, error) {
+	apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "roles", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.Api.Execute(ctx, "POST", apiUrl, nil, roleToCreate, []int{http.StatusOK, http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	roleId, err := api.GetCreatedEntityId(response, "security role")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetSecurityRole(ctx, environmentId, roleId)
+}
+
+func (client *SecurityRoleClient) UpdateSecurityRole(ctx context.Context, environmentId, roleId string, roleToUpdate UpdateSecurityRoleDto) (*SecurityRoleDetailsDto, error) {
+	apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "roles("+roleId+")", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = client.Api.Execute(ctx, "PATCH", apiUrl, nil, roleToUpdate, []int{http.StatusOK, http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetSecurityRole(ctx, environmentId, roleId)
+}
+
+func (client *SecurityRoleClient) DeleteSecurityRole(ctx context.Context, environmentId, roleId string) error {
+	apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "roles("+roleId+")", nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Api.Execute(ctx, "DELETE", apiUrl, nil, nil, []int{http.StatusNoContent}, nil)
+	return err
+}
+
+func (client *SecurityRoleClient) GetRolePrivileges(ctx context.Context, environmentId, roleId string) ([]RolePrivilegeDto, error) {
+	apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "roles("+roleId+")/Microsoft.Dynamics.CRM.RetrieveRolePrivilegesRole()", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := RetrieveRolePrivilegesResponseDto{}
+	_, err = client.Api.Execute(ctx, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &response)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil, powerplatform_helpers.WrapIntoProviderError(err, powerplatform_helpers.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("Security role with id %s not found", roleId))
+		}
+		return nil, err
+	}
+
+	//older organizations don't return the privilege names, so they are resolved from the privilege ids
+	missingNames := []string{}
+	for _, privilege := range response.RolePrivileges {
+		if privilege.PrivilegeName == "" {
+			missingNames = append(missingNames, privilege.PrivilegeId)
+		}
+	}
+	if len(missingNames) > 0 {
+		privileges, err := client.getPrivileges(ctx, environmentId, "privilegeid", missingNames)
+		if err != nil {
+			return nil, err
+		}
+		names := map[string]string{}
+		for _, privilege := range privileges {
+			names[strings.ToLower(privilege.Id)] = privilege.Name
+		}
+		for i, privilege := range response.RolePrivileges {
+			if privilege.PrivilegeName == "" {
+				response.RolePrivileges[i].PrivilegeName = names[strings.ToLower(privilege.PrivilegeId)]
+			}
+		}
+	}
+	return response.RolePrivileges, nil
+}
+
+// GetPrivilegesByName resolves privilege names to privileges and fails if any of the names doesn't exist in the environment.
+func (client *SecurityRoleClient) GetPrivilegesByName(ctx context.Context, environmentId string, privilegeNames []string) (map[string]PrivilegeDto, error) {
+	privileges, err := client.getPrivileges(ctx, environmentId, "name", privilegeNames)
+	if err != nil {
+		return nil, err
+	}
+
+	privilegesByName := map[string]PrivilegeDto{}
+	for _, privilege := range privileges {
+		privilegesByName[privilege.Name] = privilege
+	}
+
+	unknownPrivileges := []string{}
+	for _, name := range privilegeNames {
+		if _, ok := privilegesByName[name]; !ok {
+			unknownPrivileges = append(unknownPrivileges, name)
+		}
+	}
+	if len(unknownPrivileges) > 0 {
+		return nil, fmt.Errorf("privileges not found in environment %s: %s", environmentId, strings.Join(unknownPrivileges, ", "))
+	}
+	return privilegesByName, nil
+}
+
+func (client *SecurityRoleClient) getPrivileges(ctx context.Context, environmentId, propertyName string, propertyValues []string) ([]PrivilegeDto, error) {
+	//privileges are looked up in batches to keep the request url within the Dataverse limits
+	const batchSize = 50
+
+	privileges := []PrivilegeDto{}
+	for start := 0; start < len(propertyValues); start += batchSize {
+		end := min(start+batchSize, len(propertyValues))
+
+		quotedValues := []string{}
+		for _, value := range propertyValues[start:end] {
+			quotedValues = append(quotedValues, fmt.Sprintf("'%s'", strings.ReplaceAll(value, "'", "''")))
+		}
+		values := url.Values{}
+		values.Add("$select", "privilegeid,name")
+		values.Add("$filter", fmt.Sprintf("Microsoft.Dynamics.CRM.In(PropertyName='%s',PropertyValues=[%s])", propertyName, strings.Join(quotedValues, ",")))
+		apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "privileges", values)
+		if err != nil {
+			return nil, err
+		}
+
+		batch := PrivilegeDtoArray{}
+		_, err = client.Api.Execute(ctx, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &batch)
+		if err != nil {
+			return nil, err
+		}
+		privileges = append(privileges, batch.Value...)
+	}
+	return privileges, nil
+}
+
+func (client *SecurityRoleClient) ReplaceRolePrivileges(ctx context.Context, environmentId, roleId string, privileges []RolePrivilegeDto) error {
+	return client.executeRoleAction(ctx, environmentId, roleId, "ReplacePrivilegesRole", RolePrivilegesDto{Privileges: privileges})
+}
+
+func (client *SecurityRoleClient) AddRolePrivileges(ctx context.Context, environmentId, roleId string, privileges []RolePrivilegeDto) error {
+	return client.executeRoleAction(ctx, environmentId, roleId, "AddPrivilegesRole", RolePrivilegesDto{Privileges: privileges})
+}
+
+func (client *SecurityRoleClient) RemoveRolePrivileges(ctx context.Context, environmentId, roleId string, privilegeIds []string) error {
+	for _, privilegeId := range privilegeIds {
+		err := client.executeRoleAction(ctx, environmentId, roleId, "RemovePrivilegeRole", RemovePrivilegeRoleDto{PrivilegeId: privilegeId})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (client *SecurityRoleClient) executeRoleAction(ctx context.Context, environmentId, roleId, action string, body interface{}) error {
+	apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "roles("+roleId+")/Microsoft.Dynamics.CRM."+action, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Api.Execute(ctx, "POST", apiUrl, nil, body, []int{http.StatusOK, http.StatusNoContent}, nil)
+	return err
+}
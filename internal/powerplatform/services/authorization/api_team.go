@@ -0,0 +1,182 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+func NewTeamClient(api *api.ApiClient) TeamClient {
+	return TeamClient{
+		Api:        api,
+		userClient: NewUserClient(api),
+	}
+}
+
+type TeamClient struct {
+	Api        *api.ApiClient
+	userClient UserClient
+}
+
+func (client *TeamClient) GetTeam(ctx context.Context, environmentId, teamId string) (*TeamDto, error) {
+	values := url.Values{}
+	values.Add("$expand", "teamroles_association($select=roleid,name,ismanaged,_businessunitid_value)")
+	apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "teams("+teamId+")", values)
+	if err != nil {
+		return nil, err
+	}
+
+	team := TeamDto{}
+	_, err = client.Api.Execute(ctx, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &team)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil, powerplatform_helpers.WrapIntoProviderError(err, powerplatform_helpers.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("Team with id %s not found", teamId))
+		}
+		return nil, err
+	}
+	return &team, nil
+}
+
+func (client *TeamClient) CreateTeam(ctx context.Context, environmentId string, teamToCreate CreateTeamDto) (*TeamDto, error) {
+	apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "teams", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.Api.Execute(ctx, "POST", apiUrl, nil, teamToCreate, []int{http.StatusOK, http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	teamId, err := api.GetCreatedEntityId(response, "team")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetTeam(ctx, environmentId, teamId)
+}
+
+func (client *TeamClient) UpdateTeam(ctx context.Context, environmentId, teamId string, teamToUpdate UpdateTeamDto) (*TeamDto, error) {
+	apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "teams("+teamId+")", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = client.Api.Execute(ctx, "PATCH", apiUrl, nil, teamToUpdate, []int{http.StatusOK, http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetTeam(ctx, environmentId, teamId)
+}
+
+func (client *TeamClient) DeleteTeam(ctx context.Context, environmentId, teamId string) error {
+	apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "teams("+teamId+")", nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Api.Execute(ctx, "DELETE", apiUrl, nil, nil, []int{http.StatusNoContent}, nil)
+	return err
+}
+
+func (client *TeamClient) AddTeamSecurityRoles(ctx context.Context, environmentId, teamId string, securityRolesIds []string) (*TeamDto, error) {
+	err := client.associate(ctx, environmentId, teamId, "teamroles_association", "roles", securityRolesIds)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTeam(ctx, environmentId, teamId)
+}
+
+func (client *TeamClient) RemoveTeamSecurityRoles(ctx context.Context, environmentId, teamId string, securityRolesIds []string) (*TeamDto, error) {
+	err := client.disassociate(ctx, environmentId, teamId, "teamroles_association", "roles", securityRolesIds)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTeam(ctx, environmentId, teamId)
+}
+
+func (client *TeamClient) GetTeamMembers(ctx context.Context, environmentId, teamId string) ([]string, error) {
+	values := url.Values{}
+	values.Add("$select", "systemuserid")
+	apiUrl, _, err := client.userClient.buildDataverseUrl(ctx, environmentId, "teams("+teamId+")/teammembership_association", values)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIds := []string{}
+	nextLink := apiUrl
+	for nextLink != "" {
+		members := UserDtoArray{}
+		_, err = client.Api.Execute(ctx, "GET", nextLink, nil, nil, []int{http.StatusOK}, &members)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil, powerplatform_helpers.WrapIntoProviderError(err, powerplatform_helpers.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("Team with id %s not found", teamId))
+			}
+			return nil, err
+		}
+		for _, member := range members.Value {
+			memberIds = append(memberIds, member.Id)
+		}
+		nextLink = members.NextLink
+	}
+	return memberIds, nil
+}
+
+func (client *TeamClient) AddTeamMembers(ctx context.Context, environmentId, teamId string, systemUserIds []string) error {
+	return client.associate(ctx, environmentId, teamId, "teammembership_association", "systemusers", systemUserIds)
+}
+
+func (client *TeamClient) RemoveTeamMembers(ctx context.Context, environmentId, teamId string, systemUserIds []string) error {
+	return client.disassociate(ctx, environmentId, teamId, "teammembership_association", "systemusers", systemUserIds)
+}
+
+func (client *TeamClient) associate(ctx context.Context, environmentId, teamId, relationship, entitySet string, ids []string) error {
+	apiUrl, environmentUrl, err := client.userClient.buildDataverseUrl(ctx, environmentId, "teams("+teamId+")/"+relationship+"/$ref", nil)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		recordToAssociate := map[string]interface{}{
+			"@odata.id": fmt.Sprintf("%s/api/data/v9.2/%s(%s)", environmentUrl, entitySet, id),
+		}
+		_, err = client.Api.Execute(ctx, "POST", apiUrl, nil, recordToAssociate, []int{http.StatusNoContent}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (client *TeamClient) disassociate(ctx context.Context, environmentId, teamId, relationship, entitySet string, ids []string) error {
+	environmentUrl, err := client.userClient.GetEnvironmentUrlById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		values := url.Values{}
+		values.Add("$id", fmt.Sprintf("%s/api/data/v9.2/%s(%s)", environmentUrl, entitySet, id))
+		apiUrl := &url.URL{
+			Scheme:   "https",
+			Host:     strings.TrimPrefix(environmentUrl, "https://"),
+			Path:     "/api/data/v9.2/teams(" + teamId + ")/" + relationship + "/$ref",
+			RawQuery: values.Encode(),
+		}
+
+		_, err = client.Api.Execute(ctx, "DELETE", apiUrl.String(), nil, nil, []int{http.StatusNoContent}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
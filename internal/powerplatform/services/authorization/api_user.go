@@ -26,6 +26,15 @@ type UserClient struct {
 	Api *api.ApiClient
 }
 
+// buildDataverseUrl returns the url of a Dataverse web api path in an environment, together with the url of the environment.
+func (client *UserClient) buildDataverseUrl(ctx context.Context, environmentId, path string, values url.Values) (string, string, error) {
+	environmentUrl, err := client.GetEnvironmentUrlById(ctx, environmentId)
+	if err != nil {
+		return "", "", err
+	}
+	return api.BuildDataverseUrl(environmentUrl, path, values), environmentUrl, nil
+}
+
 func (client *UserClient) DataverseExists(ctx context.Context, environmentId string) (bool, error) {
 
 	env, err := client.getEnvironment(ctx, environmentId)
@@ -35,7 +44,8 @@ func (client *UserClient) DataverseExists(ctx context.Context, environmentId str
 	return env.Properties.LinkedEnvironmentMetadata.InstanceURL != "", nil
 }
 
-func (client *UserClient) GetUsers(ctx context.Context, environmentId string) ([]UserDto, error) {
+// GetUsers returns the system users of an environment, with their security roles, that match the OData filter.
+func (client *UserClient) GetUsers(ctx context.Context, environmentId, filter string) ([]UserDto, error) {
 	environmentUrl, err := client.GetEnvironmentUrlById(ctx, environmentId)
 	if err != nil {
 		return nil, err
@@ -45,12 +55,25 @@ func (client *UserClient) GetUsers(ctx context.Context, environmentId string) ([
 		Host:   strings.TrimPrefix(environmentUrl, "https://"),
 		Path:   "/api/data/v9.2/systemusers",
 	}
-	userArray := UserDtoArray{}
-	_, err = client.Api.Execute(ctx, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &userArray)
-	if err != nil {
-		return nil, err
+	values := url.Values{}
+	values.Add("$expand", "systemuserroles_association($select=roleid,name,ismanaged,_businessunitid_value)")
+	if filter != "" {
+		values.Add("$filter", filter)
 	}
-	return userArray.Value, nil
+	apiUrl.RawQuery = values.Encode()
+
+	users := []UserDto{}
+	nextLink := apiUrl.String()
+	for nextLink != "" {
+		userArray := UserDtoArray{}
+		_, err = client.Api.Execute(ctx, "GET", nextLink, nil, nil, []int{http.StatusOK}, &userArray)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, userArray.Value...)
+		nextLink = userArray.NextLink
+	}
+	return users, nil
 }
 
 func (client *UserClient) GetUserBySystemUserId(ctx context.Context, environmentId, systemUserId string) (*UserDto, error) {
@@ -144,6 +167,83 @@ func (client *UserClient) CreateUser(ctx context.Context, environmentId, aadObje
 	return user, nil
 }
 
+func (client *UserClient) CreateApplicationUser(ctx context.Context, environmentId, applicationId, businessUnitId string) (*UserDto, error) {
+	environmentUrl, err := client.GetEnvironmentUrlById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   strings.TrimPrefix(environmentUrl, "https://"),
+		Path:   "/api/data/v9.2/systemusers",
+	}
+
+	userToCreate := map[string]interface{}{
+		"applicationid":             applicationId,
+		"businessunitid@odata.bind": fmt.Sprintf("/businessunits(%s)", businessUnitId),
+	}
+
+	response, err := client.Api.Execute(ctx, "POST", apiUrl.String(), nil, userToCreate, []int{http.StatusOK, http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	systemUserId, err := api.GetCreatedEntityId(response, "systemuser")
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := client.GetUserBySystemUserId(ctx, environmentId, systemUserId)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (client *UserClient) DisableUser(ctx context.Context, environmentId, systemUserId string) error {
+	environmentUrl, err := client.GetEnvironmentUrlById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   strings.TrimPrefix(environmentUrl, "https://"),
+		Path:   "/api/data/v9.2/systemusers(" + systemUserId + ")",
+	}
+
+	userUpdate := map[string]interface{}{
+		"isdisabled": true,
+	}
+	_, err = client.Api.Execute(ctx, "PATCH", apiUrl.String(), nil, userUpdate, []int{http.StatusOK, http.StatusNoContent}, nil)
+	return err
+}
+
+func (client *UserClient) GetRootBusinessUnitId(ctx context.Context, environmentId string) (string, error) {
+	environmentUrl, err := client.GetEnvironmentUrlById(ctx, environmentId)
+	if err != nil {
+		return "", err
+	}
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   strings.TrimPrefix(environmentUrl, "https://"),
+		Path:   "/api/data/v9.2/businessunits",
+	}
+	values := url.Values{}
+	values.Add("$filter", "_parentbusinessunitid_value eq null")
+	values.Add("$select", "businessunitid")
+	apiUrl.RawQuery = values.Encode()
+
+	businessUnits := BusinessUnitDtoArray{}
+	_, err = client.Api.Execute(ctx, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &businessUnits)
+	if err != nil {
+		return "", err
+	}
+	if len(businessUnits.Value) == 0 {
+		return "", fmt.Errorf("root business unit not found in environment %s", environmentId)
+	}
+	return businessUnits.Value[0].Id, nil
+}
+
 func (client *UserClient) UpdateUser(ctx context.Context, environmentId, systemUserId string, userUpdate *UserDto) (*UserDto, error) {
 	environmentUrl, err := client.GetEnvironmentUrlById(ctx, environmentId)
 	if err != nil {
@@ -167,6 +267,32 @@ func (client *UserClient) UpdateUser(ctx context.Context, environmentId, systemU
 	return user, nil
 }
 
+func (client *UserClient) SetUserBusinessUnit(ctx context.Context, environmentId, systemUserId, businessUnitId string) (*UserDto, error) {
+	environmentUrl, err := client.GetEnvironmentUrlById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   strings.TrimPrefix(environmentUrl, "https://"),
+		Path:   "/api/data/v9.2/systemusers(" + systemUserId + ")",
+	}
+
+	userUpdate := map[string]interface{}{
+		"businessunitid@odata.bind": fmt.Sprintf("/businessunits(%s)", businessUnitId),
+	}
+	_, err = client.Api.Execute(ctx, "PATCH", apiUrl.String(), nil, userUpdate, []int{http.StatusOK, http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := client.GetUserBySystemUserId(ctx, environmentId, systemUserId)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
 func (client *UserClient) DeleteUser(ctx context.Context, environmentId, systemUserId string) error {
 	environmentUrl, err := client.GetEnvironmentUrlById(ctx, environmentId)
 	if err != nil {
@@ -298,3 +424,35 @@ func (client *UserClient) GetSecurityRoles(ctx context.Context, environmentId, b
 	}
 	return securityRoleArray.Value, nil
 }
+
+// GetSecurityRoleIdsByName resolves security role names to the ids of the roles defined in the business unit.
+// Names are matched case-insensitively, like Dataverse does, and have to match exactly one role.
+func (client *UserClient) GetSecurityRoleIdsByName(ctx context.Context, environmentId, businessUnitId string, securityRoleNames []string) ([]string, error) {
+	securityRoles, err := client.GetSecurityRoles(ctx, environmentId, businessUnitId)
+	if err != nil {
+		return nil, err
+	}
+
+	securityRoleIds := []string{}
+	unknownSecurityRoles := []string{}
+	for _, name := range securityRoleNames {
+		matchingRoleIds := []string{}
+		for _, role := range securityRoles {
+			if strings.EqualFold(role.Name, name) {
+				matchingRoleIds = append(matchingRoleIds, role.RoleId)
+			}
+		}
+		switch len(matchingRoleIds) {
+		case 0:
+			unknownSecurityRoles = append(unknownSecurityRoles, name)
+		case 1:
+			securityRoleIds = append(securityRoleIds, matchingRoleIds[0])
+		default:
+			return nil, fmt.Errorf("security role name '%s' is ambiguous in business unit %s, it matches the roles %s", name, businessUnitId, strings.Join(matchingRoleIds, ", "))
+		}
+	}
+	if len(unknownSecurityRoles) > 0 {
+		return nil, fmt.Errorf("security roles not found in business unit %s: %s", businessUnitId, strings.Join(unknownSecurityRoles, ", "))
+	}
+	return securityRoleIds, nil
+}
@@ -0,0 +1,175 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+)
+
+var (
+	_ datasource.DataSource              = &BusinessUnitsDataSource{}
+	_ datasource.DataSourceWithConfigure = &BusinessUnitsDataSource{}
+)
+
+type BusinessUnitsDataSource struct {
+	BusinessUnitClient BusinessUnitClient
+	ProviderTypeName   string
+	TypeName           string
+}
+
+type BusinessUnitsListDataSourceModel struct {
+	Id            types.String                  `tfsdk:"id"`
+	EnvironmentId types.String                  `tfsdk:"environment_id"`
+	Name          types.String                  `tfsdk:"name"`
+	BusinessUnits []BusinessUnitDataSourceModel `tfsdk:"business_units"`
+}
+
+type BusinessUnitDataSourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	ParentBusinessUnitId types.String `tfsdk:"parent_business_unit_id"`
+	CostCenter           types.String `tfsdk:"cost_center"`
+	Description          types.String `tfsdk:"description"`
+	Disabled             types.Bool   `tfsdk:"disabled"`
+	Level                types.Int64  `tfsdk:"level"`
+	ChildBusinessUnitIds []string     `tfsdk:"child_business_unit_ids"`
+}
+
+func NewBusinessUnitsDataSource() datasource.DataSource {
+	return &BusinessUnitsDataSource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_business_units",
+	}
+}
+
+func (d *BusinessUnitsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Fetches the business unit tree of a given environment",
+		MarkdownDescription: "Fetches the business unit tree of a given environment. Business units are returned depth first, starting from the root business unit.  For more information see [Create or edit business units](https://learn.microsoft.com/power-platform/admin/create-edit-business-units)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Id of the read operation",
+				MarkdownDescription: "Id of the read operation",
+				Computed:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				Description:         "Id of the Dynamics 365 environment",
+				MarkdownDescription: "Id of the Dynamics 365 environment",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the business units to look up. When set, only the business units with this name are returned",
+				MarkdownDescription: "Name of the business units to look up. When set, only the business units with this name are returned",
+				Optional:            true,
+			},
+			"business_units": schema.ListNestedAttribute{
+				Description:         "List of business units",
+				MarkdownDescription: "List of business units",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Business unit id",
+							Description:         "Business unit id",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Business unit name",
+							Description:         "Business unit name",
+							Computed:            true,
+						},
+						"parent_business_unit_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the parent business unit. Not set for the root business unit",
+							Description:         "Id of the parent business unit. Not set for the root business unit",
+							Computed:            true,
+						},
+						"cost_center": schema.StringAttribute{
+							MarkdownDescription: "Cost center of the business unit",
+							Description:         "Cost center of the business unit",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the business unit",
+							Description:         "Description of the business unit",
+							Computed:            true,
+						},
+						"disabled": schema.BoolAttribute{
+							MarkdownDescription: "Is the business unit disabled",
+							Description:         "Is the business unit disabled",
+							Computed:            true,
+						},
+						"level": schema.Int64Attribute{
+							MarkdownDescription: "Depth of the business unit in the tree. The root business unit has level `0`",
+							Description:         "Depth of the business unit in the tree. The root business unit has level 0",
+							Computed:            true,
+						},
+						"child_business_unit_ids": schema.ListAttribute{
+							MarkdownDescription: "Ids of the direct child business units",
+							Description:         "Ids of the direct child business units",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BusinessUnitsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clientApi := req.ProviderData.(*api.ProviderClient).Api
+	if clientApi == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.BusinessUnitClient = NewBusinessUnitClient(clientApi)
+}
+
+func (d *BusinessUnitsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + d.TypeName
+}
+
+func (d *BusinessUnitsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state BusinessUnitsListDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE BUSINESS UNITS START: %s", d.ProviderTypeName))
+
+	businessUnits, err := d.BusinessUnitClient.GetBusinessUnits(ctx, state.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s%s", d.ProviderTypeName, d.TypeName), err.Error())
+		return
+	}
+
+	state.Id = state.EnvironmentId
+	state.BusinessUnits = []BusinessUnitDataSourceModel{}
+	for _, businessUnit := range convertBusinessUnitsToTree(businessUnits) {
+		if !state.Name.IsNull() && businessUnit.Name.ValueString() != state.Name.ValueString() {
+			continue
+		}
+		state.BusinessUnits = append(state.BusinessUnits, businessUnit)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE BUSINESS UNITS END: %s", d.ProviderTypeName))
+}
@@ -0,0 +1,344 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	constants "github.com/microsoft/terraform-provider-power-platform/constants"
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+var (
+	_ datasource.DataSource                   = &UsersDataSource{}
+	_ datasource.DataSourceWithConfigure      = &UsersDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &UsersDataSource{}
+)
+
+type UsersDataSource struct {
+	UserClient       UserClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+type UsersListDataSourceModel struct {
+	Id                   types.String          `tfsdk:"id"`
+	EnvironmentId        types.String          `tfsdk:"environment_id"`
+	EnvironmentIds       []string              `tfsdk:"environment_ids"`
+	AadObjectId          types.String          `tfsdk:"aad_object_id"`
+	UserPrincipalName    types.String          `tfsdk:"user_principal_name"`
+	Disabled             types.Bool            `tfsdk:"disabled"`
+	AccessMode           types.String          `tfsdk:"access_mode"`
+	ApplicationUsersOnly types.Bool            `tfsdk:"application_users_only"`
+	SecurityRoleId       types.String          `tfsdk:"security_role_id"`
+	SecurityRoleName     types.String          `tfsdk:"security_role_name"`
+	Users                []UserDataSourceModel `tfsdk:"users"`
+}
+
+type UserDataSourceModel struct {
+	EnvironmentId     types.String `tfsdk:"environment_id"`
+	Id                types.String `tfsdk:"id"`
+	AadObjectId       types.String `tfsdk:"aad_object_id"`
+	UserPrincipalName types.String `tfsdk:"user_principal_name"`
+	FirstName         types.String `tfsdk:"first_name"`
+	LastName          types.String `tfsdk:"last_name"`
+	FullName          types.String `tfsdk:"full_name"`
+	ApplicationId     types.String `tfsdk:"application_id"`
+	BusinessUnitId    types.String `tfsdk:"business_unit_id"`
+	Disabled          types.Bool   `tfsdk:"disabled"`
+	AccessMode        types.String `tfsdk:"access_mode"`
+	SecurityRoles     []string     `tfsdk:"security_roles"`
+	SecurityRoleNames []string     `tfsdk:"security_role_names"`
+}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_users",
+	}
+}
+
+func (d *UsersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	accessModes := []string{USER_ACCESS_MODE_READ_WRITE, USER_ACCESS_MODE_ADMINISTRATIVE, USER_ACCESS_MODE_READ, USER_ACCESS_MODE_SUPPORT_USER, USER_ACCESS_MODE_NON_INTERACTIVE, USER_ACCESS_MODE_DELEGATED_ADMIN}
+
+	resp.Schema = schema.Schema{
+		Description:         "Fetches the Dataverse users of one or more environments",
+		MarkdownDescription: "Fetches the Dataverse users of one or more environments. All filters are optional and combined, so only the users matching every filter are returned. For more information see [Manage users](https://learn.microsoft.com/power-platform/admin/create-users)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Id of the read operation",
+				MarkdownDescription: "Id of the read operation",
+				Computed:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				Description:         "Id of the Dynamics 365 environment. Conflicts with 'environment_ids'",
+				MarkdownDescription: "Id of the Dynamics 365 environment. Conflicts with `environment_ids`",
+				Optional:            true,
+			},
+			"environment_ids": schema.ListAttribute{
+				Description:         "Ids of the Dynamics 365 environments to read the users from. Conflicts with 'environment_id'",
+				MarkdownDescription: "Ids of the Dynamics 365 environments to read the users from. Conflicts with `environment_id`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"aad_object_id": schema.StringAttribute{
+				Description:         "Only return the user with this Entra object id",
+				MarkdownDescription: "Only return the user with this Entra object id",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(powerplatform_helpers.GuidRegex), "aad_object_id must be a valid GUID"),
+				},
+			},
+			"user_principal_name": schema.StringAttribute{
+				Description:         "Only return the user with this user principal name",
+				MarkdownDescription: "Only return the user with this user principal name",
+				Optional:            true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description:         "Only return disabled users when true, or enabled users when false",
+				MarkdownDescription: "Only return disabled users when `true`, or enabled users when `false`",
+				Optional:            true,
+			},
+			"access_mode": schema.StringAttribute{
+				Description:         "Only return users with this access mode. Can be one of 'ReadWrite', 'Administrative', 'Read', 'SupportUser', 'NonInteractive' or 'DelegatedAdmin'",
+				MarkdownDescription: "Only return users with this access mode. Can be one of `ReadWrite`, `Administrative`, `Read`, `SupportUser`, `NonInteractive` or `DelegatedAdmin`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(accessModes...),
+				},
+			},
+			"application_users_only": schema.BoolAttribute{
+				Description:         "Only return application users when true",
+				MarkdownDescription: "Only return application users when `true`",
+				Optional:            true,
+			},
+			"security_role_id": schema.StringAttribute{
+				Description:         "Only return users that have the security role with this id assigned",
+				MarkdownDescription: "Only return users that have the security role with this id assigned",
+				Optional:            true,
+			},
+			"security_role_name": schema.StringAttribute{
+				Description:         "Only return users that have a security role with this name assigned. Useful together with 'environment_ids', because security role ids differ per environment",
+				MarkdownDescription: "Only return users that have a security role with this name assigned. Useful together with `environment_ids`, because security role ids differ per environment",
+				Optional:            true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description:         "List of users, one entry per user and environment",
+				MarkdownDescription: "List of users, one entry per user and environment",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"environment_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the environment of the user",
+							Description:         "Id of the environment of the user",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique user id (guid)",
+							Description:         "Unique user id (guid)",
+							Computed:            true,
+						},
+						"aad_object_id": schema.StringAttribute{
+							MarkdownDescription: "Entra user object id",
+							Description:         "Entra user object id",
+							Computed:            true,
+						},
+						"user_principal_name": schema.StringAttribute{
+							MarkdownDescription: "User principal name",
+							Description:         "User principal name",
+							Computed:            true,
+						},
+						"first_name": schema.StringAttribute{
+							MarkdownDescription: "User first name",
+							Description:         "User first name",
+							Computed:            true,
+						},
+						"last_name": schema.StringAttribute{
+							MarkdownDescription: "User last name",
+							Description:         "User last name",
+							Computed:            true,
+						},
+						"full_name": schema.StringAttribute{
+							MarkdownDescription: "User full name",
+							Description:         "User full name",
+							Computed:            true,
+						},
+						"application_id": schema.StringAttribute{
+							MarkdownDescription: "Entra application id. Only set for application users",
+							Description:         "Entra application id. Only set for application users",
+							Computed:            true,
+						},
+						"business_unit_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the business unit to which the user belongs",
+							Description:         "Id of the business unit to which the user belongs",
+							Computed:            true,
+						},
+						"disabled": schema.BoolAttribute{
+							MarkdownDescription: "Is the user disabled",
+							Description:         "Is the user disabled",
+							Computed:            true,
+						},
+						"access_mode": schema.StringAttribute{
+							MarkdownDescription: "Access mode of the user",
+							Description:         "Access mode of the user",
+							Computed:            true,
+						},
+						"security_roles": schema.ListAttribute{
+							MarkdownDescription: "Ids of the security roles assigned to the user",
+							Description:         "Ids of the security roles assigned to the user",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"security_role_names": schema.ListAttribute{
+							MarkdownDescription: "Names of the security roles assigned to the user",
+							Description:         "Names of the security roles assigned to the user",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clientApi := req.ProviderData.(*api.ProviderClient).Api
+	if clientApi == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.UserClient = NewUserClient(clientApi)
+}
+
+func (d *UsersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + d.TypeName
+}
+
+func (d *UsersDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var environmentId types.String
+	var environmentIds types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("environment_id"), &environmentId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("environment_ids"), &environmentIds)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if environmentId.IsNull() == environmentIds.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("environment_id"), "Invalid environments", "Exactly one of `environment_id` and `environment_ids` has to be set")
+	}
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state UsersListDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE USERS START: %s", d.ProviderTypeName))
+
+	environmentIds := state.EnvironmentIds
+	if !state.EnvironmentId.IsNull() {
+		environmentIds = []string{state.EnvironmentId.ValueString()}
+	}
+
+	filter := buildUsersFilter(state)
+
+	//users of all environments are read concurrently, the order of the environments is kept
+	usersPerEnvironment, err := api.ExecuteConcurrently(ctx, environmentIds, constants.MAX_CONCURRENT_REQUESTS, func(ctx context.Context, environmentId string) ([]UserDataSourceModel, error) {
+		dvExits, err := d.UserClient.DataverseExists(ctx, environmentId)
+		if err != nil {
+			return nil, fmt.Errorf("checking if Dataverse exists in environment '%s' failed: %w", environmentId, err)
+		}
+		if !dvExits {
+			return nil, fmt.Errorf("no Dataverse exists in environment '%s'", environmentId)
+		}
+
+		users, err := d.UserClient.GetUsers(ctx, environmentId, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		models := []UserDataSourceModel{}
+		for _, user := range users {
+			//security roles are filtered client side, because the filter is applied to the expanded roles of the user
+			if !hasSecurityRole(user, state.SecurityRoleId.ValueString(), state.SecurityRoleName.ValueString()) {
+				continue
+			}
+			models = append(models, convertFromUserDtoToUserDataSourceModel(environmentId, user))
+		}
+		return models, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s%s", d.ProviderTypeName, d.TypeName), err.Error())
+		return
+	}
+
+	state.Users = []UserDataSourceModel{}
+	for _, users := range usersPerEnvironment {
+		state.Users = append(state.Users, users...)
+	}
+
+	state.Id = types.StringValue(strings.Join(environmentIds, "_"))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE USERS END: %s", d.ProviderTypeName))
+}
+
+func buildUsersFilter(state UsersListDataSourceModel) string {
+	filters := []string{}
+	if !state.AadObjectId.IsNull() {
+		filters = append(filters, fmt.Sprintf("azureactivedirectoryobjectid eq %s", state.AadObjectId.ValueString()))
+	}
+	if !state.UserPrincipalName.IsNull() {
+		filters = append(filters, fmt.Sprintf("domainname eq '%s'", strings.ReplaceAll(state.UserPrincipalName.ValueString(), "'", "''")))
+	}
+	if !state.Disabled.IsNull() {
+		filters = append(filters, fmt.Sprintf("isdisabled eq %t", state.Disabled.ValueBool()))
+	}
+	if !state.AccessMode.IsNull() {
+		for value, name := range userAccessModes {
+			if name == state.AccessMode.ValueString() {
+				filters = append(filters, fmt.Sprintf("accessmode eq %d", value))
+			}
+		}
+	}
+	if state.ApplicationUsersOnly.ValueBool() {
+		filters = append(filters, "applicationid ne null")
+	}
+	return strings.Join(filters, " and ")
+}
+
+func hasSecurityRole(user UserDto, securityRoleId, securityRoleName string) bool {
+	if securityRoleId == "" && securityRoleName == "" {
+		return true
+	}
+	for _, role := range user.SecurityRoles {
+		if (securityRoleId == "" || strings.EqualFold(role.RoleId, securityRoleId)) && (securityRoleName == "" || strings.EqualFold(role.Name, securityRoleName)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type BusinessUnitDto struct {
+	Id                   string `json:"businessunitid"`
+	Name                 string `json:"name"`
+	ParentBusinessUnitId string `json:"_parentbusinessunitid_value"`
+	CostCenter           string `json:"costcenter"`
+	Description          string `json:"description"`
+	IsDisabled           bool   `json:"isdisabled"`
+}
+
+type BusinessUnitDtoArray struct {
+	Value []BusinessUnitDto `json:"value"`
+}
+
+type CreateBusinessUnitDto struct {
+	Name               string `json:"name"`
+	ParentBusinessUnit string `json:"parentbusinessunitid@odata.bind"`
+	CostCenter         string `json:"costcenter,omitempty"`
+	Description        string `json:"description,omitempty"`
+}
+
+type UpdateBusinessUnitDto struct {
+	Name               string `json:"name"`
+	ParentBusinessUnit string `json:"parentbusinessunitid@odata.bind,omitempty"`
+	CostCenter         string `json:"costcenter"`
+	Description        string `json:"description"`
+}
+
+func ConvertFromBusinessUnitDto(businessUnitDto *BusinessUnitDto) BusinessUnitResourceModel {
+	return BusinessUnitResourceModel{
+		Id:                   types.StringValue(businessUnitDto.Id),
+		Name:                 types.StringValue(businessUnitDto.Name),
+		ParentBusinessUnitId: types.StringValue(businessUnitDto.ParentBusinessUnitId),
+		CostCenter:           types.StringValue(businessUnitDto.CostCenter),
+		Description:          types.StringValue(businessUnitDto.Description),
+		Disabled:             types.BoolValue(businessUnitDto.IsDisabled),
+	}
+}
+
+// convertBusinessUnitsToTree orders the business units depth first starting from the root business unit
+// and resolves the level and the child business units of each of them.
+func convertBusinessUnitsToTree(businessUnits []BusinessUnitDto) []BusinessUnitDataSourceModel {
+	children := map[string][]BusinessUnitDto{}
+	for _, businessUnit := range businessUnits {
+		children[businessUnit.ParentBusinessUnitId] = append(children[businessUnit.ParentBusinessUnitId], businessUnit)
+	}
+
+	tree := []BusinessUnitDataSourceModel{}
+	var visit func(businessUnit BusinessUnitDto, level int)
+	visit = func(businessUnit BusinessUnitDto, level int) {
+		childIds := []string{}
+		for _, child := range children[businessUnit.Id] {
+			childIds = append(childIds, child.Id)
+		}
+
+		model := BusinessUnitDataSourceModel{
+			Id:                   types.StringValue(businessUnit.Id),
+			Name:                 types.StringValue(businessUnit.Name),
+			ParentBusinessUnitId: types.StringNull(),
+			CostCenter:           types.StringValue(businessUnit.CostCenter),
+			Description:          types.StringValue(businessUnit.Description),
+			Disabled:             types.BoolValue(businessUnit.IsDisabled),
+			Level:                types.Int64Value(int64(level)),
+			ChildBusinessUnitIds: childIds,
+		}
+		if businessUnit.ParentBusinessUnitId != "" {
+			model.ParentBusinessUnitId = types.StringValue(businessUnit.ParentBusinessUnitId)
+		}
+		tree = append(tree, model)
+
+		for _, child := range children[businessUnit.Id] {
+			visit(child, level+1)
+		}
+	}
+
+	for _, root := range children[""] {
+		visit(root, 0)
+	}
+	return tree
+}
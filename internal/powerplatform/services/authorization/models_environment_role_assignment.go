@@ -0,0 +1,96 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	ENVIRONMENT_ROLE_ADMIN = "EnvironmentAdmin"
+	ENVIRONMENT_ROLE_MAKER = "EnvironmentMaker"
+
+	ENVIRONMENT_ROLE_PRINCIPAL_TYPE_USER              = "User"
+	ENVIRONMENT_ROLE_PRINCIPAL_TYPE_GROUP             = "Group"
+	ENVIRONMENT_ROLE_PRINCIPAL_TYPE_SERVICE_PRINCIPAL = "ServicePrincipal"
+)
+
+type EnvironmentRoleAssignmentDtoArray struct {
+	Value []EnvironmentRoleAssignmentDto `json:"value"`
+}
+
+type EnvironmentRoleAssignmentDto struct {
+	Id         string                                 `json:"id"`
+	Name       string                                 `json:"name"`
+	Properties EnvironmentRoleAssignmentPropertiesDto `json:"properties"`
+}
+
+type EnvironmentRoleAssignmentPropertiesDto struct {
+	RoleDefinition EnvironmentRoleDefinitionDto `json:"roleDefinition"`
+	Principal      EnvironmentRolePrincipalDto  `json:"principal"`
+}
+
+type EnvironmentRoleDefinitionDto struct {
+	Id   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+type EnvironmentRolePrincipalDto struct {
+	Id          string `json:"id"`
+	Type        string `json:"type"`
+	DisplayName string `json:"displayName,omitempty"`
+	Email       string `json:"email,omitempty"`
+}
+
+type EnvironmentRoleAssignmentModifyDto struct {
+	Add    []EnvironmentRoleAssignmentModifyAddDto    `json:"add"`
+	Remove []EnvironmentRoleAssignmentModifyRemoveDto `json:"remove"`
+}
+
+type EnvironmentRoleAssignmentModifyAddDto struct {
+	Properties EnvironmentRoleAssignmentPropertiesDto `json:"properties"`
+}
+
+type EnvironmentRoleAssignmentModifyRemoveDto struct {
+	Id string `json:"id"`
+}
+
+type EnvironmentRoleAssignmentModifyResponseDto struct {
+	Add    []EnvironmentRoleAssignmentModifyResultDto `json:"add"`
+	Remove []EnvironmentRoleAssignmentModifyResultDto `json:"remove"`
+}
+
+type EnvironmentRoleAssignmentModifyResultDto struct {
+	RoleAssignment *EnvironmentRoleAssignmentDto      `json:"roleAssignment"`
+	HttpStatus     string                             `json:"httpStatus"`
+	Error          *EnvironmentRoleAssignmentErrorDto `json:"error"`
+}
+
+type EnvironmentRoleAssignmentErrorDto struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// RoleName returns the name of the assigned role, e.g. EnvironmentAdmin for /providers/Microsoft.BusinessAppPlatform/environments/<id>/roleDefinitions/EnvironmentAdmin.
+func (assignment *EnvironmentRoleAssignmentDto) RoleName() string {
+	if assignment.Properties.RoleDefinition.Name != "" {
+		return assignment.Properties.RoleDefinition.Name
+	}
+	return path.Base(assignment.Properties.RoleDefinition.Id)
+}
+
+func ConvertFromEnvironmentRoleAssignmentDto(environmentId string, assignment *EnvironmentRoleAssignmentDto) EnvironmentRoleAssignmentResourceModel {
+	return EnvironmentRoleAssignmentResourceModel{
+		Id:            types.StringValue(assignment.Name),
+		EnvironmentId: types.StringValue(environmentId),
+		Role:          types.StringValue(assignment.RoleName()),
+		Principal: EnvironmentRoleAssignmentPrincipalModel{
+			ObjectId: types.StringValue(assignment.Properties.Principal.Id),
+			Type:     types.StringValue(assignment.Properties.Principal.Type),
+		},
+		PrincipalDisplayName: types.StringValue(assignment.Properties.Principal.DisplayName),
+	}
+}
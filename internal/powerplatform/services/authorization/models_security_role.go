@@ -0,0 +1,106 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	PRIVILEGE_DEPTH_USER          = "User"
+	PRIVILEGE_DEPTH_BUSINESS_UNIT = "BusinessUnit"
+	PRIVILEGE_DEPTH_PARENT_CHILD  = "ParentChild"
+	PRIVILEGE_DEPTH_ORGANIZATION  = "Organization"
+)
+
+// privilegeDepths maps the provider privilege depths to the Dataverse PrivilegeDepth enum members.
+var privilegeDepths = map[string]string{
+	PRIVILEGE_DEPTH_USER:          "Basic",
+	PRIVILEGE_DEPTH_BUSINESS_UNIT: "Local",
+	PRIVILEGE_DEPTH_PARENT_CHILD:  "Deep",
+	PRIVILEGE_DEPTH_ORGANIZATION:  "Global",
+}
+
+type SecurityRoleDetailsDto struct {
+	Id             string `json:"roleid"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	IsManaged      bool   `json:"ismanaged"`
+	BusinessUnitId string `json:"_businessunitid_value"`
+}
+
+type CreateSecurityRoleDto struct {
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	BusinessUnit string `json:"businessunitid@odata.bind"`
+}
+
+type UpdateSecurityRoleDto struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type PrivilegeDto struct {
+	Id   string `json:"privilegeid"`
+	Name string `json:"name"`
+}
+
+type PrivilegeDtoArray struct {
+	Value []PrivilegeDto `json:"value"`
+}
+
+type RolePrivilegeDto struct {
+	Depth          string `json:"Depth"`
+	PrivilegeId    string `json:"PrivilegeId"`
+	BusinessUnitId string `json:"BusinessUnitId"`
+	PrivilegeName  string `json:"PrivilegeName"`
+}
+
+type RolePrivilegesDto struct {
+	Privileges []RolePrivilegeDto `json:"Privileges"`
+}
+
+type RetrieveRolePrivilegesResponseDto struct {
+	RolePrivileges []RolePrivilegeDto `json:"RolePrivileges"`
+}
+
+type RemovePrivilegeRoleDto struct {
+	PrivilegeId string `json:"PrivilegeId"`
+}
+
+func convertPrivilegeDepthToDataverse(depth string) string {
+	return privilegeDepths[depth]
+}
+
+func convertPrivilegeDepthFromDataverse(depth string) string {
+	for name, value := range privilegeDepths {
+		if value == depth {
+			return name
+		}
+	}
+	return depth
+}
+
+func ConvertFromSecurityRoleDto(roleDto *SecurityRoleDetailsDto, rolePrivileges []RolePrivilegeDto) SecurityRoleResourceModel {
+	model := SecurityRoleResourceModel{
+		Id:             types.StringValue(roleDto.Id),
+		Name:           types.StringValue(roleDto.Name),
+		Description:    types.StringValue(roleDto.Description),
+		BusinessUnitId: types.StringValue(roleDto.BusinessUnitId),
+		Privileges:     []SecurityRolePrivilegeModel{},
+	}
+
+	sort.Slice(rolePrivileges, func(i, j int) bool {
+		return rolePrivileges[i].PrivilegeName < rolePrivileges[j].PrivilegeName
+	})
+	for _, privilege := range rolePrivileges {
+		model.Privileges = append(model.Privileges, SecurityRolePrivilegeModel{
+			Name:  types.StringValue(privilege.PrivilegeName),
+			Depth: types.StringValue(convertPrivilegeDepthFromDataverse(privilege.Depth)),
+		})
+	}
+	return model
+}
@@ -0,0 +1,115 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+const (
+	TEAM_TYPE_OWNER                = "Owner"
+	TEAM_TYPE_ACCESS               = "Access"
+	TEAM_TYPE_ENTRA_SECURITY_GROUP = "EntraSecurityGroup"
+	TEAM_TYPE_ENTRA_OFFICE_GROUP   = "EntraOfficeGroup"
+
+	TEAM_MEMBERSHIP_TYPE_MEMBERS_AND_GUESTS = "MembersAndGuests"
+	TEAM_MEMBERSHIP_TYPE_MEMBERS            = "Members"
+	TEAM_MEMBERSHIP_TYPE_OWNERS             = "Owners"
+	TEAM_MEMBERSHIP_TYPE_GUESTS             = "Guests"
+)
+
+// teamTypes maps the Dataverse teamtype option set values to their provider names.
+var teamTypes = map[int]string{
+	0: TEAM_TYPE_OWNER,
+	1: TEAM_TYPE_ACCESS,
+	2: TEAM_TYPE_ENTRA_SECURITY_GROUP,
+	3: TEAM_TYPE_ENTRA_OFFICE_GROUP,
+}
+
+// teamMembershipTypes maps the Dataverse membershiptype option set values to their provider names.
+var teamMembershipTypes = map[int]string{
+	0: TEAM_MEMBERSHIP_TYPE_MEMBERS_AND_GUESTS,
+	1: TEAM_MEMBERSHIP_TYPE_MEMBERS,
+	2: TEAM_MEMBERSHIP_TYPE_OWNERS,
+	3: TEAM_MEMBERSHIP_TYPE_GUESTS,
+}
+
+type TeamDto struct {
+	Id              string            `json:"teamid"`
+	Name            string            `json:"name"`
+	Description     string            `json:"description"`
+	TeamType        int               `json:"teamtype"`
+	AadObjectId     string            `json:"azureactivedirectoryobjectid"`
+	MembershipType  int               `json:"membershiptype"`
+	BusinessUnitId  string            `json:"_businessunitid_value"`
+	AdministratorId string            `json:"_administratorid_value"`
+	SecurityRoles   []SecurityRoleDto `json:"teamroles_association,omitempty"`
+}
+
+type TeamDtoArray struct {
+	Value []TeamDto `json:"value"`
+}
+
+type CreateTeamDto struct {
+	Name           string `json:"name"`
+	Description    string `json:"description,omitempty"`
+	TeamType       int    `json:"teamtype"`
+	AadObjectId    string `json:"azureactivedirectoryobjectid,omitempty"`
+	MembershipType *int   `json:"membershiptype,omitempty"`
+	BusinessUnit   string `json:"businessunitid@odata.bind"`
+	Administrator  string `json:"administratorid@odata.bind,omitempty"`
+}
+
+type UpdateTeamDto struct {
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	MembershipType *int   `json:"membershiptype,omitempty"`
+	Administrator  string `json:"administratorid@odata.bind,omitempty"`
+}
+
+func (t *TeamDto) SecurityRolesArray() []string {
+	roles := []string{}
+	for _, role := range t.SecurityRoles {
+		roles = append(roles, role.RoleId)
+	}
+	return roles
+}
+
+func convertTeamTypeToDataverse(teamType string) int {
+	for value, name := range teamTypes {
+		if name == teamType {
+			return value
+		}
+	}
+	return 0
+}
+
+func convertTeamMembershipTypeToDataverse(membershipType string) int {
+	for value, name := range teamMembershipTypes {
+		if name == membershipType {
+			return value
+		}
+	}
+	return 0
+}
+
+func isEntraTeamType(teamType string) bool {
+	return teamType == TEAM_TYPE_ENTRA_SECURITY_GROUP || teamType == TEAM_TYPE_ENTRA_OFFICE_GROUP
+}
+
+func ConvertFromTeamDto(teamDto *TeamDto) TeamResourceModel {
+	model := TeamResourceModel{
+		Id:              types.StringValue(teamDto.Id),
+		Name:            types.StringValue(teamDto.Name),
+		Description:     types.StringValue(teamDto.Description),
+		TeamType:        types.StringValue(teamTypes[teamDto.TeamType]),
+		EntraObjectId:   types.StringNull(),
+		MembershipType:  types.StringValue(teamMembershipTypes[teamDto.MembershipType]),
+		BusinessUnitId:  types.StringValue(teamDto.BusinessUnitId),
+		AdministratorId: types.StringValue(teamDto.AdministratorId),
+		SecurityRoles:   convertToSecurityRolesSet(teamDto.SecurityRolesArray()),
+	}
+	if teamDto.AadObjectId != "" {
+		model.EntraObjectId = types.StringValue(teamDto.AadObjectId)
+	}
+	return model
+}
@@ -3,7 +3,29 @@
 
 package powerplatform
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	USER_ACCESS_MODE_READ_WRITE      = "ReadWrite"
+	USER_ACCESS_MODE_ADMINISTRATIVE  = "Administrative"
+	USER_ACCESS_MODE_READ            = "Read"
+	USER_ACCESS_MODE_SUPPORT_USER    = "SupportUser"
+	USER_ACCESS_MODE_NON_INTERACTIVE = "NonInteractive"
+	USER_ACCESS_MODE_DELEGATED_ADMIN = "DelegatedAdmin"
+)
+
+// userAccessModes maps the Dataverse accessmode option set values to their provider names.
+var userAccessModes = map[int]string{
+	0: USER_ACCESS_MODE_READ_WRITE,
+	1: USER_ACCESS_MODE_ADMINISTRATIVE,
+	2: USER_ACCESS_MODE_READ,
+	3: USER_ACCESS_MODE_SUPPORT_USER,
+	4: USER_ACCESS_MODE_NON_INTERACTIVE,
+	5: USER_ACCESS_MODE_DELEGATED_ADMIN,
+}
 
 type UserDto struct {
 	Id             string            `json:"systemuserid"`
@@ -12,6 +34,10 @@ type UserDto struct {
 	LastName       string            `json:"lastname"`
 	AadObjectId    string            `json:"azureactivedirectoryobjectid"`
 	BusinessUnitId string            `json:"_businessunitid_value"`
+	ApplicationId  string            `json:"applicationid,omitempty"`
+	FullName       string            `json:"fullname,omitempty"`
+	IsDisabled     bool              `json:"isdisabled,omitempty"`
+	AccessMode     int               `json:"accessmode,omitempty"`
 	SecurityRoles  []SecurityRoleDto `json:"systemuserroles_association,omitempty"`
 }
 
@@ -39,7 +65,8 @@ func (u *UserDto) SecurityRolesArray() []string {
 }
 
 type UserDtoArray struct {
-	Value []UserDto `json:"value"`
+	Value    []UserDto `json:"value"`
+	NextLink string    `json:"@odata.nextLink"`
 }
 
 type EnvironmentIdDto struct {
@@ -60,7 +87,7 @@ func ConvertFromUserDto(userDto *UserDto, disableDelete bool) UserResourceModel
 	model := UserResourceModel{
 		Id:                types.StringValue(userDto.Id),
 		AadId:             types.StringValue(userDto.AadObjectId),
-		SecurityRoles:     userDto.SecurityRolesArray(),
+		SecurityRoles:     convertToSecurityRolesSet(userDto.SecurityRolesArray()),
 		UserPrincipalName: types.StringValue(userDto.DomainName),
 		FirstName:         types.StringValue(userDto.FirstName),
 		LastName:          types.StringValue(userDto.LastName),
@@ -69,3 +96,55 @@ func ConvertFromUserDto(userDto *UserDto, disableDelete bool) UserResourceModel
 	model.DisableDelete = types.BoolValue(disableDelete)
 	return model
 }
+
+func convertToSecurityRolesSet(securityRoles []string) types.Set {
+	values := []attr.Value{}
+	for _, roleId := range securityRoles {
+		values = append(values, types.StringValue(roleId))
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+func ConvertFromApplicationUserDto(userDto *UserDto) ApplicationUserResourceModel {
+	return ApplicationUserResourceModel{
+		Id:             types.StringValue(userDto.Id),
+		ApplicationId:  types.StringValue(userDto.ApplicationId),
+		BusinessUnitId: types.StringValue(userDto.BusinessUnitId),
+		SecurityRoles:  convertToSecurityRolesSet(userDto.SecurityRolesArray()),
+		FullName:       types.StringValue(userDto.FullName),
+	}
+}
+
+func convertFromSecurityRolesSet(securityRoles types.Set) []string {
+	roles := []string{}
+	if securityRoles.IsNull() || securityRoles.IsUnknown() {
+		return roles
+	}
+	for _, role := range securityRoles.Elements() {
+		roles = append(roles, role.(types.String).ValueString())
+	}
+	return roles
+}
+
+func convertFromUserDtoToUserDataSourceModel(environmentId string, userDto UserDto) UserDataSourceModel {
+	model := UserDataSourceModel{
+		EnvironmentId:     types.StringValue(environmentId),
+		Id:                types.StringValue(userDto.Id),
+		AadObjectId:       types.StringValue(userDto.AadObjectId),
+		UserPrincipalName: types.StringValue(userDto.DomainName),
+		FirstName:         types.StringValue(userDto.FirstName),
+		LastName:          types.StringValue(userDto.LastName),
+		FullName:          types.StringValue(userDto.FullName),
+		ApplicationId:     types.StringValue(userDto.ApplicationId),
+		BusinessUnitId:    types.StringValue(userDto.BusinessUnitId),
+		Disabled:          types.BoolValue(userDto.IsDisabled),
+		AccessMode:        types.StringValue(userAccessModes[userDto.AccessMode]),
+		SecurityRoles:     []string{},
+		SecurityRoleNames: []string{},
+	}
+	for _, role := range userDto.SecurityRoles {
+		model.SecurityRoles = append(model.SecurityRoles, role.RoleId)
+		model.SecurityRoleNames = append(model.SecurityRoleNames, role.Name)
+	}
+	return model
+}
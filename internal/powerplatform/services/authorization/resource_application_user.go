@@ -0,0 +1,308 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+var _ resource.Resource = &ApplicationUserResource{}
+var _ resource.ResourceWithImportState = &ApplicationUserResource{}
+
+func NewApplicationUserResource() resource.Resource {
+	return &ApplicationUserResource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_application_user",
+	}
+}
+
+type ApplicationUserResource struct {
+	UserClient       UserClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+type ApplicationUserResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	EnvironmentId  types.String `tfsdk:"environment_id"`
+	ApplicationId  types.String `tfsdk:"application_id"`
+	BusinessUnitId types.String `tfsdk:"business_unit_id"`
+	SecurityRoles  types.Set    `tfsdk:"security_roles"`
+	FullName       types.String `tfsdk:"full_name"`
+}
+
+func (r *ApplicationUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.TypeName
+}
+
+func (r *ApplicationUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource registers an application user (service principal) in a Power Platform environment. Additional Resources:\n\n* [Manage application users](https://learn.microsoft.com/power-platform/admin/manage-application-users)\n\n* [Use server-to-server (S2S) authentication](https://learn.microsoft.com/power-apps/developer/data-platform/use-single-tenant-server-server-authentication)",
+		Description:         "This resource registers an application user (service principal) in a Power Platform environment",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique system user id (guid)",
+				Description:         "Unique system user id (guid)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Unique environment id (guid)",
+				Description:         "Unique environment id (guid)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "Entra application (client) id",
+				Description:         "Entra application (client) id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"business_unit_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the business unit to which the application user belongs. Defaults to the root business unit of the environment",
+				Description:         "Id of the business unit to which the application user belongs. Defaults to the root business unit of the environment",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"security_roles": schema.SetAttribute{
+				MarkdownDescription: "Security roles Ids assigned to the application user",
+				Description:         "Security roles Ids assigned to the application user",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+			},
+			"full_name": schema.StringAttribute{
+				MarkdownDescription: "Full name of the application user",
+				Description:         "Full name of the application user",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ApplicationUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientApi := req.ProviderData.(*api.ProviderClient).Api
+
+	if clientApi == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.UserClient = NewUserClient(clientApi)
+}
+
+func (r *ApplicationUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ApplicationUserResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	businessUnitId := plan.BusinessUnitId.ValueString()
+	if plan.BusinessUnitId.IsUnknown() || plan.BusinessUnitId.IsNull() {
+		rootBusinessUnitId, err := r.UserClient.GetRootBusinessUnitId(ctx, plan.EnvironmentId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading root business unit for %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+		businessUnitId = rootBusinessUnitId
+	}
+
+	userDto, err := r.UserClient.CreateApplicationUser(ctx, plan.EnvironmentId.ValueString(), plan.ApplicationId.ValueString(), businessUnitId)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	if securityRoles := convertFromSecurityRolesSet(plan.SecurityRoles); len(securityRoles) > 0 {
+		userDto, err = r.UserClient.AddSecurityRoles(ctx, plan.EnvironmentId.ValueString(), userDto.Id, securityRoles)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+	}
+
+	model := ConvertFromApplicationUserDto(userDto)
+
+	plan.Id = model.Id
+	plan.ApplicationId = model.ApplicationId
+	plan.BusinessUnitId = model.BusinessUnitId
+	plan.SecurityRoles = model.SecurityRoles
+	plan.FullName = model.FullName
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID %s", plan.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *ApplicationUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ApplicationUserResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userDto, err := r.UserClient.GetUserBySystemUserId(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		if helpers.Code(err) == helpers.ERROR_OBJECT_NOT_FOUND {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	model := ConvertFromApplicationUserDto(userDto)
+
+	state.Id = model.Id
+	state.ApplicationId = model.ApplicationId
+	state.BusinessUnitId = model.BusinessUnitId
+	state.SecurityRoles = model.SecurityRoles
+	state.FullName = model.FullName
+
+	tflog.Debug(ctx, fmt.Sprintf("READ: %s_application_user with id %s", r.ProviderTypeName, state.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *ApplicationUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *ApplicationUserResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state *ApplicationUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//the security roles of the application user are kept when they are not configured
+	currentSecurityRoles := convertFromSecurityRolesSet(state.SecurityRoles)
+	securityRoles := currentSecurityRoles
+	if !plan.SecurityRoles.IsUnknown() {
+		securityRoles = convertFromSecurityRolesSet(plan.SecurityRoles)
+	}
+	addedSecurityRoles, removedSecurityRoles := helpers.DiffArrays(securityRoles, currentSecurityRoles)
+
+	user, err := r.UserClient.GetUserBySystemUserId(ctx, plan.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	if len(addedSecurityRoles) > 0 {
+		userDto, err := r.UserClient.AddSecurityRoles(ctx, plan.EnvironmentId.ValueString(), state.Id.ValueString(), addedSecurityRoles)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when adding security roles %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+		user = userDto
+	}
+	if len(removedSecurityRoles) > 0 {
+		userDto, err := r.UserClient.RemoveSecurityRoles(ctx, plan.EnvironmentId.ValueString(), state.Id.ValueString(), removedSecurityRoles)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when removing security roles %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+		user = userDto
+	}
+
+	model := ConvertFromApplicationUserDto(user)
+
+	plan.Id = model.Id
+	plan.ApplicationId = model.ApplicationId
+	plan.BusinessUnitId = model.BusinessUnitId
+	plan.SecurityRoles = model.SecurityRoles
+	plan.FullName = model.FullName
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *ApplicationUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *ApplicationUserResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//active system users can't be deleted, so the application user has to be disabled first
+	err := r.UserClient.DisableUser(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when disabling %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	err = r.UserClient.DeleteUser(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *ApplicationUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	//application users are imported with the format <environment_id>_<system_user_id>
+	parts := strings.Split(req.ID, "_")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected import identifier", fmt.Sprintf("Expected import identifier with format: <environment_id>_<system_user_id>. Got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
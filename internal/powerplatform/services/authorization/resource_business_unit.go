@@ -0,0 +1,300 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+var _ resource.Resource = &BusinessUnitResource{}
+var _ resource.ResourceWithImportState = &BusinessUnitResource{}
+
+func NewBusinessUnitResource() resource.Resource {
+	return &BusinessUnitResource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_business_unit",
+	}
+}
+
+type BusinessUnitResource struct {
+	BusinessUnitClient BusinessUnitClient
+	ProviderTypeName   string
+	TypeName           string
+}
+
+type BusinessUnitResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	EnvironmentId        types.String `tfsdk:"environment_id"`
+	Name                 types.String `tfsdk:"name"`
+	ParentBusinessUnitId types.String `tfsdk:"parent_business_unit_id"`
+	CostCenter           types.String `tfsdk:"cost_center"`
+	Description          types.String `tfsdk:"description"`
+	Disabled             types.Bool   `tfsdk:"disabled"`
+}
+
+func (r *BusinessUnitResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.TypeName
+}
+
+func (r *BusinessUnitResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource manages a Dataverse business unit in a Power Platform environment. Additional Resources:\n\n* [Create or edit business units](https://learn.microsoft.com/power-platform/admin/create-edit-business-units)",
+		Description:         "This resource manages a Dataverse business unit in a Power Platform environment",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique business unit id (guid)",
+				Description:         "Unique business unit id (guid)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Unique environment id (guid)",
+				Description:         "Unique environment id (guid)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the business unit",
+				Description:         "Name of the business unit",
+				Required:            true,
+			},
+			"parent_business_unit_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the parent business unit. Defaults to the root business unit of the environment",
+				Description:         "Id of the parent business unit. Defaults to the root business unit of the environment",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cost_center": schema.StringAttribute{
+				MarkdownDescription: "Cost center of the business unit",
+				Description:         "Cost center of the business unit",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the business unit",
+				Description:         "Description of the business unit",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the business unit is disabled. Users of a disabled business unit can't sign in",
+				Description:         "Whether the business unit is disabled. Users of a disabled business unit can't sign in",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *BusinessUnitResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientApi := req.ProviderData.(*api.ProviderClient).Api
+
+	if clientApi == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.BusinessUnitClient = NewBusinessUnitClient(clientApi)
+}
+
+func (r *BusinessUnitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *BusinessUnitResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentBusinessUnitId := plan.ParentBusinessUnitId.ValueString()
+	if plan.ParentBusinessUnitId.IsUnknown() || plan.ParentBusinessUnitId.IsNull() {
+		rootBusinessUnitId, err := r.BusinessUnitClient.userClient.GetRootBusinessUnitId(ctx, plan.EnvironmentId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading root business unit for %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+		parentBusinessUnitId = rootBusinessUnitId
+	}
+
+	businessUnitDto, err := r.BusinessUnitClient.CreateBusinessUnit(ctx, plan.EnvironmentId.ValueString(), CreateBusinessUnitDto{
+		Name:               plan.Name.ValueString(),
+		ParentBusinessUnit: fmt.Sprintf("/businessunits(%s)", parentBusinessUnitId),
+		CostCenter:         plan.CostCenter.ValueString(),
+		Description:        plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	if plan.Disabled.ValueBool() {
+		businessUnitDto, err = r.BusinessUnitClient.SetBusinessUnitDisabled(ctx, plan.EnvironmentId.ValueString(), businessUnitDto.Id, true)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when disabling %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+	}
+
+	model := ConvertFromBusinessUnitDto(businessUnitDto)
+	model.EnvironmentId = plan.EnvironmentId
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID %s", model.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *BusinessUnitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *BusinessUnitResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	businessUnitDto, err := r.BusinessUnitClient.GetBusinessUnit(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		if helpers.Code(err) == helpers.ERROR_OBJECT_NOT_FOUND {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	model := ConvertFromBusinessUnitDto(businessUnitDto)
+	model.EnvironmentId = state.EnvironmentId
+
+	tflog.Debug(ctx, fmt.Sprintf("READ: %s_business_unit with id %s", r.ProviderTypeName, model.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *BusinessUnitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *BusinessUnitResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state *BusinessUnitResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	businessUnitToUpdate := UpdateBusinessUnitDto{
+		Name:        plan.Name.ValueString(),
+		CostCenter:  plan.CostCenter.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+	if !plan.ParentBusinessUnitId.IsUnknown() && !plan.ParentBusinessUnitId.Equal(state.ParentBusinessUnitId) {
+		businessUnitToUpdate.ParentBusinessUnit = fmt.Sprintf("/businessunits(%s)", plan.ParentBusinessUnitId.ValueString())
+	}
+
+	businessUnitDto, err := r.BusinessUnitClient.UpdateBusinessUnit(ctx, plan.EnvironmentId.ValueString(), state.Id.ValueString(), businessUnitToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	if plan.Disabled.ValueBool() != businessUnitDto.IsDisabled {
+		businessUnitDto, err = r.BusinessUnitClient.SetBusinessUnitDisabled(ctx, plan.EnvironmentId.ValueString(), state.Id.ValueString(), plan.Disabled.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+	}
+
+	model := ConvertFromBusinessUnitDto(businessUnitDto)
+	model.EnvironmentId = plan.EnvironmentId
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *BusinessUnitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *BusinessUnitResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//only disabled business units can be deleted
+	if !state.Disabled.ValueBool() {
+		_, err := r.BusinessUnitClient.SetBusinessUnitDisabled(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString(), true)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when disabling %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+	}
+
+	err := r.BusinessUnitClient.DeleteBusinessUnit(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *BusinessUnitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	//business units are imported with the format <environment_id>_<business_unit_id>
+	parts := strings.Split(req.ID, "_")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected import identifier", fmt.Sprintf("Expected import identifier with format: <environment_id>_<business_unit_id>. Got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
@@ -0,0 +1,255 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+var _ resource.Resource = &EnvironmentRoleAssignmentResource{}
+var _ resource.ResourceWithImportState = &EnvironmentRoleAssignmentResource{}
+
+func NewEnvironmentRoleAssignmentResource() resource.Resource {
+	return &EnvironmentRoleAssignmentResource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_environment_role_assignment",
+	}
+}
+
+type EnvironmentRoleAssignmentResource struct {
+	EnvironmentRoleAssignmentClient EnvironmentRoleAssignmentClient
+	ProviderTypeName                string
+	TypeName                        string
+}
+
+type EnvironmentRoleAssignmentResourceModel struct {
+	Id                   types.String                            `tfsdk:"id"`
+	EnvironmentId        types.String                            `tfsdk:"environment_id"`
+	Role                 types.String                            `tfsdk:"role"`
+	Principal            EnvironmentRoleAssignmentPrincipalModel `tfsdk:"principal"`
+	PrincipalDisplayName types.String                            `tfsdk:"principal_display_name"`
+}
+
+type EnvironmentRoleAssignmentPrincipalModel struct {
+	ObjectId types.String `tfsdk:"object_id"`
+	Type     types.String `tfsdk:"type"`
+}
+
+func (r *EnvironmentRoleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.TypeName
+}
+
+func (r *EnvironmentRoleAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This resource assigns the Environment Admin or Environment Maker role of an environment without Dataverse to a user, group or service principal",
+		MarkdownDescription: "This resource assigns the Environment Admin or Environment Maker role of an environment without Dataverse to a user, group or service principal. Environments with Dataverse manage these permissions with security roles, see `powerplatform_user`. Additional Resources:\n\n* [Environments without a Dataverse database](https://learn.microsoft.com/power-platform/admin/database-security#environments-without-a-dataverse-database)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the role assignment",
+				Description:         "Unique identifier of the role assignment",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Unique environment id (guid)",
+				Description:         "Unique environment id (guid)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role assigned to the principal, either `EnvironmentAdmin` or `EnvironmentMaker`",
+				Description:         "Role assigned to the principal, either `EnvironmentAdmin` or `EnvironmentMaker`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(ENVIRONMENT_ROLE_ADMIN, ENVIRONMENT_ROLE_MAKER),
+				},
+			},
+			"principal": schema.SingleNestedAttribute{
+				MarkdownDescription: "Principal the role is assigned to",
+				Description:         "Principal the role is assigned to",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"object_id": schema.StringAttribute{
+						MarkdownDescription: "Entra ID object id of the principal",
+						Description:         "Entra ID object id of the principal",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of the principal, one of `User`, `Group` or `ServicePrincipal`",
+						Description:         "Type of the principal, one of `User`, `Group` or `ServicePrincipal`",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf(ENVIRONMENT_ROLE_PRINCIPAL_TYPE_USER, ENVIRONMENT_ROLE_PRINCIPAL_TYPE_GROUP, ENVIRONMENT_ROLE_PRINCIPAL_TYPE_SERVICE_PRINCIPAL),
+						},
+					},
+				},
+			},
+			"principal_display_name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the principal",
+				Description:         "Display name of the principal",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *EnvironmentRoleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientApi := req.ProviderData.(*api.ProviderClient).Api
+
+	if clientApi == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.EnvironmentRoleAssignmentClient = NewEnvironmentRoleAssignmentClient(clientApi)
+}
+
+func (r *EnvironmentRoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *EnvironmentRoleAssignmentResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	principal := EnvironmentRolePrincipalDto{
+		Id:   plan.Principal.ObjectId.ValueString(),
+		Type: plan.Principal.Type.ValueString(),
+	}
+	assignment, err := r.EnvironmentRoleAssignmentClient.CreateEnvironmentRoleAssignment(ctx, plan.EnvironmentId.ValueString(), plan.Role.ValueString(), principal)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	model := ConvertFromEnvironmentRoleAssignmentDto(plan.EnvironmentId.ValueString(), assignment)
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID %s", model.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *EnvironmentRoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var environmentId, id types.String
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE START: %s", r.ProviderTypeName))
+
+	//only the ids are read from the state, the principal is not known yet when the assignment is imported
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("environment_id"), &environmentId)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assignment, err := r.EnvironmentRoleAssignmentClient.GetEnvironmentRoleAssignment(ctx, environmentId.ValueString(), id.ValueString())
+	if err != nil {
+		if helpers.Code(err) == helpers.ERROR_OBJECT_NOT_FOUND {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	model := ConvertFromEnvironmentRoleAssignmentDto(environmentId.ValueString(), assignment)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ: %s_environment_role_assignment with id %s", r.ProviderTypeName, model.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *EnvironmentRoleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *EnvironmentRoleAssignmentResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE START: %s", r.ProviderTypeName))
+
+	//all configurable attributes require a replacement, so there is nothing to update in place
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *EnvironmentRoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *EnvironmentRoleAssignmentResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.EnvironmentRoleAssignmentClient.DeleteEnvironmentRoleAssignment(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *EnvironmentRoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	//role assignments are imported with the format <environment_id>_<role_assignment_id>
+	parts := strings.Split(req.ID, "_")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected import identifier", fmt.Sprintf("Expected import identifier with format: <environment_id>_<role_assignment_id>. Got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
@@ -0,0 +1,420 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+var _ resource.Resource = &SecurityRoleResource{}
+var _ resource.ResourceWithImportState = &SecurityRoleResource{}
+var _ resource.ResourceWithValidateConfig = &SecurityRoleResource{}
+
+func NewSecurityRoleResource() resource.Resource {
+	return &SecurityRoleResource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_security_role",
+	}
+}
+
+type SecurityRoleResource struct {
+	SecurityRoleClient SecurityRoleClient
+	ProviderTypeName   string
+	TypeName           string
+}
+
+type SecurityRoleResourceModel struct {
+	Id             types.String                 `tfsdk:"id"`
+	EnvironmentId  types.String                 `tfsdk:"environment_id"`
+	Name           types.String                 `tfsdk:"name"`
+	Description    types.String                 `tfsdk:"description"`
+	BusinessUnitId types.String                 `tfsdk:"business_unit_id"`
+	Privileges     []SecurityRolePrivilegeModel `tfsdk:"privileges"`
+}
+
+type SecurityRolePrivilegeModel struct {
+	Name  types.String `tfsdk:"name"`
+	Depth types.String `tfsdk:"depth"`
+}
+
+func (r *SecurityRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.TypeName
+}
+
+func (r *SecurityRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource manages a custom Dataverse security role and its privileges. Additional Resources:\n\n* [Create or edit a security role](https://learn.microsoft.com/power-platform/admin/create-edit-security-role)\n\n* [Security roles and privileges](https://learn.microsoft.com/power-platform/admin/security-roles-privileges)",
+		Description:         "This resource manages a custom Dataverse security role and its privileges",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique security role id (guid)",
+				Description:         "Unique security role id (guid)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Unique environment id (guid)",
+				Description:         "Unique environment id (guid)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the security role",
+				Description:         "Name of the security role",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the security role",
+				Description:         "Description of the security role",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"business_unit_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the business unit the security role is defined in. Defaults to the root business unit of the environment",
+				Description:         "Id of the business unit the security role is defined in. Defaults to the root business unit of the environment",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"privileges": schema.SetNestedAttribute{
+				MarkdownDescription: "Privileges granted by the security role",
+				Description:         "Privileges granted by the security role",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the privilege, for example `prvReadAccount`",
+							Description:         "Name of the privilege, for example 'prvReadAccount'",
+							Required:            true,
+						},
+						"depth": schema.StringAttribute{
+							MarkdownDescription: "Depth of the privilege. Can be one of `User`, `BusinessUnit`, `ParentChild` or `Organization`",
+							Description:         "Depth of the privilege. Can be one of 'User', 'BusinessUnit', 'ParentChild' or 'Organization'",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(PRIVILEGE_DEPTH_USER, PRIVILEGE_DEPTH_BUSINESS_UNIT, PRIVILEGE_DEPTH_PARENT_CHILD, PRIVILEGE_DEPTH_ORGANIZATION),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SecurityRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientApi := req.ProviderData.(*api.ProviderClient).Api
+
+	if clientApi == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.SecurityRoleClient = NewSecurityRoleClient(clientApi)
+}
+
+func (r *SecurityRoleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var privileges types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("privileges"), &privileges)...)
+	if resp.Diagnostics.HasError() || privileges.IsNull() || privileges.IsUnknown() {
+		return
+	}
+
+	var privilegeModels []SecurityRolePrivilegeModel
+	resp.Diagnostics.Append(privileges.ElementsAs(ctx, &privilegeModels, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := map[string]bool{}
+	for _, privilege := range privilegeModels {
+		if privilege.Name.IsUnknown() || privilege.Name.IsNull() {
+			continue
+		}
+		if names[privilege.Name.ValueString()] {
+			resp.Diagnostics.AddAttributeError(path.Root("privileges"), "Duplicate privilege", fmt.Sprintf("The privilege %q is defined more than once. Each privilege can only be granted with a single depth", privilege.Name.ValueString()))
+		}
+		names[privilege.Name.ValueString()] = true
+	}
+}
+
+func (r *SecurityRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *SecurityRoleResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentId := plan.EnvironmentId.ValueString()
+
+	businessUnitId := plan.BusinessUnitId.ValueString()
+	if plan.BusinessUnitId.IsUnknown() || plan.BusinessUnitId.IsNull() {
+		rootBusinessUnitId, err := r.SecurityRoleClient.userClient.GetRootBusinessUnitId(ctx, environmentId)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading root business unit for %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+		businessUnitId = rootBusinessUnitId
+	}
+
+	//privileges are resolved before the role is created, so that an unknown privilege doesn't leave an empty role behind
+	rolePrivileges, err := r.buildRolePrivileges(ctx, environmentId, businessUnitId, plan.Privileges)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when resolving privileges for %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	roleDto, err := r.SecurityRoleClient.CreateSecurityRole(ctx, environmentId, CreateSecurityRoleDto{
+		Name:         plan.Name.ValueString(),
+		Description:  plan.Description.ValueString(),
+		BusinessUnit: fmt.Sprintf("/businessunits(%s)", businessUnitId),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	err = r.SecurityRoleClient.ReplaceRolePrivileges(ctx, environmentId, roleDto.Id, rolePrivileges)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when setting privileges of %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		//the role is not saved to the state, so it is deleted rather than left behind without its privileges
+		deleteErr := r.SecurityRoleClient.DeleteSecurityRole(ctx, environmentId, roleDto.Id)
+		if deleteErr != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s_%s after failing to set its privileges", r.ProviderTypeName, r.TypeName), fmt.Sprintf("Security role '%s' has to be deleted manually: %s", roleDto.Id, deleteErr.Error()))
+		}
+		return
+	}
+
+	privileges, err := r.SecurityRoleClient.GetRolePrivileges(ctx, environmentId, roleDto.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading privileges of %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	model := ConvertFromSecurityRoleDto(roleDto, privileges)
+	model.EnvironmentId = plan.EnvironmentId
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID %s", model.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *SecurityRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *SecurityRoleResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleDto, err := r.SecurityRoleClient.GetSecurityRole(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		if helpers.Code(err) == helpers.ERROR_OBJECT_NOT_FOUND {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	privileges, err := r.SecurityRoleClient.GetRolePrivileges(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading privileges of %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	model := ConvertFromSecurityRoleDto(roleDto, privileges)
+	model.EnvironmentId = state.EnvironmentId
+
+	tflog.Debug(ctx, fmt.Sprintf("READ: %s_security_role with id %s", r.ProviderTypeName, model.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *SecurityRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *SecurityRoleResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state *SecurityRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentId := plan.EnvironmentId.ValueString()
+	roleId := state.Id.ValueString()
+
+	roleDto, err := r.SecurityRoleClient.UpdateSecurityRole(ctx, environmentId, roleId, UpdateSecurityRoleDto{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	currentPrivileges, err := r.SecurityRoleClient.GetRolePrivileges(ctx, environmentId, roleId)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading privileges of %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	plannedDepths := map[string]string{}
+	for _, privilege := range plan.Privileges {
+		plannedDepths[privilege.Name.ValueString()] = convertPrivilegeDepthToDataverse(privilege.Depth.ValueString())
+	}
+
+	currentDepths := map[string]string{}
+	removedPrivilegeIds := []string{}
+	for _, privilege := range currentPrivileges {
+		currentDepths[privilege.PrivilegeName] = privilege.Depth
+		if _, ok := plannedDepths[privilege.PrivilegeName]; !ok {
+			removedPrivilegeIds = append(removedPrivilegeIds, privilege.PrivilegeId)
+		}
+	}
+
+	//new privileges and privileges with a changed depth are both granted through AddPrivilegesRole
+	changedPrivileges := []SecurityRolePrivilegeModel{}
+	for _, privilege := range plan.Privileges {
+		if currentDepths[privilege.Name.ValueString()] != plannedDepths[privilege.Name.ValueString()] {
+			changedPrivileges = append(changedPrivileges, privilege)
+		}
+	}
+
+	if len(changedPrivileges) > 0 {
+		rolePrivileges, err := r.buildRolePrivileges(ctx, environmentId, roleDto.BusinessUnitId, changedPrivileges)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when resolving privileges for %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+		err = r.SecurityRoleClient.AddRolePrivileges(ctx, environmentId, roleId, rolePrivileges)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when adding privileges to %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+	}
+	if len(removedPrivilegeIds) > 0 {
+		err = r.SecurityRoleClient.RemoveRolePrivileges(ctx, environmentId, roleId, removedPrivilegeIds)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when removing privileges from %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+	}
+
+	privileges, err := r.SecurityRoleClient.GetRolePrivileges(ctx, environmentId, roleId)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading privileges of %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	model := ConvertFromSecurityRoleDto(roleDto, privileges)
+	model.EnvironmentId = plan.EnvironmentId
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *SecurityRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *SecurityRoleResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.SecurityRoleClient.DeleteSecurityRole(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *SecurityRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	//security roles are imported with the format <environment_id>_<role_id>
+	parts := strings.Split(req.ID, "_")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected import identifier", fmt.Sprintf("Expected import identifier with format: <environment_id>_<role_id>. Got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func (r *SecurityRoleResource) buildRolePrivileges(ctx context.Context, environmentId, businessUnitId string, privileges []SecurityRolePrivilegeModel) ([]RolePrivilegeDto, error) {
+	names := []string{}
+	for _, privilege := range privileges {
+		names = append(names, privilege.Name.ValueString())
+	}
+
+	privilegesByName, err := r.SecurityRoleClient.GetPrivilegesByName(ctx, environmentId, names)
+	if err != nil {
+		return nil, err
+	}
+
+	rolePrivileges := []RolePrivilegeDto{}
+	for _, privilege := range privileges {
+		rolePrivileges = append(rolePrivileges, RolePrivilegeDto{
+			Depth:          convertPrivilegeDepthToDataverse(privilege.Depth.ValueString()),
+			PrivilegeId:    privilegesByName[privilege.Name.ValueString()].Id,
+			BusinessUnitId: businessUnitId,
+			PrivilegeName:  privilege.Name.ValueString(),
+		})
+	}
+	return rolePrivileges, nil
+}
@@ -0,0 +1,383 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+var _ resource.Resource = &TeamResource{}
+var _ resource.ResourceWithImportState = &TeamResource{}
+var _ resource.ResourceWithValidateConfig = &TeamResource{}
+
+func NewTeamResource() resource.Resource {
+	return &TeamResource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_team",
+	}
+}
+
+type TeamResource struct {
+	TeamClient       TeamClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+type TeamResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	EnvironmentId   types.String `tfsdk:"environment_id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	TeamType        types.String `tfsdk:"team_type"`
+	EntraObjectId   types.String `tfsdk:"entra_object_id"`
+	MembershipType  types.String `tfsdk:"membership_type"`
+	BusinessUnitId  types.String `tfsdk:"business_unit_id"`
+	AdministratorId types.String `tfsdk:"administrator_id"`
+	SecurityRoles   types.Set    `tfsdk:"security_roles"`
+}
+
+func (r *TeamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.TypeName
+}
+
+func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource manages a Dataverse team in a Power Platform environment. Owner, access and Entra group teams are supported. Additional Resources:\n\n* [Manage teams](https://learn.microsoft.com/power-platform/admin/manage-teams)\n\n* [Manage group teams](https://learn.microsoft.com/power-platform/admin/manage-group-teams)",
+		Description:         "This resource manages a Dataverse team in a Power Platform environment",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique team id (guid)",
+				Description:         "Unique team id (guid)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Unique environment id (guid)",
+				Description:         "Unique environment id (guid)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the team",
+				Description:         "Name of the team",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the team",
+				Description:         "Description of the team",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"team_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the team. Can be one of `Owner`, `Access`, `EntraSecurityGroup` or `EntraOfficeGroup`",
+				Description:         "Type of the team. Can be one of 'Owner', 'Access', 'EntraSecurityGroup' or 'EntraOfficeGroup'",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(TEAM_TYPE_OWNER, TEAM_TYPE_ACCESS, TEAM_TYPE_ENTRA_SECURITY_GROUP, TEAM_TYPE_ENTRA_OFFICE_GROUP),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entra_object_id": schema.StringAttribute{
+				MarkdownDescription: "Object id of the Entra group the team is linked to. Required for `EntraSecurityGroup` and `EntraOfficeGroup` teams",
+				Description:         "Object id of the Entra group the team is linked to. Required for 'EntraSecurityGroup' and 'EntraOfficeGroup' teams",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"membership_type": schema.StringAttribute{
+				MarkdownDescription: "Which members of the Entra group are members of the team. Can be one of `MembersAndGuests`, `Members`, `Owners` or `Guests`. Only applies to Entra group teams",
+				Description:         "Which members of the Entra group are members of the team. Can be one of 'MembersAndGuests', 'Members', 'Owners' or 'Guests'. Only applies to Entra group teams",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(TEAM_MEMBERSHIP_TYPE_MEMBERS_AND_GUESTS, TEAM_MEMBERSHIP_TYPE_MEMBERS, TEAM_MEMBERSHIP_TYPE_OWNERS, TEAM_MEMBERSHIP_TYPE_GUESTS),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"business_unit_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the business unit to which the team belongs. Defaults to the root business unit of the environment",
+				Description:         "Id of the business unit to which the team belongs. Defaults to the root business unit of the environment",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"administrator_id": schema.StringAttribute{
+				MarkdownDescription: "System user id of the team administrator. Defaults to the user creating the team",
+				Description:         "System user id of the team administrator. Defaults to the user creating the team",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"security_roles": schema.SetAttribute{
+				MarkdownDescription: "Security roles Ids assigned to the team",
+				Description:         "Security roles Ids assigned to the team",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *TeamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientApi := req.ProviderData.(*api.ProviderClient).Api
+
+	if clientApi == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.TeamClient = NewTeamClient(clientApi)
+}
+
+func (r *TeamResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var teamType, entraObjectId, membershipType types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("team_type"), &teamType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("entra_object_id"), &entraObjectId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("membership_type"), &membershipType)...)
+	if resp.Diagnostics.HasError() || teamType.IsUnknown() || teamType.IsNull() {
+		return
+	}
+
+	if isEntraTeamType(teamType.ValueString()) {
+		if entraObjectId.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("entra_object_id"), "Missing required argument", fmt.Sprintf("The argument \"entra_object_id\" is required for %s teams", teamType.ValueString()))
+		}
+		return
+	}
+
+	if !entraObjectId.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("entra_object_id"), "Invalid argument", fmt.Sprintf("The argument \"entra_object_id\" can only be set for %s and %s teams", TEAM_TYPE_ENTRA_SECURITY_GROUP, TEAM_TYPE_ENTRA_OFFICE_GROUP))
+	}
+	if !membershipType.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("membership_type"), "Invalid argument", fmt.Sprintf("The argument \"membership_type\" can only be set for %s and %s teams", TEAM_TYPE_ENTRA_SECURITY_GROUP, TEAM_TYPE_ENTRA_OFFICE_GROUP))
+	}
+}
+
+func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *TeamResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	businessUnitId := plan.BusinessUnitId.ValueString()
+	if plan.BusinessUnitId.IsUnknown() || plan.BusinessUnitId.IsNull() {
+		rootBusinessUnitId, err := r.TeamClient.userClient.GetRootBusinessUnitId(ctx, plan.EnvironmentId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading root business unit for %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+		businessUnitId = rootBusinessUnitId
+	}
+
+	teamToCreate := CreateTeamDto{
+		Name:         plan.Name.ValueString(),
+		Description:  plan.Description.ValueString(),
+		TeamType:     convertTeamTypeToDataverse(plan.TeamType.ValueString()),
+		AadObjectId:  plan.EntraObjectId.ValueString(),
+		BusinessUnit: fmt.Sprintf("/businessunits(%s)", businessUnitId),
+	}
+	if !plan.MembershipType.IsUnknown() && !plan.MembershipType.IsNull() {
+		membershipType := convertTeamMembershipTypeToDataverse(plan.MembershipType.ValueString())
+		teamToCreate.MembershipType = &membershipType
+	}
+	if !plan.AdministratorId.IsUnknown() && !plan.AdministratorId.IsNull() {
+		teamToCreate.Administrator = fmt.Sprintf("/systemusers(%s)", plan.AdministratorId.ValueString())
+	}
+
+	teamDto, err := r.TeamClient.CreateTeam(ctx, plan.EnvironmentId.ValueString(), teamToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	if securityRoles := convertFromSecurityRolesSet(plan.SecurityRoles); len(securityRoles) > 0 {
+		teamDto, err = r.TeamClient.AddTeamSecurityRoles(ctx, plan.EnvironmentId.ValueString(), teamDto.Id, securityRoles)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+	}
+
+	model := ConvertFromTeamDto(teamDto)
+	model.EnvironmentId = plan.EnvironmentId
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID %s", model.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *TeamResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamDto, err := r.TeamClient.GetTeam(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		if helpers.Code(err) == helpers.ERROR_OBJECT_NOT_FOUND {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	model := ConvertFromTeamDto(teamDto)
+	model.EnvironmentId = state.EnvironmentId
+
+	tflog.Debug(ctx, fmt.Sprintf("READ: %s_team with id %s", r.ProviderTypeName, model.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *TeamResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state *TeamResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamToUpdate := UpdateTeamDto{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+	if !plan.MembershipType.IsUnknown() && !plan.MembershipType.IsNull() && isEntraTeamType(plan.TeamType.ValueString()) {
+		membershipType := convertTeamMembershipTypeToDataverse(plan.MembershipType.ValueString())
+		teamToUpdate.MembershipType = &membershipType
+	}
+	if !plan.AdministratorId.IsUnknown() && !plan.AdministratorId.Equal(state.AdministratorId) {
+		teamToUpdate.Administrator = fmt.Sprintf("/systemusers(%s)", plan.AdministratorId.ValueString())
+	}
+
+	teamDto, err := r.TeamClient.UpdateTeam(ctx, plan.EnvironmentId.ValueString(), state.Id.ValueString(), teamToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	//the security roles of the team are kept when they are not configured
+	currentSecurityRoles := convertFromSecurityRolesSet(state.SecurityRoles)
+	securityRoles := currentSecurityRoles
+	if !plan.SecurityRoles.IsUnknown() {
+		securityRoles = convertFromSecurityRolesSet(plan.SecurityRoles)
+	}
+	addedSecurityRoles, removedSecurityRoles := helpers.DiffArrays(securityRoles, currentSecurityRoles)
+
+	if len(addedSecurityRoles) > 0 {
+		teamDto, err = r.TeamClient.AddTeamSecurityRoles(ctx, plan.EnvironmentId.ValueString(), state.Id.ValueString(), addedSecurityRoles)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when adding security roles %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+	}
+	if len(removedSecurityRoles) > 0 {
+		teamDto, err = r.TeamClient.RemoveTeamSecurityRoles(ctx, plan.EnvironmentId.ValueString(), state.Id.ValueString(), removedSecurityRoles)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when removing security roles %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+	}
+
+	model := ConvertFromTeamDto(teamDto)
+	model.EnvironmentId = plan.EnvironmentId
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *TeamResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.TeamClient.DeleteTeam(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	//teams are imported with the format <environment_id>_<team_id>
+	parts := strings.Split(req.ID, "_")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected import identifier", fmt.Sprintf("Expected import identifier with format: <environment_id>_<team_id>. Got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
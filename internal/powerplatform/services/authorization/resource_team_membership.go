@@ -0,0 +1,248 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+var _ resource.Resource = &TeamMembershipResource{}
+var _ resource.ResourceWithImportState = &TeamMembershipResource{}
+
+func NewTeamMembershipResource() resource.Resource {
+	return &TeamMembershipResource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_team_membership",
+	}
+}
+
+type TeamMembershipResource struct {
+	TeamClient       TeamClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+type TeamMembershipResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	TeamId        types.String `tfsdk:"team_id"`
+	UserIds       []string     `tfsdk:"user_ids"`
+}
+
+func (r *TeamMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.TypeName
+}
+
+func (r *TeamMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource manages the explicit members of a Dataverse team. Only the members listed in the resource are managed, members added outside of Terraform are left untouched. Members of Entra group teams are managed through the Entra group and can't be added explicitly. Additional Resources:\n\n* [Manage teams](https://learn.microsoft.com/power-platform/admin/manage-teams)",
+		Description:         "This resource manages the explicit members of a Dataverse team",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique id of the team membership. Same as the team id",
+				Description:         "Unique id of the team membership. Same as the team id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Unique environment id (guid)",
+				Description:         "Unique environment id (guid)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Unique team id (guid)",
+				Description:         "Unique team id (guid)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_ids": schema.SetAttribute{
+				MarkdownDescription: "System user ids of the team members",
+				Description:         "System user ids of the team members",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *TeamMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientApi := req.ProviderData.(*api.ProviderClient).Api
+
+	if clientApi == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.TeamClient = NewTeamClient(clientApi)
+}
+
+func (r *TeamMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *TeamMembershipResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := r.TeamClient.GetTeamMembers(ctx, plan.EnvironmentId.ValueString(), plan.TeamId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	addedMembers, _ := helpers.DiffArrays(plan.UserIds, members)
+	err = r.TeamClient.AddTeamMembers(ctx, plan.EnvironmentId.ValueString(), plan.TeamId.ValueString(), addedMembers)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	plan.Id = plan.TeamId
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID %s", plan.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *TeamMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *TeamMembershipResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := r.TeamClient.GetTeamMembers(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		if helpers.Code(err) == helpers.ERROR_OBJECT_NOT_FOUND {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	//only the members managed by this resource are tracked, unless the resource is being imported
+	if state.UserIds != nil {
+		_, removedMembers := helpers.DiffArrays(members, state.UserIds)
+		managedMembers, _ := helpers.DiffArrays(state.UserIds, removedMembers)
+		members = managedMembers
+	}
+
+	state.TeamId = state.Id
+	state.UserIds = members
+
+	tflog.Debug(ctx, fmt.Sprintf("READ: %s_team_membership with id %s", r.ProviderTypeName, state.Id.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *TeamMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *TeamMembershipResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state *TeamMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addedMembers, removedMembers := helpers.DiffArrays(plan.UserIds, state.UserIds)
+
+	if len(addedMembers) > 0 {
+		err := r.TeamClient.AddTeamMembers(ctx, plan.EnvironmentId.ValueString(), state.Id.ValueString(), addedMembers)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when adding members %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+	}
+	if len(removedMembers) > 0 {
+		err := r.TeamClient.RemoveTeamMembers(ctx, plan.EnvironmentId.ValueString(), state.Id.ValueString(), removedMembers)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when removing members %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+	}
+
+	plan.Id = state.Id
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *TeamMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *TeamMembershipResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.TeamClient.RemoveTeamMembers(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString(), state.UserIds)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *TeamMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	//team memberships are imported with the format <environment_id>_<team_id>
+	parts := strings.Split(req.ID, "_")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected import identifier", fmt.Sprintf("Expected import identifier with format: <environment_id>_<team_id>. Got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
@@ -22,6 +22,8 @@ import (
 
 var _ resource.Resource = &UserResource{}
 var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithValidateConfig = &UserResource{}
+var _ resource.ResourceWithModifyPlan = &UserResource{}
 
 func NewUserResource() resource.Resource {
 	return &UserResource{
@@ -41,7 +43,8 @@ type UserResourceModel struct {
 	EnvironmentId     types.String `tfsdk:"environment_id"`
 	AadId             types.String `tfsdk:"aad_id"`
 	BusinessUnitId    types.String `tfsdk:"business_unit_id"`
-	SecurityRoles     []string     `tfsdk:"security_roles"`
+	SecurityRoles     types.Set    `tfsdk:"security_roles"`
+	SecurityRoleNames types.Set    `tfsdk:"security_role_names"`
 	UserPrincipalName types.String `tfsdk:"user_principal_name"`
 	FirstName         types.String `tfsdk:"first_name"`
 	LastName          types.String `tfsdk:"last_name"`
@@ -76,8 +79,13 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 			"business_unit_id": schema.StringAttribute{
-				Description: "Id of the business unit to which the user belongs",
-				Computed:    true,
+				MarkdownDescription: "Id of the business unit to which the user belongs. Changing the business unit moves the user without recreating it. Dataverse removes the security roles of a user when it is moved, so `security_roles` have to belong to the new business unit",
+				Description:         "Id of the business unit to which the user belongs. Changing the business unit moves the user without recreating it",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"aad_id": schema.StringAttribute{
 				MarkdownDescription: "Entra user object id",
@@ -94,6 +102,12 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Optional:            true,
 				Computed:            true,
 			},
+			"security_role_names": schema.SetAttribute{
+				MarkdownDescription: "Names of the security roles assigned to the user. The names are resolved against the business unit of the user during plan and the resolved ids are stored in `security_roles`. Users without `business_unit_id` are expected in the root business unit. Conflicts with `security_roles`",
+				Description:         "Names of the security roles assigned to the user. The names are resolved against the business unit of the user during plan and the resolved ids are stored in 'security_roles'",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
 			"user_principal_name": schema.StringAttribute{
 				MarkdownDescription: "User principal name",
 				Description:         "User principal name",
@@ -139,6 +153,65 @@ func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.UserClient = NewUserClient(clientApi)
 }
 
+func (r *UserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var securityRoles, securityRoleNames types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("security_roles"), &securityRoles)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("security_role_names"), &securityRoleNames)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !securityRoles.IsNull() && !securityRoleNames.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("security_role_names"), "Conflicting security roles", "Only one of `security_roles` and `security_role_names` can be set")
+	}
+}
+
+func (r *UserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.SecurityRoleNames.IsNull() {
+		return
+	}
+
+	//the names can only be resolved once the provider is configured and the environment and business unit are known, otherwise they are resolved during apply
+	if r.UserClient.Api == nil || plan.SecurityRoleNames.IsUnknown() || plan.EnvironmentId.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("security_roles"), types.SetUnknown(types.StringType))...)
+		return
+	}
+
+	businessUnitId := plan.BusinessUnitId.ValueString()
+	if plan.BusinessUnitId.IsUnknown() {
+		var configBusinessUnitId types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("business_unit_id"), &configBusinessUnitId)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !configBusinessUnitId.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("security_roles"), types.SetUnknown(types.StringType))...)
+			return
+		}
+
+		rootBusinessUnitId, err := r.UserClient.GetRootBusinessUnitId(ctx, plan.EnvironmentId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading root business unit for %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+		businessUnitId = rootBusinessUnitId
+	}
+
+	securityRoleIds, err := r.UserClient.GetSecurityRoleIdsByName(ctx, plan.EnvironmentId.ValueString(), businessUnitId, convertFromSecurityRolesSet(plan.SecurityRoleNames))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("security_role_names"), "Unable to resolve security role names", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("security_roles"), convertToSecurityRolesSet(securityRoleIds))...)
+}
+
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan *UserResourceModel
 
@@ -156,7 +229,21 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	userDto, err = r.UserClient.AddSecurityRoles(ctx, plan.EnvironmentId.ValueString(), userDto.Id, plan.SecurityRoles)
+	if !plan.BusinessUnitId.IsUnknown() && !plan.BusinessUnitId.IsNull() && plan.BusinessUnitId.ValueString() != userDto.BusinessUnitId {
+		userDto, err = r.UserClient.SetUserBusinessUnit(ctx, plan.EnvironmentId.ValueString(), userDto.Id, plan.BusinessUnitId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when moving %s_%s to business unit", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+	}
+
+	securityRoles, err := r.plannedSecurityRoles(ctx, plan, userDto.BusinessUnitId, []string{})
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("security_role_names"), "Unable to resolve security role names", err.Error())
+		return
+	}
+
+	userDto, err = r.UserClient.AddSecurityRoles(ctx, plan.EnvironmentId.ValueString(), userDto.Id, securityRoles)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
 		return
@@ -166,7 +253,7 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	plan.Id = model.Id
 	plan.AadId = model.AadId
-	req.Plan.SetAttribute(ctx, path.Root("security_roles"), model.SecurityRoles)
+	plan.SecurityRoles = model.SecurityRoles
 	plan.UserPrincipalName = model.UserPrincipalName
 	plan.FirstName = model.FirstName
 	plan.LastName = model.LastName
@@ -234,14 +321,31 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	addedSecurityRoles, removedSecurityRoles := helpers.DiffArrays(plan.SecurityRoles, state.SecurityRoles)
-
 	user, err := r.UserClient.GetUserBySystemUserId(ctx, plan.EnvironmentId.ValueString(), state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
 		return
 	}
 
+	currentSecurityRoles := convertFromSecurityRolesSet(state.SecurityRoles)
+	if !plan.BusinessUnitId.IsUnknown() && !plan.BusinessUnitId.Equal(state.BusinessUnitId) {
+		user, err = r.UserClient.SetUserBusinessUnit(ctx, plan.EnvironmentId.ValueString(), state.Id.ValueString(), plan.BusinessUnitId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when moving %s_%s to business unit", r.ProviderTypeName, r.TypeName), err.Error())
+			return
+		}
+		//moving a user to another business unit removes its security roles, so they are compared against the moved user
+		currentSecurityRoles = user.SecurityRolesArray()
+	}
+
+	securityRoles, err := r.plannedSecurityRoles(ctx, plan, user.BusinessUnitId, currentSecurityRoles)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("security_role_names"), "Unable to resolve security role names", err.Error())
+		return
+	}
+
+	addedSecurityRoles, removedSecurityRoles := helpers.DiffArrays(securityRoles, currentSecurityRoles)
+
 	if len(addedSecurityRoles) > 0 {
 		userDto, err := r.UserClient.AddSecurityRoles(ctx, plan.EnvironmentId.ValueString(), state.Id.ValueString(), addedSecurityRoles)
 		if err != nil {
@@ -263,7 +367,7 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	plan.Id = model.Id
 	plan.AadId = model.AadId
-	req.Plan.SetAttribute(ctx, path.Root("security_roles"), model.SecurityRoles)
+	plan.SecurityRoles = model.SecurityRoles
 	plan.UserPrincipalName = model.UserPrincipalName
 	plan.FirstName = model.FirstName
 	plan.LastName = model.LastName
@@ -275,6 +379,18 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE END: %s", r.ProviderTypeName))
 }
 
+// plannedSecurityRoles returns the security role ids to assign to the user. Role names that couldn't be resolved during plan are resolved
+// against the business unit of the user, and an unknown set of role ids without names keeps the current roles.
+func (r *UserResource) plannedSecurityRoles(ctx context.Context, plan *UserResourceModel, businessUnitId string, currentSecurityRoles []string) ([]string, error) {
+	if !plan.SecurityRoles.IsUnknown() {
+		return convertFromSecurityRolesSet(plan.SecurityRoles), nil
+	}
+	if plan.SecurityRoleNames.IsNull() {
+		return currentSecurityRoles, nil
+	}
+	return r.UserClient.GetSecurityRoleIdsByName(ctx, plan.EnvironmentId.ValueString(), businessUnitId, convertFromSecurityRolesSet(plan.SecurityRoleNames))
+}
+
 func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state *UserResourceModel
 
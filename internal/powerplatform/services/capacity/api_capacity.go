@@ -0,0 +1,59 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+)
+
+type CapacityClient struct {
+	Api *api.ApiClient
+}
+
+func NewCapacityClient(api *api.ApiClient) CapacityClient {
+	return CapacityClient{
+		Api: api,
+	}
+}
+
+func (client *CapacityClient) GetTenantCapacity(ctx context.Context, tenantId string) (*TenantCapacityDto, error) {
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   client.Api.GetConfig().Urls.LicensingUrl,
+		Path:   fmt.Sprintf("/v0.1-alpha/tenants/%s/TenantCapacity", tenantId),
+	}
+
+	tenantCapacity := TenantCapacityDto{}
+	_, err := client.Api.Execute(ctx, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &tenantCapacity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenantCapacity, nil
+}
+
+func (client *CapacityClient) GetEnvironmentsCapacity(ctx context.Context) ([]EnvironmentCapacityDto, error) {
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   client.Api.GetConfig().Urls.BapiUrl,
+		Path:   "/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments",
+	}
+	values := url.Values{}
+	values.Add("$expand", "properties.capacity")
+	values.Add("api-version", "2023-06-01")
+	apiUrl.RawQuery = values.Encode()
+
+	environments := EnvironmentCapacityArrayDto{}
+	_, err := client.Api.Execute(ctx, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &environments)
+	if err != nil {
+		return nil, err
+	}
+
+	return environments.Value, nil
+}
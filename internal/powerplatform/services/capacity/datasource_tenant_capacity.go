@@ -0,0 +1,236 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+)
+
+var (
+	_ datasource.DataSource              = &TenantCapacityDataSource{}
+	_ datasource.DataSourceWithConfigure = &TenantCapacityDataSource{}
+)
+
+func NewTenantCapacityDataSource() datasource.DataSource {
+	return &TenantCapacityDataSource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_tenant_capacity",
+	}
+}
+
+type TenantCapacityDataSource struct {
+	CapacityClient   CapacityClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+func (d *TenantCapacityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + d.TypeName
+}
+
+func (d *TenantCapacityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Fetches the capacity entitlements and consumption of a tenant and the storage used by each of its environments",
+		MarkdownDescription: "Fetches the capacity entitlements and consumption of a tenant and the Dataverse database, file and log storage used by each of its environments. See [Dataverse capacity-based storage details](https://learn.microsoft.com/power-platform/admin/capacity-storage) for more information.",
+		Attributes: map[string]schema.Attribute{
+			"tenant_id": schema.StringAttribute{
+				Description:         "Id of the tenant (guid)",
+				MarkdownDescription: "Id of the tenant (guid)",
+				Required:            true,
+			},
+			"license_model_type": schema.StringAttribute{
+				Description:         "License model of the tenant (StorageDriven, etc.)",
+				MarkdownDescription: "License model of the tenant (StorageDriven, etc.)",
+				Computed:            true,
+			},
+			"tenant_capacities": schema.ListNestedAttribute{
+				Description:         "Capacity entitlements and consumption of the tenant by capacity type",
+				MarkdownDescription: "Capacity entitlements and consumption of the tenant by capacity type",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"capacity_type": schema.StringAttribute{
+							Description:         "Type of the capacity (Database, File, Log, etc.)",
+							MarkdownDescription: "Type of the capacity (Database, File, Log, etc.)",
+							Computed:            true,
+						},
+						"capacity_units": schema.StringAttribute{
+							Description:         "Unit of the capacity values (MB, etc.)",
+							MarkdownDescription: "Unit of the capacity values (MB, etc.)",
+							Computed:            true,
+						},
+						"total_capacity": schema.Float64Attribute{
+							Description:         "Total capacity the tenant is entitled to",
+							MarkdownDescription: "Total capacity the tenant is entitled to",
+							Computed:            true,
+						},
+						"max_capacity": schema.Float64Attribute{
+							Description:         "Maximum capacity of the tenant",
+							MarkdownDescription: "Maximum capacity of the tenant",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							Description:         "Status of the capacity (Available, Overflow, etc.)",
+							MarkdownDescription: "Status of the capacity (Available, Overflow, etc.)",
+							Computed:            true,
+						},
+						"consumption": schema.SingleNestedAttribute{
+							Description:         "Consumption of the capacity",
+							MarkdownDescription: "Consumption of the capacity",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"actual": schema.Float64Attribute{
+									Description:         "Actual consumption",
+									MarkdownDescription: "Actual consumption",
+									Computed:            true,
+								},
+								"rated": schema.Float64Attribute{
+									Description:         "Rated consumption, i.e. the consumption counted against the entitlement",
+									MarkdownDescription: "Rated consumption, i.e. the consumption counted against the entitlement",
+									Computed:            true,
+								},
+								"actual_updated_on": schema.StringAttribute{
+									Description:         "Time when the actual consumption was last updated",
+									MarkdownDescription: "Time when the actual consumption was last updated",
+									Computed:            true,
+								},
+								"rated_updated_on": schema.StringAttribute{
+									Description:         "Time when the rated consumption was last updated",
+									MarkdownDescription: "Time when the rated consumption was last updated",
+									Computed:            true,
+								},
+							},
+						},
+						"overflow_capacity": schema.ListNestedAttribute{
+							Description:         "Capacity of other types used to cover the consumption exceeding this capacity",
+							MarkdownDescription: "Capacity of other types used to cover the consumption exceeding this capacity",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"capacity_type": schema.StringAttribute{
+										Description:         "Type of the overflow capacity",
+										MarkdownDescription: "Type of the overflow capacity",
+										Computed:            true,
+									},
+									"value": schema.Float64Attribute{
+										Description:         "Overflow capacity used",
+										MarkdownDescription: "Overflow capacity used",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"environments": schema.ListNestedAttribute{
+				Description:         "Storage used by each environment of the tenant",
+				MarkdownDescription: "Storage used by each environment of the tenant",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "Unique environment id (guid)",
+							MarkdownDescription: "Unique environment id (guid)",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							Description:         "Display name of the environment",
+							MarkdownDescription: "Display name of the environment",
+							Computed:            true,
+						},
+						"environment_type": schema.StringAttribute{
+							Description:         "Type of the environment (Sandbox, Production etc.)",
+							MarkdownDescription: "Type of the environment (Sandbox, Production etc.)",
+							Computed:            true,
+						},
+						"database_used_mb": schema.Float64Attribute{
+							Description:         "Actual Dataverse database storage used by the environment in MB",
+							MarkdownDescription: "Actual Dataverse database storage used by the environment in MB",
+							Computed:            true,
+						},
+						"file_used_mb": schema.Float64Attribute{
+							Description:         "Actual Dataverse file storage used by the environment in MB",
+							MarkdownDescription: "Actual Dataverse file storage used by the environment in MB",
+							Computed:            true,
+						},
+						"log_used_mb": schema.Float64Attribute{
+							Description:         "Actual Dataverse log storage used by the environment in MB",
+							MarkdownDescription: "Actual Dataverse log storage used by the environment in MB",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TenantCapacityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client := req.ProviderData.(*api.ProviderClient).Api
+
+	if client == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.CapacityClient = NewCapacityClient(client)
+}
+
+func (d *TenantCapacityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state TenantCapacityDataSourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE TENANT CAPACITY START: %s_%s", d.ProviderTypeName, d.TypeName))
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tenantCapacity, err := d.CapacityClient.GetTenantCapacity(ctx, state.TenantId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s_%s", d.ProviderTypeName, d.TypeName), err.Error())
+		return
+	}
+
+	environments, err := d.CapacityClient.GetEnvironmentsCapacity(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s_%s", d.ProviderTypeName, d.TypeName), err.Error())
+		return
+	}
+
+	state.LicenseModelType = types.StringValue(tenantCapacity.LicenseModelType)
+	state.TenantCapacities = []TenantCapacityDetailsModel{}
+	for _, capacity := range tenantCapacity.TenantCapacities {
+		state.TenantCapacities = append(state.TenantCapacities, ConvertFromTenantCapacityDetailsDto(capacity))
+	}
+	state.Environments = []EnvironmentCapacityDataSourceModel{}
+	for _, environment := range environments {
+		state.Environments = append(state.Environments, ConvertFromEnvironmentCapacityDto(environment))
+	}
+
+	diags := resp.State.Set(ctx, &state)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE TENANT CAPACITY END: %s_%s", d.ProviderTypeName, d.TypeName))
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TenantCapacityDto struct {
+	TenantId         string                     `json:"tenantId"`
+	LicenseModelType string                     `json:"licenseModelType"`
+	TenantCapacities []TenantCapacityDetailsDto `json:"tenantCapacities"`
+}
+
+type TenantCapacityDetailsDto struct {
+	CapacityType     string                        `json:"capacityType"`
+	CapacityUnits    string                        `json:"capacityUnits"`
+	TotalCapacity    float64                       `json:"totalCapacity"`
+	MaxCapacity      float64                       `json:"maxCapacity"`
+	Consumption      *TenantCapacityConsumptionDto `json:"consumption,omitempty"`
+	Status           string                        `json:"status"`
+	OverflowCapacity []TenantCapacityOverflowDto   `json:"overflowCapacity"`
+}
+
+type TenantCapacityConsumptionDto struct {
+	Actual          float64 `json:"actual"`
+	Rated           float64 `json:"rated"`
+	ActualUpdatedOn string  `json:"actualUpdatedOn"`
+	RatedUpdatedOn  string  `json:"ratedUpdatedOn"`
+}
+
+type TenantCapacityOverflowDto struct {
+	CapacityType string  `json:"capacityType"`
+	Value        float64 `json:"value"`
+}
+
+type EnvironmentCapacityArrayDto struct {
+	Value []EnvironmentCapacityDto `json:"value"`
+}
+
+type EnvironmentCapacityDto struct {
+	Name       string                           `json:"name"`
+	Properties EnvironmentCapacityPropertiesDto `json:"properties"`
+}
+
+type EnvironmentCapacityPropertiesDto struct {
+	DisplayName    string                              `json:"displayName"`
+	EnvironmentSku string                              `json:"environmentSku"`
+	Capacity       []EnvironmentCapacityConsumptionDto `json:"capacity"`
+}
+
+type EnvironmentCapacityConsumptionDto struct {
+	CapacityType      string  `json:"capacityType"`
+	ActualConsumption float64 `json:"actualConsumption"`
+	RatedConsumption  float64 `json:"ratedConsumption"`
+	CapacityUnit      string  `json:"capacityUnit"`
+	UpdatedOn         string  `json:"updatedOn"`
+}
+
+type TenantCapacityDataSourceModel struct {
+	TenantId         types.String                         `tfsdk:"tenant_id"`
+	LicenseModelType types.String                         `tfsdk:"license_model_type"`
+	TenantCapacities []TenantCapacityDetailsModel         `tfsdk:"tenant_capacities"`
+	Environments     []EnvironmentCapacityDataSourceModel `tfsdk:"environments"`
+}
+
+type TenantCapacityDetailsModel struct {
+	CapacityType     types.String                   `tfsdk:"capacity_type"`
+	CapacityUnits    types.String                   `tfsdk:"capacity_units"`
+	TotalCapacity    types.Float64                  `tfsdk:"total_capacity"`
+	MaxCapacity      types.Float64                  `tfsdk:"max_capacity"`
+	Status           types.String                   `tfsdk:"status"`
+	Consumption      TenantCapacityConsumptionModel `tfsdk:"consumption"`
+	OverflowCapacity []TenantCapacityOverflowModel  `tfsdk:"overflow_capacity"`
+}
+
+type TenantCapacityConsumptionModel struct {
+	Actual          types.Float64 `tfsdk:"actual"`
+	Rated           types.Float64 `tfsdk:"rated"`
+	ActualUpdatedOn types.String  `tfsdk:"actual_updated_on"`
+	RatedUpdatedOn  types.String  `tfsdk:"rated_updated_on"`
+}
+
+type TenantCapacityOverflowModel struct {
+	CapacityType types.String  `tfsdk:"capacity_type"`
+	Value        types.Float64 `tfsdk:"value"`
+}
+
+type EnvironmentCapacityDataSourceModel struct {
+	Id              types.String  `tfsdk:"id"`
+	DisplayName     types.String  `tfsdk:"display_name"`
+	EnvironmentType types.String  `tfsdk:"environment_type"`
+	DatabaseUsedMb  types.Float64 `tfsdk:"database_used_mb"`
+	FileUsedMb      types.Float64 `tfsdk:"file_used_mb"`
+	LogUsedMb       types.Float64 `tfsdk:"log_used_mb"`
+}
+
+func ConvertFromTenantCapacityDetailsDto(capacity TenantCapacityDetailsDto) TenantCapacityDetailsModel {
+	model := TenantCapacityDetailsModel{
+		CapacityType:     types.StringValue(capacity.CapacityType),
+		CapacityUnits:    types.StringValue(capacity.CapacityUnits),
+		TotalCapacity:    types.Float64Value(capacity.TotalCapacity),
+		MaxCapacity:      types.Float64Value(capacity.MaxCapacity),
+		Status:           types.StringValue(capacity.Status),
+		OverflowCapacity: []TenantCapacityOverflowModel{},
+		Consumption: TenantCapacityConsumptionModel{
+			Actual:          types.Float64Null(),
+			Rated:           types.Float64Null(),
+			ActualUpdatedOn: types.StringNull(),
+			RatedUpdatedOn:  types.StringNull(),
+		},
+	}
+
+	if capacity.Consumption != nil {
+		model.Consumption = TenantCapacityConsumptionModel{
+			Actual:          types.Float64Value(capacity.Consumption.Actual),
+			Rated:           types.Float64Value(capacity.Consumption.Rated),
+			ActualUpdatedOn: types.StringValue(capacity.Consumption.ActualUpdatedOn),
+			RatedUpdatedOn:  types.StringValue(capacity.Consumption.RatedUpdatedOn),
+		}
+	}
+
+	for _, overflow := range capacity.OverflowCapacity {
+		model.OverflowCapacity = append(model.OverflowCapacity, TenantCapacityOverflowModel{
+			CapacityType: types.StringValue(overflow.CapacityType),
+			Value:        types.Float64Value(overflow.Value),
+		})
+	}
+	return model
+}
+
+func ConvertFromEnvironmentCapacityDto(environment EnvironmentCapacityDto) EnvironmentCapacityDataSourceModel {
+	model := EnvironmentCapacityDataSourceModel{
+		Id:              types.StringValue(environment.Name),
+		DisplayName:     types.StringValue(environment.Properties.DisplayName),
+		EnvironmentType: types.StringValue(environment.Properties.EnvironmentSku),
+		DatabaseUsedMb:  types.Float64Null(),
+		FileUsedMb:      types.Float64Null(),
+		LogUsedMb:       types.Float64Null(),
+	}
+
+	for _, capacity := range environment.Properties.Capacity {
+		value := types.Float64Value(convertToMegabytes(capacity.ActualConsumption, capacity.CapacityUnit))
+		switch capacity.CapacityType {
+		case "Database":
+			model.DatabaseUsedMb = value
+		case "File":
+			model.FileUsedMb = value
+		case "Log":
+			model.LogUsedMb = value
+		}
+	}
+	return model
+}
+
+// convertToMegabytes converts a consumption reported in the given unit to MB, consumption is reported in MB unless stated otherwise.
+func convertToMegabytes(value float64, unit string) float64 {
+	switch strings.ToUpper(unit) {
+	case "GB":
+		return value * 1024
+	case "TB":
+		return value * 1024 * 1024
+	default:
+		return value
+	}
+}
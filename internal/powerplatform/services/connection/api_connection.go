@@ -0,0 +1,180 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+const CONNECTION_API_VERSION = "2016-11-01"
+
+func NewConnectionClient(api *api.ApiClient) ConnectionClient {
+	return ConnectionClient{
+		Api: api,
+	}
+}
+
+type ConnectionClient struct {
+	Api *api.ApiClient
+}
+
+func (client *ConnectionClient) GetConnections(ctx context.Context, environmentId string) ([]ConnectionDto, error) {
+	apiUrl := client.buildConnectionUrl(environmentId, "/providers/Microsoft.PowerApps/connections")
+
+	connectionArray := ConnectionDtoArray{}
+	_, err := client.Api.Execute(ctx, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &connectionArray)
+	if err != nil {
+		return nil, err
+	}
+	return connectionArray.Value, nil
+}
+
+func (client *ConnectionClient) GetConnection(ctx context.Context, environmentId, connectorId, connectionName string) (*ConnectionDto, error) {
+	apiUrl := client.buildConnectionUrl(environmentId, fmt.Sprintf("/providers/Microsoft.PowerApps/apis/%s/connections/%s", ConnectorName(connectorId), connectionName))
+
+	connection := ConnectionDto{}
+	_, err := client.Api.Execute(ctx, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &connection)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil, powerplatform_helpers.WrapIntoProviderError(err, powerplatform_helpers.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("connection %s not found in %s", connectionName, environmentId))
+		}
+		return nil, err
+	}
+	return &connection, nil
+}
+
+func (client *ConnectionClient) CreateConnection(ctx context.Context, environmentId, connectorId string, connectionToCreate ConnectionDto) (*ConnectionDto, error) {
+	//connection names are generated by the client, the portal uses a guid without dashes
+	connectionName := strings.ReplaceAll(uuid.New().String(), "-", "")
+	return client.putConnection(ctx, environmentId, connectorId, connectionName, connectionToCreate, []int{http.StatusOK, http.StatusCreated})
+}
+
+func (client *ConnectionClient) UpdateConnection(ctx context.Context, environmentId, connectorId, connectionName string, connectionToUpdate ConnectionDto) (*ConnectionDto, error) {
+	return client.putConnection(ctx, environmentId, connectorId, connectionName, connectionToUpdate, []int{http.StatusOK})
+}
+
+func (client *ConnectionClient) DeleteConnection(ctx context.Context, environmentId, connectorId, connectionName string) error {
+	apiUrl := client.buildConnectionUrl(environmentId, fmt.Sprintf("/providers/Microsoft.PowerApps/apis/%s/connections/%s", ConnectorName(connectorId), connectionName))
+
+	_, err := client.Api.Execute(ctx, "DELETE", apiUrl, nil, nil, []int{http.StatusOK, http.StatusNoContent}, nil)
+	if err != nil && !strings.Contains(err.Error(), "404") {
+		return err
+	}
+	return nil
+}
+
+func (client *ConnectionClient) GetConnectionPermissions(ctx context.Context, environmentId, connectorId, connectionName string) ([]ConnectionPermissionDto, error) {
+	apiUrl := client.buildConnectionUrl(environmentId, fmt.Sprintf("/providers/Microsoft.PowerApps/apis/%s/connections/%s/permissions", ConnectorName(connectorId), connectionName))
+
+	permissionArray := ConnectionPermissionDtoArray{}
+	_, err := client.Api.Execute(ctx, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &permissionArray)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil, powerplatform_helpers.WrapIntoProviderError(err, powerplatform_helpers.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("connection %s not found in %s", connectionName, environmentId))
+		}
+		return nil, err
+	}
+	return permissionArray.Value, nil
+}
+
+// GetConnectionPermission returns the permission a principal has been granted on a connection.
+func (client *ConnectionClient) GetConnectionPermission(ctx context.Context, environmentId, connectorId, connectionName, principalId string) (*ConnectionPermissionDto, error) {
+	permissions, err := client.GetConnectionPermissions(ctx, environmentId, connectorId, connectionName)
+	if err != nil {
+		return nil, err
+	}
+	for _, permission := range permissions {
+		if strings.EqualFold(permission.Properties.Principal.Id, principalId) {
+			return &permission, nil
+		}
+	}
+	return nil, powerplatform_helpers.NewProviderError(powerplatform_helpers.ERROR_OBJECT_NOT_FOUND, "permission for principal %s not found on connection %s", principalId, connectionName)
+}
+
+// ShareConnection grants a principal a role on a connection. Sharing with a principal that already has a role replaces that role.
+func (client *ConnectionClient) ShareConnection(ctx context.Context, environmentId, connectorId, connectionName, roleName string, principal ConnectionPrincipalDto) (*ConnectionPermissionDto, error) {
+	share := ConnectionModifyPermissionsDto{
+		Put: []ConnectionModifyPermissionsPutDto{
+			{
+				Properties: ConnectionModifyPermissionsPropertiesDto{
+					RoleName:                roleName,
+					Principal:               principal,
+					NotifyShareTargetOption: "Notify",
+				},
+			},
+		},
+		Delete: []ConnectionModifyPermissionsDeleteDto{},
+	}
+	err := client.modifyConnectionPermissions(ctx, environmentId, connectorId, connectionName, share)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetConnectionPermission(ctx, environmentId, connectorId, connectionName, principal.Id)
+}
+
+func (client *ConnectionClient) UnshareConnection(ctx context.Context, environmentId, connectorId, connectionName, permissionId string) error {
+	unshare := ConnectionModifyPermissionsDto{
+		Put: []ConnectionModifyPermissionsPutDto{},
+		Delete: []ConnectionModifyPermissionsDeleteDto{
+			{
+				Id: permissionId,
+			},
+		},
+	}
+	err := client.modifyConnectionPermissions(ctx, environmentId, connectorId, connectionName, unshare)
+	if err != nil && !strings.Contains(err.Error(), "404") {
+		return err
+	}
+	return nil
+}
+
+func (client *ConnectionClient) modifyConnectionPermissions(ctx context.Context, environmentId, connectorId, connectionName string, permissions ConnectionModifyPermissionsDto) error {
+	apiUrl := client.buildConnectionUrl(environmentId, fmt.Sprintf("/providers/Microsoft.PowerApps/apis/%s/connections/%s/modifyPermissions", ConnectorName(connectorId), connectionName))
+
+	_, err := client.Api.Execute(ctx, "POST", apiUrl, nil, permissions, []int{http.StatusOK}, nil)
+	return err
+}
+
+func (client *ConnectionClient) putConnection(ctx context.Context, environmentId, connectorId, connectionName string, connectionToPut ConnectionDto, expectedStatusCodes []int) (*ConnectionDto, error) {
+	apiUrl := client.buildConnectionUrl(environmentId, fmt.Sprintf("/providers/Microsoft.PowerApps/apis/%s/connections/%s", ConnectorName(connectorId), connectionName))
+
+	connectionToPut.Properties.Environment = ConnectionEnvironmentDto{
+		Id:   fmt.Sprintf("/providers/Microsoft.PowerApps/environments/%s", environmentId),
+		Name: environmentId,
+	}
+
+	connection := ConnectionDto{}
+	_, err := client.Api.Execute(ctx, "PUT", apiUrl, nil, connectionToPut, expectedStatusCodes, &connection)
+	if err != nil {
+		return nil, err
+	}
+	return &connection, nil
+}
+
+func (client *ConnectionClient) buildConnectionUrl(environmentId, apiPath string) string {
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   client.Api.GetConfig().Urls.PowerAppsUrl,
+		Path:   apiPath,
+	}
+	values := url.Values{}
+	values.Add("api-version", CONNECTION_API_VERSION)
+	values.Add("$filter", fmt.Sprintf("environment eq '%s'", environmentId))
+	apiUrl.RawQuery = values.Encode()
+	return apiUrl.String()
+}
+
+// ConnectorName returns the name of a connector from its id, e.g. shared_sql for /providers/Microsoft.PowerApps/apis/shared_sql.
+func ConnectorName(connectorId string) string {
+	return path.Base(connectorId)
+}
@@ -0,0 +1,162 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+)
+
+var (
+	_ datasource.DataSource              = &ConnectionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ConnectionsDataSource{}
+)
+
+func NewConnectionsDataSource() datasource.DataSource {
+	return &ConnectionsDataSource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_connections",
+	}
+}
+
+type ConnectionsDataSource struct {
+	ConnectionClient ConnectionClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+type ConnectionsListDataSourceModel struct {
+	Id            types.String                 `tfsdk:"id"`
+	EnvironmentId types.String                 `tfsdk:"environment_id"`
+	Connections   []ConnectionsDataSourceModel `tfsdk:"connections"`
+}
+
+type ConnectionsDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	ConnectorId types.String `tfsdk:"connector_id"`
+	Status      types.String `tfsdk:"status"`
+	CreatedTime types.String `tfsdk:"created_time"`
+}
+
+func ConvertFromConnectionDto(connectionDto ConnectionDto) ConnectionsDataSourceModel {
+	return ConnectionsDataSourceModel{
+		Id:          types.StringValue(connectionDto.Name),
+		DisplayName: types.StringValue(connectionDto.Properties.DisplayName),
+		ConnectorId: types.StringValue(connectionDto.Properties.ApiId),
+		Status:      types.StringValue(connectionDto.Status()),
+		CreatedTime: types.StringValue(connectionDto.Properties.CreatedTime),
+	}
+}
+
+func (d *ConnectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + d.TypeName
+}
+
+func (d *ConnectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Fetches the list of connections in a Power Platform environment",
+		MarkdownDescription: "Fetches the list of [connections](https://learn.microsoft.com/power-apps/maker/canvas-apps/add-manage-connections) in a Power Platform environment together with their status. Connections with a status other than `Connected` have to be fixed in the maker portal before they can be used.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment to list the connections of",
+				Description:         "Id of the environment to list the connections of",
+				Required:            true,
+			},
+			"connections": schema.ListNestedAttribute{
+				Description:         "List of connections",
+				MarkdownDescription: "List of connections",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique name of the connection",
+							Description:         "Unique name of the connection",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "Display name",
+							Description:         "Display name",
+							Computed:            true,
+						},
+						"connector_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the connector of the connection",
+							Description:         "Id of the connector of the connection",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the connection, for example `Connected` or `Error`",
+							Description:         "Status of the connection, for example `Connected` or `Error`",
+							Computed:            true,
+						},
+						"created_time": schema.StringAttribute{
+							MarkdownDescription: "Time the connection was created",
+							Description:         "Time the connection was created",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ConnectionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client := req.ProviderData.(*api.ProviderClient).Api
+
+	if client == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.ConnectionClient = NewConnectionClient(client)
+}
+
+func (d *ConnectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ConnectionsListDataSourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE CONNECTIONS START: %s", d.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connections, err := d.ConnectionClient.GetConnections(ctx, state.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.ProviderTypeName), err.Error())
+		return
+	}
+
+	state.Connections = []ConnectionsDataSourceModel{}
+	for _, connection := range connections {
+		state.Connections = append(state.Connections, ConvertFromConnectionDto(connection))
+	}
+	state.Id = types.StringValue(state.EnvironmentId.ValueString())
+
+	diags := resp.State.Set(ctx, &state)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE CONNECTIONS END: %s", d.ProviderTypeName))
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
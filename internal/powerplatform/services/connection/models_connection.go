@@ -0,0 +1,109 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+const (
+	CONNECTION_ROLE_CAN_VIEW = "CanView"
+	CONNECTION_ROLE_CAN_EDIT = "CanEdit"
+
+	CONNECTION_PRINCIPAL_TYPE_USER              = "User"
+	CONNECTION_PRINCIPAL_TYPE_GROUP             = "Group"
+	CONNECTION_PRINCIPAL_TYPE_SERVICE_PRINCIPAL = "ServicePrincipal"
+)
+
+type ConnectionDtoArray struct {
+	Value []ConnectionDto `json:"value"`
+}
+
+type ConnectionDto struct {
+	Name       string                  `json:"name,omitempty"`
+	Id         string                  `json:"id,omitempty"`
+	Type       string                  `json:"type,omitempty"`
+	Properties ConnectionPropertiesDto `json:"properties"`
+}
+
+type ConnectionPropertiesDto struct {
+	ApiId                   string                      `json:"apiId,omitempty"`
+	DisplayName             string                      `json:"displayName"`
+	Environment             ConnectionEnvironmentDto    `json:"environment"`
+	ConnectionParameters    map[string]interface{}      `json:"connectionParameters,omitempty"`
+	ConnectionParametersSet *ConnectionParametersSetDto `json:"connectionParametersSet,omitempty"`
+	Statuses                []ConnectionStatusDto       `json:"statuses,omitempty"`
+	CreatedBy               *ConnectionPrincipalDto     `json:"createdBy,omitempty"`
+	CreatedTime             string                      `json:"createdTime,omitempty"`
+	LastModifiedTime        string                      `json:"lastModifiedTime,omitempty"`
+}
+
+type ConnectionEnvironmentDto struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type ConnectionParametersSetDto struct {
+	Name   string                 `json:"name"`
+	Values map[string]interface{} `json:"values"`
+}
+
+type ConnectionStatusDto struct {
+	Status string                    `json:"status"`
+	Target string                    `json:"target,omitempty"`
+	Error  *ConnectionStatusErrorDto `json:"error,omitempty"`
+}
+
+type ConnectionStatusErrorDto struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type ConnectionPrincipalDto struct {
+	Id       string `json:"id"`
+	Type     string `json:"type"`
+	TenantId string `json:"tenantId,omitempty"`
+}
+
+type ConnectionPermissionDtoArray struct {
+	Value []ConnectionPermissionDto `json:"value"`
+}
+
+type ConnectionPermissionDto struct {
+	Name       string                            `json:"name"`
+	Id         string                            `json:"id"`
+	Type       string                            `json:"type"`
+	Properties ConnectionPermissionPropertiesDto `json:"properties"`
+}
+
+type ConnectionPermissionPropertiesDto struct {
+	RoleName  string                 `json:"roleName"`
+	Principal ConnectionPrincipalDto `json:"principal"`
+}
+
+type ConnectionModifyPermissionsDto struct {
+	Put    []ConnectionModifyPermissionsPutDto    `json:"put"`
+	Delete []ConnectionModifyPermissionsDeleteDto `json:"delete"`
+}
+
+type ConnectionModifyPermissionsPutDto struct {
+	Properties ConnectionModifyPermissionsPropertiesDto `json:"properties"`
+}
+
+type ConnectionModifyPermissionsPropertiesDto struct {
+	RoleName                string                 `json:"roleName"`
+	Principal               ConnectionPrincipalDto `json:"principal"`
+	NotifyShareTargetOption string                 `json:"NotifyShareTargetOption"`
+}
+
+type ConnectionModifyPermissionsDeleteDto struct {
+	Id string `json:"id"`
+}
+
+// Status returns the overall status of the connection, a status other than Connected takes precedence.
+func (connection *ConnectionDto) Status() string {
+	status := ""
+	for _, s := range connection.Properties.Statuses {
+		if status == "" || s.Status != "Connected" {
+			status = s.Status
+		}
+	}
+	return status
+}
@@ -0,0 +1,303 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+var _ resource.Resource = &ConnectionResource{}
+var _ resource.ResourceWithImportState = &ConnectionResource{}
+
+func NewConnectionResource() resource.Resource {
+	return &ConnectionResource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_connection",
+	}
+}
+
+type ConnectionResource struct {
+	ConnectionClient ConnectionClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+type ConnectionResourceModel struct {
+	Id                      types.String `tfsdk:"id"`
+	EnvironmentId           types.String `tfsdk:"environment_id"`
+	ConnectorId             types.String `tfsdk:"connector_id"`
+	DisplayName             types.String `tfsdk:"display_name"`
+	ConnectionParameters    types.String `tfsdk:"connection_parameters"`
+	ConnectionParametersSet types.String `tfsdk:"connection_parameters_set"`
+	Status                  types.String `tfsdk:"status"`
+}
+
+func (r *ConnectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.TypeName
+}
+
+func (r *ConnectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Resource for managing connections in a Power Platform environment",
+		MarkdownDescription: "Resource for managing [connections](https://learn.microsoft.com/power-apps/maker/canvas-apps/add-manage-connections) in a Power Platform environment. Connections have to exist before a solution that contains connection references using them can be imported.\n\nConnections that use OAuth with a signed-in user are created without credentials and have to be authorized in the maker portal before they can be used, their `status` will be `Error` until then. Connectors that support [service principal authentication](https://learn.microsoft.com/connectors/custom-connectors/azure-active-directory-authentication#service-principal-authentication) can be fully configured with `connection_parameters_set`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique name of the connection",
+				Description:         "Unique name of the connection",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment the connection is created in",
+				Description:         "Id of the environment the connection is created in",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"connector_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the connector the connection is created for, for example `/providers/Microsoft.PowerApps/apis/shared_sql`",
+				Description:         "Id of the connector the connection is created for, for example `/providers/Microsoft.PowerApps/apis/shared_sql`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/providers/Microsoft\.PowerApps/apis/[^/]+$`), "connector_id must have the format /providers/Microsoft.PowerApps/apis/<connector_name>"),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the connection",
+				Description:         "Display name of the connection",
+				Required:            true,
+			},
+			"connection_parameters": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded connection parameters of the connection, for example `jsonencode({ server = \"contoso.database.windows.net\", database = \"orders\" })`. The parameters depend on the connector",
+				Description:         "JSON encoded connection parameters of the connection. The parameters depend on the connector",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"connection_parameters_set": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded connection parameter set of the connection. Used by connectors that support multiple authentication types, for example `jsonencode({ name = \"oauthSP\", values = { \"token:clientId\" = { value = \"...\" }, \"token:clientSecret\" = { value = \"...\" }, \"token:TenantId\" = { value = \"...\" } } })` for a service principal connection",
+				Description:         "JSON encoded connection parameter set of the connection. Used by connectors that support multiple authentication types, for example service principal connections",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the connection, for example `Connected` or `Error`",
+				Description:         "Status of the connection, for example `Connected` or `Error`",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ConnectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientApi := req.ProviderData.(*api.ProviderClient).Api
+
+	if clientApi == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.ConnectionClient = NewConnectionClient(clientApi)
+}
+
+func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ConnectionResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectionToCreate := convertToConnectionDto(plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection, err := r.ConnectionClient.CreateConnection(ctx, plan.EnvironmentId.ValueString(), plan.ConnectorId.ValueString(), *connectionToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.ProviderTypeName), err.Error())
+		return
+	}
+
+	convertFromConnectionDto(plan, connection)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *ConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ConnectionResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection, err := r.ConnectionClient.GetConnection(ctx, state.EnvironmentId.ValueString(), state.ConnectorId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		if powerplatform_helpers.Code(err) == powerplatform_helpers.ERROR_OBJECT_NOT_FOUND {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.ProviderTypeName), err.Error())
+		return
+	}
+
+	convertFromConnectionDto(state, connection)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *ConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *ConnectionResourceModel
+	var state *ConnectionResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectionToUpdate := convertToConnectionDto(plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection, err := r.ConnectionClient.UpdateConnection(ctx, plan.EnvironmentId.ValueString(), plan.ConnectorId.ValueString(), state.Id.ValueString(), *connectionToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.ProviderTypeName), err.Error())
+		return
+	}
+
+	convertFromConnectionDto(plan, connection)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *ConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *ConnectionResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.ConnectionClient.DeleteConnection(ctx, state.EnvironmentId.ValueString(), state.ConnectorId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *ConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	//import id has the format <environment_id>_<connection_name>, the connector is looked up from the connections of the environment
+	parts := strings.Split(req.ID, "_")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected import identifier", fmt.Sprintf("Expected import identifier with format: <environment_id>_<connection_name>. Got: %q", req.ID))
+		return
+	}
+
+	connections, err := r.ConnectionClient.GetConnections(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when importing %s", r.ProviderTypeName), err.Error())
+		return
+	}
+
+	for _, connection := range connections {
+		if connection.Name == parts[1] {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), connection.Name)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), parts[0])...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connector_id"), connection.Properties.ApiId)...)
+			return
+		}
+	}
+	resp.Diagnostics.AddError("Connection not found", fmt.Sprintf("Connection %s not found in environment %s", parts[1], parts[0]))
+}
+
+func convertToConnectionDto(model *ConnectionResourceModel, diags *diag.Diagnostics) *ConnectionDto {
+	connection := ConnectionDto{
+		Properties: ConnectionPropertiesDto{
+			DisplayName: model.DisplayName.ValueString(),
+		},
+	}
+
+	if !model.ConnectionParameters.IsNull() && model.ConnectionParameters.ValueString() != "" {
+		err := json.Unmarshal([]byte(model.ConnectionParameters.ValueString()), &connection.Properties.ConnectionParameters)
+		if err != nil {
+			diags.AddAttributeError(path.Root("connection_parameters"), "Invalid connection parameters", fmt.Sprintf("connection_parameters has to be a JSON encoded object: %s", err.Error()))
+			return nil
+		}
+	}
+
+	if !model.ConnectionParametersSet.IsNull() && model.ConnectionParametersSet.ValueString() != "" {
+		parametersSet := ConnectionParametersSetDto{}
+		err := json.Unmarshal([]byte(model.ConnectionParametersSet.ValueString()), &parametersSet)
+		if err != nil {
+			diags.AddAttributeError(path.Root("connection_parameters_set"), "Invalid connection parameters set", fmt.Sprintf("connection_parameters_set has to be a JSON encoded object with a name and values: %s", err.Error()))
+			return nil
+		}
+		connection.Properties.ConnectionParametersSet = &parametersSet
+	}
+
+	return &connection
+}
+
+func convertFromConnectionDto(model *ConnectionResourceModel, connection *ConnectionDto) {
+	//connection parameters are write only, the api does not return secret values so they are kept as configured
+	model.Id = types.StringValue(connection.Name)
+	model.DisplayName = types.StringValue(connection.Properties.DisplayName)
+	if connection.Properties.ApiId != "" {
+		model.ConnectorId = types.StringValue(connection.Properties.ApiId)
+	}
+	model.Status = types.StringValue(connection.Status())
+}
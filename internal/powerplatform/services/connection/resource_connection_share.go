@@ -0,0 +1,260 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+var _ resource.Resource = &ConnectionShareResource{}
+
+func NewConnectionShareResource() resource.Resource {
+	return &ConnectionShareResource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_connection_share",
+	}
+}
+
+type ConnectionShareResource struct {
+	ConnectionClient ConnectionClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+type ConnectionShareResourceModel struct {
+	Id            types.String                  `tfsdk:"id"`
+	EnvironmentId types.String                  `tfsdk:"environment_id"`
+	ConnectorId   types.String                  `tfsdk:"connector_id"`
+	ConnectionId  types.String                  `tfsdk:"connection_id"`
+	RoleName      types.String                  `tfsdk:"role_name"`
+	Principal     ConnectionSharePrincipalModel `tfsdk:"principal"`
+}
+
+type ConnectionSharePrincipalModel struct {
+	ObjectId types.String `tfsdk:"object_id"`
+	Type     types.String `tfsdk:"type"`
+}
+
+func (r *ConnectionShareResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.TypeName
+}
+
+func (r *ConnectionShareResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Resource for sharing a connection with a user, group or service principal",
+		MarkdownDescription: "Resource for [sharing a connection](https://learn.microsoft.com/power-apps/maker/canvas-apps/share-app-resources#connections) with a user, group or service principal. Each share grants one principal either the `CanView` or the `CanEdit` role on the connection.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the connection permission",
+				Description:         "Unique identifier of the connection permission",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment of the connection",
+				Description:         "Id of the environment of the connection",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"connector_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the connector of the connection, for example `/providers/Microsoft.PowerApps/apis/shared_sql`",
+				Description:         "Id of the connector of the connection, for example `/providers/Microsoft.PowerApps/apis/shared_sql`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/providers/Microsoft\.PowerApps/apis/[^/]+$`), "connector_id must have the format /providers/Microsoft.PowerApps/apis/<connector_name>"),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				MarkdownDescription: "Unique name of the connection to share",
+				Description:         "Unique name of the connection to share",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_name": schema.StringAttribute{
+				MarkdownDescription: "Role granted to the principal, either `CanView` or `CanEdit`",
+				Description:         "Role granted to the principal, either `CanView` or `CanEdit`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(CONNECTION_ROLE_CAN_VIEW, CONNECTION_ROLE_CAN_EDIT),
+				},
+			},
+			"principal": schema.SingleNestedAttribute{
+				MarkdownDescription: "Principal the connection is shared with",
+				Description:         "Principal the connection is shared with",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"object_id": schema.StringAttribute{
+						MarkdownDescription: "Entra ID object id of the principal",
+						Description:         "Entra ID object id of the principal",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of the principal, one of `User`, `Group` or `ServicePrincipal`",
+						Description:         "Type of the principal, one of `User`, `Group` or `ServicePrincipal`",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf(CONNECTION_PRINCIPAL_TYPE_USER, CONNECTION_PRINCIPAL_TYPE_GROUP, CONNECTION_PRINCIPAL_TYPE_SERVICE_PRINCIPAL),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ConnectionShareResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientApi := req.ProviderData.(*api.ProviderClient).Api
+
+	if clientApi == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.ConnectionClient = NewConnectionClient(clientApi)
+}
+
+func (r *ConnectionShareResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ConnectionShareResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permission, err := r.ConnectionClient.ShareConnection(ctx, plan.EnvironmentId.ValueString(), plan.ConnectorId.ValueString(), plan.ConnectionId.ValueString(), plan.RoleName.ValueString(), convertToConnectionPrincipalDto(plan.Principal))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when sharing connection %s", plan.ConnectionId.ValueString()), err.Error())
+		return
+	}
+
+	convertFromConnectionPermissionDto(plan, permission)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *ConnectionShareResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ConnectionShareResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permission, err := r.ConnectionClient.GetConnectionPermission(ctx, state.EnvironmentId.ValueString(), state.ConnectorId.ValueString(), state.ConnectionId.ValueString(), state.Principal.ObjectId.ValueString())
+	if err != nil {
+		if powerplatform_helpers.Code(err) == powerplatform_helpers.ERROR_OBJECT_NOT_FOUND {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.ProviderTypeName), err.Error())
+		return
+	}
+
+	convertFromConnectionPermissionDto(state, permission)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *ConnectionShareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *ConnectionShareResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//sharing again with the same principal replaces the role of the existing permission
+	permission, err := r.ConnectionClient.ShareConnection(ctx, plan.EnvironmentId.ValueString(), plan.ConnectorId.ValueString(), plan.ConnectionId.ValueString(), plan.RoleName.ValueString(), convertToConnectionPrincipalDto(plan.Principal))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.ProviderTypeName), err.Error())
+		return
+	}
+
+	convertFromConnectionPermissionDto(plan, permission)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *ConnectionShareResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *ConnectionShareResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.ConnectionClient.UnshareConnection(ctx, state.EnvironmentId.ValueString(), state.ConnectorId.ValueString(), state.ConnectionId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func convertToConnectionPrincipalDto(principal ConnectionSharePrincipalModel) ConnectionPrincipalDto {
+	return ConnectionPrincipalDto{
+		Id:   principal.ObjectId.ValueString(),
+		Type: principal.Type.ValueString(),
+	}
+}
+
+func convertFromConnectionPermissionDto(model *ConnectionShareResourceModel, permission *ConnectionPermissionDto) {
+	model.Id = types.StringValue(permission.Id)
+	model.RoleName = types.StringValue(permission.Properties.RoleName)
+}
@@ -5,8 +5,11 @@ package powerplatform
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 
 	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
@@ -23,6 +26,15 @@ type ConnectorsClient struct {
 }
 
 func (client *ConnectorsClient) GetConnectors(ctx context.Context) ([]ConnectorDto, error) {
+	return client.getConnectors(ctx, "~Default")
+}
+
+// GetEnvironmentConnectors returns the connectors available in an environment, including the custom connectors of the environment.
+func (client *ConnectorsClient) GetEnvironmentConnectors(ctx context.Context, environmentId string) ([]ConnectorDto, error) {
+	return client.getConnectors(ctx, environmentId)
+}
+
+func (client *ConnectorsClient) getConnectors(ctx context.Context, environment string) ([]ConnectorDto, error) {
 	apiUrl := &url.URL{
 		Scheme: "https",
 		Host:   client.Api.GetConfig().Urls.PowerAppsUrl,
@@ -33,7 +45,7 @@ func (client *ConnectorsClient) GetConnectors(ctx context.Context) ([]ConnectorD
 	values.Add("showApisWithToS", "true")
 	values.Add("hideDlpExemptApis", "true")
 	values.Add("showAllDlpEnforceableApis", "true")
-	values.Add("$filter", "environment eq '~Default'")
+	values.Add("$filter", fmt.Sprintf("environment eq '%s'", environment))
 	apiUrl.RawQuery = values.Encode()
 
 	connectorArray := ConnectorDtoArray{}
@@ -79,6 +91,7 @@ func (client *ConnectorsClient) GetConnectors(ctx context.Context) ([]ConnectorD
 			Properties: ConnectorPropertiesDto{
 				DisplayName: virutualConnector.Metadata.DisplayName,
 				Unblockable: false,
+				Virtual:     true,
 				Tier:        "Built-in",
 				Publisher:   "Microsoft",
 				Description: "",
@@ -92,4 +105,45 @@ func (client *ConnectorsClient) GetConnectors(ctx context.Context) ([]ConnectorD
 	}
 
 	return connectorArray.Value, nil
+}
+
+// GetConnectorActions returns the operations of a connector, read from its swagger definition.
+func (client *ConnectorsClient) GetConnectorActions(ctx context.Context, environment string, connectorName string) ([]ConnectorActionDto, error) {
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   client.Api.GetConfig().Urls.PowerAppsUrl,
+		Path:   fmt.Sprintf("/providers/Microsoft.PowerApps/apis/%s", connectorName),
+	}
+	values := url.Values{}
+	values.Add("api-version", "2023-06-01")
+	values.Add("$filter", fmt.Sprintf("environment eq '%s'", environment))
+	apiUrl.RawQuery = values.Encode()
+
+	connector := ConnectorWithSwaggerDto{}
+	_, err := client.Api.Execute(ctx, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &connector)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := []ConnectorActionDto{}
+	for _, pathItem := range connector.Properties.Swagger.Paths {
+		for method, operation := range pathItem {
+			if method == "parameters" {
+				continue
+			}
+			action := ConnectorSwaggerOperationDto{}
+			if err := json.Unmarshal(operation, &action); err != nil || action.OperationId == "" {
+				continue
+			}
+			actions = append(actions, ConnectorActionDto{
+				Id:          action.OperationId,
+				DisplayName: action.Summary,
+				Deprecated:  action.Deprecated,
+			})
+		}
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i].Id < actions[j].Id
+	})
+	return actions, nil
 }
\ No newline at end of file
@@ -0,0 +1,140 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+const (
+	CUSTOM_CONNECTOR_API_VERSION  = "2016-11-01"
+	CUSTOM_CONNECTOR_REDIRECT_URL = "https://global.consent.azure-apim.net/redirect"
+)
+
+func (client *ConnectorsClient) GetCustomConnector(ctx context.Context, environmentId, connectorName string) (*CustomConnectorDto, error) {
+	apiUrl := client.buildCustomConnectorUrl(environmentId, fmt.Sprintf("/providers/Microsoft.PowerApps/apis/%s", connectorName))
+
+	connector := CustomConnectorDto{}
+	_, err := client.Api.Execute(ctx, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &connector)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil, powerplatform_helpers.WrapIntoProviderError(err, powerplatform_helpers.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("custom connector %s not found in %s", connectorName, environmentId))
+		}
+		return nil, err
+	}
+	return &connector, nil
+}
+
+func (client *ConnectorsClient) CreateCustomConnector(ctx context.Context, environmentId string, connectorToCreate CustomConnectorDto) (*CustomConnectorDto, error) {
+	apiUrl := client.buildCustomConnectorUrl(environmentId, "/providers/Microsoft.PowerApps/apis")
+
+	connector := CustomConnectorDto{}
+	_, err := client.Api.Execute(ctx, "POST", apiUrl, nil, connectorToCreate, []int{http.StatusOK, http.StatusCreated}, &connector)
+	if err != nil {
+		return nil, err
+	}
+	return &connector, nil
+}
+
+func (client *ConnectorsClient) UpdateCustomConnector(ctx context.Context, environmentId, connectorName string, connectorToUpdate CustomConnectorDto) (*CustomConnectorDto, error) {
+	apiUrl := client.buildCustomConnectorUrl(environmentId, fmt.Sprintf("/providers/Microsoft.PowerApps/apis/%s", connectorName))
+
+	connector := CustomConnectorDto{}
+	_, err := client.Api.Execute(ctx, "PATCH", apiUrl, nil, connectorToUpdate, []int{http.StatusOK}, &connector)
+	if err != nil {
+		return nil, err
+	}
+	return &connector, nil
+}
+
+func (client *ConnectorsClient) DeleteCustomConnector(ctx context.Context, environmentId, connectorName string) error {
+	apiUrl := client.buildCustomConnectorUrl(environmentId, fmt.Sprintf("/providers/Microsoft.PowerApps/apis/%s", connectorName))
+
+	_, err := client.Api.Execute(ctx, "DELETE", apiUrl, nil, nil, []int{http.StatusOK, http.StatusNoContent}, nil)
+	if err != nil && !strings.Contains(err.Error(), "404") {
+		return err
+	}
+	return nil
+}
+
+// UploadCustomConnectorIcon uploads an icon to the resource storage of an environment and returns the uri of the uploaded icon.
+func (client *ConnectorsClient) UploadCustomConnectorIcon(ctx context.Context, environmentId, fileName string, content []byte) (string, error) {
+	apiUrl := client.buildCustomConnectorUrl(environmentId, fmt.Sprintf("/providers/Microsoft.PowerApps/objectIds/%s/generateResourceStorage", uuid.New().String()))
+
+	storageRequestBody := CustomConnectorResourceStorageRequestDto{
+		Properties: CustomConnectorResourceStoragePropertiesDto{
+			Environment: CustomConnectorEnvironmentDto{Name: environmentId},
+		},
+	}
+	storage := CustomConnectorResourceStorageDto{}
+	_, err := client.Api.Execute(ctx, "POST", apiUrl, nil, storageRequestBody, []int{http.StatusOK}, &storage)
+	if err != nil {
+		return "", err
+	}
+
+	storageUrl, err := url.Parse(storage.SharedAccessSignature)
+	if err != nil {
+		return "", err
+	}
+	storageUrl.Path = fmt.Sprintf("%s/%s", strings.TrimSuffix(storageUrl.Path, "/"), filepath.Base(fileName))
+	iconUri := storageUrl.String()
+
+	headers := http.Header{}
+	headers.Set("x-ms-blob-type", "BlockBlob")
+	headers.Set("Content-Type", http.DetectContentType(content))
+
+	//the icon is uploaded to a pre-signed storage url that doesn't accept the provider token
+	_, err = client.Api.ExecuteWithoutToken(ctx, "PUT", iconUri, headers, content, []int{http.StatusCreated}, nil)
+	if err != nil {
+		return "", err
+	}
+	return iconUri, nil
+}
+
+// ReadCustomConnectorSwagger validates an OpenAPI (Swagger 2.0) definition and returns its parsed header information.
+func ReadCustomConnectorSwagger(content []byte) (*CustomConnectorSwaggerDto, error) {
+	swagger := CustomConnectorSwaggerDto{}
+	err := json.Unmarshal(content, &swagger)
+	if err != nil {
+		return nil, fmt.Errorf("swagger file is not a valid json document: %s", err.Error())
+	}
+	if swagger.Swagger != "2.0" {
+		return nil, fmt.Errorf("only OpenAPI (Swagger) 2.0 definitions are supported, got swagger version '%s'", swagger.Swagger)
+	}
+	return &swagger, nil
+}
+
+// BackendServiceUrl returns the url of the backend service described by the definition.
+func (swagger *CustomConnectorSwaggerDto) BackendServiceUrl() string {
+	if swagger.Host == "" {
+		return ""
+	}
+	scheme := "https"
+	if len(swagger.Schemes) > 0 {
+		scheme = swagger.Schemes[0]
+	}
+	return fmt.Sprintf("%s://%s%s", scheme, swagger.Host, strings.TrimSuffix(swagger.BasePath, "/"))
+}
+
+func (client *ConnectorsClient) buildCustomConnectorUrl(environmentId, path string) string {
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   client.Api.GetConfig().Urls.PowerAppsUrl,
+		Path:   path,
+	}
+	values := url.Values{}
+	values.Add("api-version", CUSTOM_CONNECTOR_API_VERSION)
+	values.Add("$filter", fmt.Sprintf("environment eq '%s'", environmentId))
+	apiUrl.RawQuery = values.Encode()
+	return apiUrl.String()
+}
@@ -6,12 +6,20 @@ package powerplatform
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	constants "github.com/microsoft/terraform-provider-power-platform/constants"
 	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
 )
 
@@ -34,32 +42,75 @@ type ConnectorsDataSource struct {
 }
 
 type ConnectorsListDataSourceModel struct {
-	Id         types.String                `tfsdk:"id"`
-	Connectors []ConnectorsDataSourceModel `tfsdk:"connectors"`
+	Id             types.String                `tfsdk:"id"`
+	Tier           types.String                `tfsdk:"tier"`
+	Publisher      types.String                `tfsdk:"publisher"`
+	NamePattern    types.String                `tfsdk:"name_pattern"`
+	EnvironmentId  types.String                `tfsdk:"environment_id"`
+	IncludeCustom  types.Bool                  `tfsdk:"include_custom"`
+	IncludeActions types.Bool                  `tfsdk:"include_actions"`
+	Connectors     []ConnectorsDataSourceModel `tfsdk:"connectors"`
 }
 
 type ConnectorsDataSourceModel struct {
+	Id                         types.String                     `tfsdk:"id"`
+	Name                       types.String                     `tfsdk:"name"`
+	Type                       types.String                     `tfsdk:"type"`
+	Description                types.String                     `tfsdk:"description"`
+	DisplayName                types.String                     `tfsdk:"display_name"`
+	Tier                       types.String                     `tfsdk:"tier"`
+	Publisher                  types.String                     `tfsdk:"publisher"`
+	Unblockable                types.Bool                       `tfsdk:"unblockable"`
+	Custom                     types.Bool                       `tfsdk:"custom"`
+	Virtual                    types.Bool                       `tfsdk:"virtual"`
+	BuiltIn                    types.Bool                       `tfsdk:"built_in"`
+	CertificationStatus        types.String                     `tfsdk:"certification_status"`
+	ReleaseTag                 types.String                     `tfsdk:"release_tag"`
+	EndpointFilteringSupported types.Bool                       `tfsdk:"endpoint_filtering_supported"`
+	Actions                    []ConnectorActionDataSourceModel `tfsdk:"actions"`
+}
+
+type ConnectorActionDataSourceModel struct {
 	Id          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Type        types.String `tfsdk:"type"`
-	Description types.String `tfsdk:"description"`
 	DisplayName types.String `tfsdk:"display_name"`
-	Tier        types.String `tfsdk:"tier"`
-	Publisher   types.String `tfsdk:"publisher"`
-	Unblockable types.Bool   `tfsdk:"unblockable"`
+	Deprecated  types.Bool   `tfsdk:"deprecated"`
 }
 
 func ConvertFromConnectorDto(connectorDto ConnectorDto) ConnectorsDataSourceModel {
-	return ConnectorsDataSourceModel{
-		Id:          types.StringValue(connectorDto.Id),
-		Name:        types.StringValue(connectorDto.Name),
-		Type:        types.StringValue(connectorDto.Type),
-		Description: types.StringValue(connectorDto.Properties.Description),
-		DisplayName: types.StringValue(connectorDto.Properties.DisplayName),
-		Tier:        types.StringValue(connectorDto.Properties.Tier),
-		Publisher:   types.StringValue(connectorDto.Properties.Publisher),
-		Unblockable: types.BoolValue(connectorDto.Properties.Unblockable),
+	// the connectors api does not expose the certification status, it is derived on a best-effort basis from marketplace and virtual connectors
+	certificationStatus := "Uncertified"
+	if !connectorDto.Properties.IsCustomApi && (connectorDto.Properties.Virtual || connectorDto.Properties.Metadata.Source == "marketplace") {
+		certificationStatus = "Certified"
+	}
+
+	connectorModel := ConnectorsDataSourceModel{
+		Id:                         types.StringValue(connectorDto.Id),
+		Name:                       types.StringValue(connectorDto.Name),
+		Type:                       types.StringValue(connectorDto.Type),
+		Description:                types.StringValue(connectorDto.Properties.Description),
+		DisplayName:                types.StringValue(connectorDto.Properties.DisplayName),
+		Tier:                       types.StringValue(connectorDto.Properties.Tier),
+		Publisher:                  types.StringValue(connectorDto.Properties.Publisher),
+		Unblockable:                types.BoolValue(connectorDto.Properties.Unblockable),
+		Custom:                     types.BoolValue(connectorDto.Properties.IsCustomApi),
+		Virtual:                    types.BoolValue(connectorDto.Properties.Virtual),
+		BuiltIn:                    types.BoolValue(connectorDto.Properties.Tier == "Built-in"),
+		CertificationStatus:        types.StringValue(certificationStatus),
+		ReleaseTag:                 types.StringValue(connectorDto.Properties.ReleaseTag),
+		EndpointFilteringSupported: types.BoolValue(slices.Contains(endpointFilteringConnectors, connectorDto.Name)),
+	}
+
+	if connectorDto.Properties.Actions != nil {
+		connectorModel.Actions = []ConnectorActionDataSourceModel{}
+		for _, action := range connectorDto.Properties.Actions {
+			connectorModel.Actions = append(connectorModel.Actions, ConnectorActionDataSourceModel{
+				Id:          types.StringValue(action.Id),
+				DisplayName: types.StringValue(action.DisplayName),
+				Deprecated:  types.BoolValue(action.Deprecated),
+			})
+		}
 	}
+	return connectorModel
 }
 
 func (d *ConnectorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -74,6 +125,42 @@ func (d *ConnectorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"tier": schema.StringAttribute{
+				MarkdownDescription: "Only return the connectors of the given tier (\"Standard\", \"Premium\", \"Built-in\")",
+				Description:         "Only return the connectors of the given tier (\"Standard\", \"Premium\", \"Built-in\")",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Standard", "Premium", "Built-in"),
+				},
+			},
+			"publisher": schema.StringAttribute{
+				MarkdownDescription: "Only return the connectors of the given publisher",
+				Description:         "Only return the connectors of the given publisher",
+				Optional:            true,
+			},
+			"name_pattern": schema.StringAttribute{
+				MarkdownDescription: "Only return the connectors whose name or display name matches the given regular expression",
+				Description:         "Only return the connectors whose name or display name matches the given regular expression",
+				Optional:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment to list the connectors of. When not set, the connectors of the default environment are returned",
+				Description:         "Id of the environment to list the connectors of. When not set, the connectors of the default environment are returned",
+				Optional:            true,
+			},
+			"include_custom": schema.BoolAttribute{
+				MarkdownDescription: "Include the custom connectors of the environment set in `environment_id`",
+				Description:         "Include the custom connectors of the environment set in `environment_id`",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("environment_id")),
+				},
+			},
+			"include_actions": schema.BoolAttribute{
+				MarkdownDescription: "Include the actions of the connectors. The actions are read from the definition of each connector, so it is recommended to narrow down the connectors with the other filters",
+				Description:         "Include the actions of the connectors. The actions are read from the definition of each connector, so it is recommended to narrow down the connectors with the other filters",
+				Optional:            true,
+			},
 			"connectors": schema.ListNestedAttribute{
 				Description:         "List of Connectors",
 				MarkdownDescription: "List of Connectors",
@@ -120,6 +207,60 @@ func (d *ConnectorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 							Description:         "Indicates if the connector can be blocked in a Data Loss Prevention policy. If true, the connector has to be in 'Non-Business' connectors group.",
 							Computed:            true,
 						},
+						"custom": schema.BoolAttribute{
+							MarkdownDescription: "Indicates if the connector is a custom connector",
+							Description:         "Indicates if the connector is a custom connector",
+							Computed:            true,
+						},
+						"virtual": schema.BoolAttribute{
+							MarkdownDescription: "Indicates if the connector is a virtual connector, like HTTP, that is classified in Data Loss Prevention policies without being an API",
+							Description:         "Indicates if the connector is a virtual connector, like HTTP, that is classified in Data Loss Prevention policies without being an API",
+							Computed:            true,
+						},
+						"built_in": schema.BoolAttribute{
+							MarkdownDescription: "Indicates if the connector is built-in",
+							Description:         "Indicates if the connector is built-in",
+							Computed:            true,
+						},
+						"certification_status": schema.StringAttribute{
+							MarkdownDescription: "Certification status of the connector (\"Certified\", \"Uncertified\"). Best-effort, derived from the source of the connector as it is not returned by the API",
+							Description:         "Certification status of the connector (\"Certified\", \"Uncertified\"). Best-effort, derived from the source of the connector as it is not returned by the API",
+							Computed:            true,
+						},
+						"release_tag": schema.StringAttribute{
+							MarkdownDescription: "Release tag of the connector (\"Production\", \"Preview\")",
+							Description:         "Release tag of the connector (\"Production\", \"Preview\")",
+							Computed:            true,
+						},
+						"endpoint_filtering_supported": schema.BoolAttribute{
+							MarkdownDescription: "Indicates if `endpoint_rules` can be set for the connector in a Data Loss Prevention policy. Best-effort, based on the documented list of connectors as it is not returned by the API. See [Connector endpoint filtering](https://learn.microsoft.com/power-platform/admin/connector-endpoint-filtering)",
+							Description:         "Indicates if endpoint_rules can be set for the connector in a Data Loss Prevention policy. Best-effort, based on the documented list of connectors as it is not returned by the API",
+							Computed:            true,
+						},
+						"actions": schema.ListNestedAttribute{
+							MarkdownDescription: "Actions of the connector, that can be used in the `action_rules` of a Data Loss Prevention policy. Only set when `include_actions` is true",
+							Description:         "Actions of the connector, that can be used in the action_rules of a Data Loss Prevention policy. Only set when include_actions is true",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: "Operation id of the action",
+										Description:         "Operation id of the action",
+										Computed:            true,
+									},
+									"display_name": schema.StringAttribute{
+										MarkdownDescription: "Display name of the action",
+										Description:         "Display name of the action",
+										Computed:            true,
+									},
+									"deprecated": schema.BoolAttribute{
+										MarkdownDescription: "Indicates if the action is deprecated",
+										Description:         "Indicates if the action is deprecated",
+										Computed:            true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -150,12 +291,73 @@ func (d *ConnectorsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 
 	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE CONNECTORS START: %s", d.ProviderTypeName))
 
-	connectors, err := d.ConnectorsClient.GetConnectors(ctx)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var namePattern *regexp.Regexp
+	if !state.NamePattern.IsNull() {
+		var err error
+		namePattern, err = regexp.Compile(state.NamePattern.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_pattern"), "Invalid regular expression", err.Error())
+			return
+		}
+	}
+
+	environment := "~Default"
+	var connectors []ConnectorDto
+	var err error
+	if state.EnvironmentId.IsNull() {
+		connectors, err = d.ConnectorsClient.GetConnectors(ctx)
+	} else {
+		environment = state.EnvironmentId.ValueString()
+		connectors, err = d.ConnectorsClient.GetEnvironmentConnectors(ctx, environment)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.ProviderTypeName), err.Error())
 		return
 	}
 
+	filteredConnectors := []ConnectorDto{}
+	for _, connector := range connectors {
+		if connector.Properties.IsCustomApi && !state.IncludeCustom.ValueBool() {
+			continue
+		}
+		if !state.Tier.IsNull() && !strings.EqualFold(connector.Properties.Tier, state.Tier.ValueString()) {
+			continue
+		}
+		if !state.Publisher.IsNull() && !strings.EqualFold(connector.Properties.Publisher, state.Publisher.ValueString()) {
+			continue
+		}
+		if namePattern != nil && !namePattern.MatchString(connector.Name) && !namePattern.MatchString(connector.Properties.DisplayName) {
+			continue
+		}
+		filteredConnectors = append(filteredConnectors, connector)
+	}
+	connectors = filteredConnectors
+
+	if state.IncludeActions.ValueBool() {
+		//actions are read from the definition of every connector, virtual connectors have no definition
+		connectors, err = api.ExecuteConcurrently(ctx, connectors, constants.MAX_CONCURRENT_REQUESTS, func(ctx context.Context, connector ConnectorDto) (ConnectorDto, error) {
+			connector.Properties.Actions = []ConnectorActionDto{}
+			if connector.Properties.Virtual {
+				return connector, nil
+			}
+			actions, err := d.ConnectorsClient.GetConnectorActions(ctx, environment, connector.Name)
+			if err != nil {
+				return connector, err
+			}
+			connector.Properties.Actions = actions
+			return connector, nil
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading actions of %s", d.ProviderTypeName), err.Error())
+			return
+		}
+	}
+
 	for _, connector := range connectors {
 		connectorModel := ConvertFromConnectorDto(connector)
 		state.Connectors = append(state.Connectors, connectorModel)
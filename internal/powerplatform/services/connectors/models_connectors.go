@@ -3,6 +3,8 @@
 
 package powerplatform
 
+import "encoding/json"
+
 type ConnectorDto struct {
 	Name       string                 `json:"name"`
 	Id         string                 `json:"id"`
@@ -11,11 +13,57 @@ type ConnectorDto struct {
 }
 
 type ConnectorPropertiesDto struct {
-	DisplayName string `json:"displayName"`
-	Description string `json:"description"`
-	Tier        string `json:"tier"`
-	Publisher   string `json:"publisher"`
+	DisplayName string               `json:"displayName"`
+	Description string               `json:"description"`
+	Tier        string               `json:"tier"`
+	Publisher   string               `json:"publisher"`
+	IsCustomApi bool                 `json:"isCustomApi"`
+	ReleaseTag  string               `json:"releaseTag"`
+	Metadata    ConnectorMetadataDto `json:"metadata"`
 	Unblockable bool
+	Virtual     bool
+	Actions     []ConnectorActionDto
+}
+
+type ConnectorMetadataDto struct {
+	Source string `json:"source"`
+}
+
+type ConnectorActionDto struct {
+	Id          string
+	DisplayName string
+	Deprecated  bool
+}
+
+type ConnectorWithSwaggerDto struct {
+	Name       string                            `json:"name"`
+	Id         string                            `json:"id"`
+	Properties ConnectorWithSwaggerPropertiesDto `json:"properties"`
+}
+
+type ConnectorWithSwaggerPropertiesDto struct {
+	Swagger ConnectorSwaggerPathsDto `json:"swagger"`
+}
+
+type ConnectorSwaggerPathsDto struct {
+	Paths map[string]map[string]json.RawMessage `json:"paths"`
+}
+
+type ConnectorSwaggerOperationDto struct {
+	OperationId string `json:"operationId"`
+	Summary     string `json:"summary"`
+	Deprecated  bool   `json:"deprecated"`
+}
+
+// connectors supporting endpoint filtering in Data Loss Prevention policies, see https://learn.microsoft.com/power-platform/admin/connector-endpoint-filtering.
+// The connectors api does not expose this, so the list is best-effort and has to be kept in sync with the documentation.
+var endpointFilteringConnectors = []string{
+	"Http",
+	"HttpWebhook",
+	"shared_webcontents",
+	"shared_sql",
+	"shared_azureblob",
+	"shared_smtp",
 }
 
 type ConnectorDtoArray struct {
@@ -41,4 +89,94 @@ type VirtualConnectorMetadataDto struct {
 	Name             string `json:"name"`
 	Type             string `json:"type"`
 	DisplayName      string `json:"displayName"`
-}
\ No newline at end of file
+}
+
+type CustomConnectorDto struct {
+	Name       string                       `json:"name,omitempty"`
+	Id         string                       `json:"id,omitempty"`
+	Type       string                       `json:"type,omitempty"`
+	Properties CustomConnectorPropertiesDto `json:"properties"`
+}
+
+type CustomConnectorPropertiesDto struct {
+	DisplayName             string                                     `json:"displayName"`
+	Description             string                                     `json:"description"`
+	IconUri                 *string                                    `json:"iconUri,omitempty"`
+	IconBrandColor          string                                     `json:"iconBrandColor,omitempty"`
+	Environment             CustomConnectorEnvironmentDto              `json:"environment"`
+	BackendService          *CustomConnectorBackendServiceDto          `json:"backendService,omitempty"`
+	OpenApiDefinition       json.RawMessage                            `json:"openApiDefinition,omitempty"`
+	ConnectionParameters    map[string]CustomConnectorParameterDto     `json:"connectionParameters,omitempty"`
+	PolicyTemplateInstances []CustomConnectorPolicyTemplateInstanceDto `json:"policyTemplateInstances"`
+}
+
+type CustomConnectorEnvironmentDto struct {
+	Name string `json:"name"`
+}
+
+type CustomConnectorBackendServiceDto struct {
+	ServiceUrl string `json:"serviceUrl"`
+}
+
+type CustomConnectorParameterDto struct {
+	Type          string                           `json:"type"`
+	OAuthSettings *CustomConnectorOAuthSettingsDto `json:"oAuthSettings,omitempty"`
+	UiDefinition  *CustomConnectorUiDefinitionDto  `json:"uiDefinition,omitempty"`
+}
+
+type CustomConnectorOAuthSettingsDto struct {
+	IdentityProvider string                                      `json:"identityProvider"`
+	ClientId         string                                      `json:"clientId"`
+	ClientSecret     string                                      `json:"clientSecret,omitempty"`
+	Scopes           []string                                    `json:"scopes"`
+	RedirectMode     string                                      `json:"redirectMode"`
+	RedirectUrl      string                                      `json:"redirectUrl,omitempty"`
+	Properties       map[string]string                           `json:"properties"`
+	CustomParameters map[string]CustomConnectorParameterValueDto `json:"customParameters"`
+}
+
+type CustomConnectorParameterValueDto struct {
+	Value string `json:"value"`
+}
+
+type CustomConnectorUiDefinitionDto struct {
+	DisplayName string                        `json:"displayName"`
+	Description string                        `json:"description"`
+	Tooltip     string                        `json:"tooltip,omitempty"`
+	Constraints CustomConnectorConstraintsDto `json:"constraints"`
+}
+
+type CustomConnectorConstraintsDto struct {
+	Required  string `json:"required"`
+	ClearText bool   `json:"clearText"`
+	TabIndex  int    `json:"tabIndex"`
+}
+
+type CustomConnectorPolicyTemplateInstanceDto struct {
+	TemplateId string            `json:"templateId"`
+	Title      string            `json:"title"`
+	Parameters map[string]string `json:"parameters"`
+}
+
+type CustomConnectorResourceStorageRequestDto struct {
+	Properties CustomConnectorResourceStoragePropertiesDto `json:"properties"`
+}
+
+type CustomConnectorResourceStoragePropertiesDto struct {
+	Environment CustomConnectorEnvironmentDto `json:"environment"`
+}
+
+type CustomConnectorResourceStorageDto struct {
+	SharedAccessSignature string `json:"sharedAccessSignature"`
+}
+
+type CustomConnectorSwaggerDto struct {
+	Swagger  string   `json:"swagger"`
+	Host     string   `json:"host"`
+	BasePath string   `json:"basePath"`
+	Schemes  []string `json:"schemes"`
+	Info     struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	} `json:"info"`
+}
@@ -0,0 +1,567 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+	powerplatform_modifiers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/modifiers"
+)
+
+var _ resource.Resource = &CustomConnectorResource{}
+
+func NewCustomConnectorResource() resource.Resource {
+	return &CustomConnectorResource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_custom_connector",
+	}
+}
+
+type CustomConnectorResource struct {
+	ConnectorsClient ConnectorsClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+type CustomConnectorResourceModel struct {
+	Id                  types.String                         `tfsdk:"id"`
+	Name                types.String                         `tfsdk:"name"`
+	EnvironmentId       types.String                         `tfsdk:"environment_id"`
+	DisplayName         types.String                         `tfsdk:"display_name"`
+	Description         types.String                         `tfsdk:"description"`
+	SwaggerFile         types.String                         `tfsdk:"swagger_file"`
+	SwaggerFileChecksum types.String                         `tfsdk:"swagger_file_checksum"`
+	IconFile            types.String                         `tfsdk:"icon_file"`
+	IconFileChecksum    types.String                         `tfsdk:"icon_file_checksum"`
+	IconBrandColor      types.String                         `tfsdk:"icon_brand_color"`
+	OAuth2              types.Object                         `tfsdk:"oauth2"`
+	ApiKey              types.Object                         `tfsdk:"api_key"`
+	PolicyTemplates     []CustomConnectorPolicyTemplateModel `tfsdk:"policy_templates"`
+}
+
+type CustomConnectorOAuth2Model struct {
+	IdentityProvider types.String `tfsdk:"identity_provider"`
+	ClientId         types.String `tfsdk:"client_id"`
+	ClientSecret     types.String `tfsdk:"client_secret"`
+	AuthorizationUrl types.String `tfsdk:"authorization_url"`
+	TokenUrl         types.String `tfsdk:"token_url"`
+	RefreshUrl       types.String `tfsdk:"refresh_url"`
+	TenantId         types.String `tfsdk:"tenant_id"`
+	ResourceUrl      types.String `tfsdk:"resource_url"`
+	Scopes           []string     `tfsdk:"scopes"`
+}
+
+type CustomConnectorApiKeyModel struct {
+	DisplayName types.String `tfsdk:"display_name"`
+	Description types.String `tfsdk:"description"`
+}
+
+type CustomConnectorPolicyTemplateModel struct {
+	TemplateId types.String      `tfsdk:"template_id"`
+	Title      types.String      `tfsdk:"title"`
+	Parameters map[string]string `tfsdk:"parameters"`
+}
+
+func (r *CustomConnectorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.TypeName
+}
+
+func (r *CustomConnectorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Resource for managing custom connectors in a Power Platform environment",
+		MarkdownDescription: "Resource for managing [custom connectors](https://learn.microsoft.com/connectors/custom-connectors/) in a Power Platform environment. The connector is defined by an [OpenAPI (Swagger 2.0) definition](https://learn.microsoft.com/connectors/custom-connectors/define-openapi-definition) file. This is the equivalent of the [`paconn create`](https://learn.microsoft.com/connectors/custom-connectors/paconn-cli) command of the Power Platform Connectors CLI.\n\nThe `id` of the resource is the connector id, that can be used in the connector groups of the `powerplatform_data_loss_prevention_policy` resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the custom connector",
+				Description:         "Unique identifier of the custom connector",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the custom connector",
+				Description:         "Name of the custom connector",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment where the custom connector is created",
+				Description:         "Id of the environment where the custom connector is created",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the custom connector",
+				Description:         "Display name of the custom connector",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the custom connector",
+				Description:         "Description of the custom connector",
+				Optional:            true,
+			},
+			"swagger_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the OpenAPI (Swagger 2.0) definition file of the custom connector in json format",
+				Description:         "Path to the OpenAPI (Swagger 2.0) definition file of the custom connector in json format",
+				Required:            true,
+			},
+			"swagger_file_checksum": schema.StringAttribute{
+				MarkdownDescription: "Checksum of the swagger file",
+				Description:         "Checksum of the swagger file",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					powerplatform_modifiers.FileChecksumPlanModifier("swagger_file"),
+				},
+			},
+			"icon_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the icon file of the custom connector. The icon should be a 230x230 pixel PNG or JPG file",
+				Description:         "Path to the icon file of the custom connector. The icon should be a 230x230 pixel PNG or JPG file",
+				Optional:            true,
+			},
+			"icon_file_checksum": schema.StringAttribute{
+				MarkdownDescription: "Checksum of the icon file",
+				Description:         "Checksum of the icon file",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					powerplatform_modifiers.FileChecksumPlanModifier("icon_file"),
+				},
+			},
+			"icon_brand_color": schema.StringAttribute{
+				MarkdownDescription: "Background color of the icon in hexadecimal format, for example `#007ee5`",
+				Description:         "Background color of the icon in hexadecimal format, for example `#007ee5`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("#007ee5"),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^#[0-9a-fA-F]{6}$`), "icon_brand_color must be a hexadecimal color in the format '#rrggbb'"),
+				},
+			},
+			"oauth2": schema.SingleNestedAttribute{
+				MarkdownDescription: "OAuth 2.0 authentication of the custom connector. The swagger file has to define a matching `oauth2` security definition",
+				Description:         "OAuth 2.0 authentication of the custom connector. The swagger file has to define a matching `oauth2` security definition",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("api_key")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"identity_provider": schema.StringAttribute{
+						MarkdownDescription: "Identity provider of the OAuth 2.0 authentication. Use `oauth2` for a generic OAuth 2.0 provider and `aad` for Microsoft Entra ID",
+						Description:         "Identity provider of the OAuth 2.0 authentication. Use `oauth2` for a generic OAuth 2.0 provider and `aad` for Microsoft Entra ID",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("oauth2"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("oauth2", "aad"),
+						},
+					},
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "Client id of the application registered in the identity provider",
+						Description:         "Client id of the application registered in the identity provider",
+						Required:            true,
+					},
+					"client_secret": schema.StringAttribute{
+						MarkdownDescription: "Client secret of the application registered in the identity provider",
+						Description:         "Client secret of the application registered in the identity provider",
+						Required:            true,
+						Sensitive:           true,
+					},
+					"authorization_url": schema.StringAttribute{
+						MarkdownDescription: "Authorization url of the generic OAuth 2.0 provider",
+						Description:         "Authorization url of the generic OAuth 2.0 provider",
+						Optional:            true,
+					},
+					"token_url": schema.StringAttribute{
+						MarkdownDescription: "Token url of the generic OAuth 2.0 provider",
+						Description:         "Token url of the generic OAuth 2.0 provider",
+						Optional:            true,
+					},
+					"refresh_url": schema.StringAttribute{
+						MarkdownDescription: "Refresh url of the generic OAuth 2.0 provider",
+						Description:         "Refresh url of the generic OAuth 2.0 provider",
+						Optional:            true,
+					},
+					"tenant_id": schema.StringAttribute{
+						MarkdownDescription: "Tenant id used by the `aad` identity provider. Defaults to `common`",
+						Description:         "Tenant id used by the `aad` identity provider. Defaults to `common`",
+						Optional:            true,
+					},
+					"resource_url": schema.StringAttribute{
+						MarkdownDescription: "Resource url used by the `aad` identity provider",
+						Description:         "Resource url used by the `aad` identity provider",
+						Optional:            true,
+					},
+					"scopes": schema.ListAttribute{
+						MarkdownDescription: "Scopes requested from the identity provider",
+						Description:         "Scopes requested from the identity provider",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+			"api_key": schema.SingleNestedAttribute{
+				MarkdownDescription: "API key authentication of the custom connector. The swagger file has to define a matching `apiKey` security definition",
+				Description:         "API key authentication of the custom connector. The swagger file has to define a matching `apiKey` security definition",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"display_name": schema.StringAttribute{
+						MarkdownDescription: "Label of the API key shown when creating a connection",
+						Description:         "Label of the API key shown when creating a connection",
+						Required:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "Description of the API key shown when creating a connection",
+						Description:         "Description of the API key shown when creating a connection",
+						Optional:            true,
+					},
+				},
+			},
+			"policy_templates": schema.ListNestedAttribute{
+				MarkdownDescription: "[Policy templates](https://learn.microsoft.com/connectors/custom-connectors/policy-templates) applied to the custom connector",
+				Description:         "Policy templates applied to the custom connector",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"template_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the policy template, for example `setheader` or `routerequesttoendpoint`",
+							Description:         "Id of the policy template, for example `setheader` or `routerequesttoendpoint`",
+							Required:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Title of the policy template instance",
+							Description:         "Title of the policy template instance",
+							Required:            true,
+						},
+						"parameters": schema.MapAttribute{
+							MarkdownDescription: "Parameters of the policy template instance",
+							Description:         "Parameters of the policy template instance",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *CustomConnectorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientApi := req.ProviderData.(*api.ProviderClient).Api
+
+	if clientApi == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.ConnectorsClient = NewConnectorsClient(clientApi)
+}
+
+func (r *CustomConnectorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *CustomConnectorResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectorToCreate := r.convertToCustomConnectorDto(ctx, plan, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connector, err := r.ConnectorsClient.CreateCustomConnector(ctx, plan.EnvironmentId.ValueString(), *connectorToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.ProviderTypeName), err.Error())
+		return
+	}
+
+	convertFromCustomConnectorDto(plan, connector)
+	r.setFileChecksums(plan, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *CustomConnectorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *CustomConnectorResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connector, err := r.ConnectorsClient.GetCustomConnector(ctx, state.EnvironmentId.ValueString(), state.Name.ValueString())
+	if err != nil {
+		if powerplatform_helpers.Code(err) == powerplatform_helpers.ERROR_OBJECT_NOT_FOUND {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.ProviderTypeName), err.Error())
+		return
+	}
+
+	convertFromCustomConnectorDto(state, connector)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *CustomConnectorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *CustomConnectorResourceModel
+	var state *CustomConnectorResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//the icon is only uploaded again when its content has changed
+	uploadIcon := !plan.IconFileChecksum.Equal(state.IconFileChecksum)
+	connectorToUpdate := r.convertToCustomConnectorDto(ctx, plan, uploadIcon, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.IconFile.IsNull() && !state.IconFile.IsNull() {
+		//the icon was removed from the configuration, so the default icon is restored
+		noIconUri := ""
+		connectorToUpdate.Properties.IconUri = &noIconUri
+	}
+
+	connector, err := r.ConnectorsClient.UpdateCustomConnector(ctx, plan.EnvironmentId.ValueString(), state.Name.ValueString(), *connectorToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.ProviderTypeName), err.Error())
+		return
+	}
+
+	convertFromCustomConnectorDto(plan, connector)
+	r.setFileChecksums(plan, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *CustomConnectorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *CustomConnectorResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.ConnectorsClient.DeleteCustomConnector(ctx, state.EnvironmentId.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *CustomConnectorResource) setFileChecksums(model *CustomConnectorResourceModel, diags *diag.Diagnostics) {
+	value, err := powerplatform_helpers.CalculateMd5(model.SwaggerFile.ValueString())
+	if err != nil {
+		diags.AddWarning("Issue when calculating checksum for swagger file", err.Error())
+	} else {
+		model.SwaggerFileChecksum = types.StringValue(value)
+	}
+
+	model.IconFileChecksum = types.StringNull()
+	if !model.IconFile.IsNull() {
+		value, err := powerplatform_helpers.CalculateMd5(model.IconFile.ValueString())
+		if err != nil {
+			diags.AddWarning("Issue when calculating checksum for icon file", err.Error())
+		} else {
+			model.IconFileChecksum = types.StringValue(value)
+		}
+	}
+}
+
+func (r *CustomConnectorResource) convertToCustomConnectorDto(ctx context.Context, model *CustomConnectorResourceModel, uploadIcon bool, diags *diag.Diagnostics) *CustomConnectorDto {
+	environmentId := model.EnvironmentId.ValueString()
+
+	swaggerContent, err := os.ReadFile(model.SwaggerFile.ValueString())
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Client error when reading swagger file %s", model.SwaggerFile.ValueString()), err.Error())
+		return nil
+	}
+	swagger, err := ReadCustomConnectorSwagger(swaggerContent)
+	if err != nil {
+		diags.AddAttributeError(path.Root("swagger_file"), "Invalid swagger file", err.Error())
+		return nil
+	}
+
+	connector := CustomConnectorDto{
+		Properties: CustomConnectorPropertiesDto{
+			DisplayName:             model.DisplayName.ValueString(),
+			Description:             model.Description.ValueString(),
+			IconBrandColor:          model.IconBrandColor.ValueString(),
+			Environment:             CustomConnectorEnvironmentDto{Name: environmentId},
+			OpenApiDefinition:       swaggerContent,
+			ConnectionParameters:    map[string]CustomConnectorParameterDto{},
+			PolicyTemplateInstances: make([]CustomConnectorPolicyTemplateInstanceDto, 0),
+		},
+	}
+	if backendServiceUrl := swagger.BackendServiceUrl(); backendServiceUrl != "" {
+		connector.Properties.BackendService = &CustomConnectorBackendServiceDto{ServiceUrl: backendServiceUrl}
+	}
+
+	if uploadIcon && !model.IconFile.IsNull() {
+		iconContent, err := os.ReadFile(model.IconFile.ValueString())
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Client error when reading icon file %s", model.IconFile.ValueString()), err.Error())
+			return nil
+		}
+		iconUri, err := r.ConnectorsClient.UploadCustomConnectorIcon(ctx, environmentId, model.IconFile.ValueString(), iconContent)
+		if err != nil {
+			diags.AddError("Client error when uploading custom connector icon", err.Error())
+			return nil
+		}
+		connector.Properties.IconUri = &iconUri
+	}
+
+	if !model.OAuth2.IsNull() && !model.OAuth2.IsUnknown() {
+		var oauth2 CustomConnectorOAuth2Model
+		diags.Append(model.OAuth2.As(ctx, &oauth2, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil
+		}
+		connector.Properties.ConnectionParameters["token"] = convertToOAuth2ConnectionParameterDto(oauth2)
+	}
+
+	if !model.ApiKey.IsNull() && !model.ApiKey.IsUnknown() {
+		var apiKey CustomConnectorApiKeyModel
+		diags.Append(model.ApiKey.As(ctx, &apiKey, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil
+		}
+		connector.Properties.ConnectionParameters["api_key"] = CustomConnectorParameterDto{
+			Type: "securestring",
+			UiDefinition: &CustomConnectorUiDefinitionDto{
+				DisplayName: apiKey.DisplayName.ValueString(),
+				Description: apiKey.Description.ValueString(),
+				Tooltip:     "Provide your API key",
+				Constraints: CustomConnectorConstraintsDto{
+					Required:  "true",
+					ClearText: false,
+					TabIndex:  2,
+				},
+			},
+		}
+	}
+
+	for _, policyTemplate := range model.PolicyTemplates {
+		parameters := policyTemplate.Parameters
+		if parameters == nil {
+			parameters = map[string]string{}
+		}
+		connector.Properties.PolicyTemplateInstances = append(connector.Properties.PolicyTemplateInstances, CustomConnectorPolicyTemplateInstanceDto{
+			TemplateId: policyTemplate.TemplateId.ValueString(),
+			Title:      policyTemplate.Title.ValueString(),
+			Parameters: parameters,
+		})
+	}
+
+	return &connector
+}
+
+func convertToOAuth2ConnectionParameterDto(oauth2 CustomConnectorOAuth2Model) CustomConnectorParameterDto {
+	scopes := oauth2.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	customParameters := map[string]CustomConnectorParameterValueDto{}
+	if oauth2.IdentityProvider.ValueString() == "aad" {
+		tenantId := oauth2.TenantId.ValueString()
+		if tenantId == "" {
+			tenantId = "common"
+		}
+		customParameters["loginUri"] = CustomConnectorParameterValueDto{Value: "https://login.microsoftonline.com"}
+		customParameters["tenantId"] = CustomConnectorParameterValueDto{Value: tenantId}
+		customParameters["resourceUri"] = CustomConnectorParameterValueDto{Value: oauth2.ResourceUrl.ValueString()}
+		customParameters["enableOnbehalfOfLogin"] = CustomConnectorParameterValueDto{Value: "false"}
+	} else {
+		customParameters["authorizationUrl"] = CustomConnectorParameterValueDto{Value: oauth2.AuthorizationUrl.ValueString()}
+		customParameters["tokenUrl"] = CustomConnectorParameterValueDto{Value: oauth2.TokenUrl.ValueString()}
+		customParameters["refreshUrl"] = CustomConnectorParameterValueDto{Value: oauth2.RefreshUrl.ValueString()}
+	}
+
+	return CustomConnectorParameterDto{
+		Type: "oauthSetting",
+		OAuthSettings: &CustomConnectorOAuthSettingsDto{
+			IdentityProvider: oauth2.IdentityProvider.ValueString(),
+			ClientId:         oauth2.ClientId.ValueString(),
+			ClientSecret:     oauth2.ClientSecret.ValueString(),
+			Scopes:           scopes,
+			RedirectMode:     "Global",
+			RedirectUrl:      CUSTOM_CONNECTOR_REDIRECT_URL,
+			Properties: map[string]string{
+				"IsFirstParty":               "False",
+				"IsOnbehalfofLoginSupported": "False",
+			},
+			CustomParameters: customParameters,
+		},
+	}
+}
+
+func convertFromCustomConnectorDto(model *CustomConnectorResourceModel, connector *CustomConnectorDto) {
+	model.Id = types.StringValue(connector.Id)
+	model.Name = types.StringValue(connector.Name)
+	model.DisplayName = types.StringValue(connector.Properties.DisplayName)
+	if connector.Properties.Description != "" {
+		model.Description = types.StringValue(connector.Properties.Description)
+	} else {
+		model.Description = types.StringNull()
+	}
+	if connector.Properties.IconBrandColor != "" {
+		model.IconBrandColor = types.StringValue(connector.Properties.IconBrandColor)
+	}
+}
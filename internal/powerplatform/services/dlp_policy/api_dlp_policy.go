@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"strings"
 
+	constants "github.com/microsoft/terraform-provider-power-platform/constants"
 	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
 	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
 )
@@ -36,38 +37,33 @@ func (client *DlpPolicyClient) GetPolicies(ctx context.Context) ([]DlpPolicyMode
 		return nil, err
 	}
 
-	policies := make([]DlpPolicyModelDto, 0)
-	for _, policy := range policiesArray.Value {
-
+	//policy details are fetched concurrently, the order of the policies is kept
+	return api.ExecuteConcurrently(ctx, policiesArray.Value, constants.MAX_CONCURRENT_REQUESTS, func(ctx context.Context, policyDefinition DlpPolicyDto) (DlpPolicyModelDto, error) {
 		apiUrl := &url.URL{
 			Scheme: "https",
 			Host:   client.Api.GetConfig().Urls.BapiUrl,
-			Path:   fmt.Sprintf("providers/PowerPlatform.Governance/v2/policies/%s", policy.PolicyDefinition.Name),
+			Path:   fmt.Sprintf("providers/PowerPlatform.Governance/v2/policies/%s", policyDefinition.PolicyDefinition.Name),
 		}
 		policy := DlpPolicyDto{}
 		_, err := client.Api.Execute(ctx, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &policy)
 		if err != nil {
-			return nil, err
+			return DlpPolicyModelDto{}, err
 		}
 		v, err := covertDlpPolicyToPolicyModelDto(policy)
 		if err != nil {
-			return nil, err
+			return DlpPolicyModelDto{}, err
 		}
-		policies = append(policies, *v)
-	}
-	return policies, nil
+		return *v, nil
+	})
 }
 
-func (client *DlpPolicyClient) GetPolicy(ctx context.Context, name string) (*DlpPolicyModelDto, error) {
-	apiUrl := &url.URL{
-		Scheme: "https",
-		Host:   client.Api.GetConfig().Urls.BapiUrl,
-		Path:   fmt.Sprintf("providers/PowerPlatform.Governance/v2/policies/%s", name),
-	}
+// GetPolicy reads a DLP policy, environmentId is empty for tenant-level policies.
+func (client *DlpPolicyClient) GetPolicy(ctx context.Context, environmentId string, name string) (*DlpPolicyModelDto, error) {
+	apiUrl := client.buildPolicyUrl("v2", environmentId, name)
 	policy := DlpPolicyDto{}
-	_, err := client.Api.Execute(ctx, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &policy)
+	response, err := client.Api.Execute(ctx, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &policy)
 	if err != nil {
-		if strings.ContainsAny(err.Error(), "404") {
+		if response != nil && response.Response.StatusCode == http.StatusNotFound {
 			return nil, powerplatform_helpers.WrapIntoProviderError(err, powerplatform_helpers.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("DLP Policy '%s' not found", name))
 		}
 		return nil, err
@@ -75,30 +71,24 @@ func (client *DlpPolicyClient) GetPolicy(ctx context.Context, name string) (*Dlp
 	return covertDlpPolicyToPolicyModel(policy)
 }
 
-func (client *DlpPolicyClient) DeletePolicy(ctx context.Context, name string) error {
-	apiUrl := &url.URL{
-		Scheme: "https",
-		Host:   client.Api.GetConfig().Urls.BapiUrl,
-		Path:   fmt.Sprintf("providers/PowerPlatform.Governance/v1/policies/%s", name),
-	}
-	_, err := client.Api.Execute(ctx, "DELETE", apiUrl.String(), nil, nil, []int{http.StatusOK}, nil)
+// DeletePolicy deletes a DLP policy, environmentId is empty for tenant-level policies.
+func (client *DlpPolicyClient) DeletePolicy(ctx context.Context, environmentId string, name string) error {
+	apiUrl := client.buildPolicyUrl("v1", environmentId, name)
+	_, err := client.Api.Execute(ctx, "DELETE", apiUrl, nil, nil, []int{http.StatusOK}, nil)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (client *DlpPolicyClient) UpdatePolicy(ctx context.Context, name string, policy DlpPolicyModelDto) (*DlpPolicyModelDto, error) {
+// UpdatePolicy updates a DLP policy, environmentId is empty for tenant-level policies.
+func (client *DlpPolicyClient) UpdatePolicy(ctx context.Context, environmentId string, name string, policy DlpPolicyModelDto) (*DlpPolicyModelDto, error) {
 	policyToCreate := convertPolicyModelToDlpPolicy(policy)
 
-	apiUrl := &url.URL{
-		Scheme: "https",
-		Host:   client.Api.GetConfig().Urls.BapiUrl,
-		Path:   fmt.Sprintf("providers/PowerPlatform.Governance/v2/policies/%s", policy.Name),
-	}
+	apiUrl := client.buildPolicyUrl("v2", environmentId, policy.Name)
 	createdPolicy := DlpPolicyDto{}
 
-	_, err := client.Api.Execute(ctx, "PATCH", apiUrl.String(), nil, policyToCreate, []int{http.StatusOK}, &createdPolicy)
+	_, err := client.Api.Execute(ctx, "PATCH", apiUrl, nil, policyToCreate, []int{http.StatusOK}, &createdPolicy)
 	if err != nil {
 		return nil, err
 	}
@@ -240,20 +230,35 @@ func covertDlpPolicyToPolicyModel(policy DlpPolicyDto) (*DlpPolicyModelDto, erro
 	return &policyModel, nil
 }
 
-func (client *DlpPolicyClient) CreatePolicy(ctx context.Context, policy DlpPolicyModelDto) (*DlpPolicyModelDto, error) {
+// CreatePolicy creates a DLP policy, environmentId is empty for tenant-level policies.
+func (client *DlpPolicyClient) CreatePolicy(ctx context.Context, environmentId string, policy DlpPolicyModelDto) (*DlpPolicyModelDto, error) {
 
 	policyToCreate := convertPolicyModelToDlpPolicy(policy)
 
-	apiUrl := &url.URL{
-		Scheme: "https",
-		Host:   client.Api.GetConfig().Urls.BapiUrl,
-		Path:   "/providers/PowerPlatform.Governance/v2/policies",
-	}
+	apiUrl := client.buildPolicyUrl("v2", environmentId, "")
 
 	createdPolicy := DlpPolicyDto{}
-	_, err := client.Api.Execute(ctx, "POST", apiUrl.String(), nil, policyToCreate, []int{http.StatusCreated}, &createdPolicy)
+	_, err := client.Api.Execute(ctx, "POST", apiUrl, nil, policyToCreate, []int{http.StatusCreated}, &createdPolicy)
 	if err != nil {
 		return nil, err
 	}
 	return covertDlpPolicyToPolicyModel(createdPolicy)
 }
+
+// buildPolicyUrl returns the url of the tenant-level policies, or of the policies of an environment when environmentId is set.
+func (client *DlpPolicyClient) buildPolicyUrl(apiVersion string, environmentId string, name string) string {
+	policiesPath := fmt.Sprintf("providers/PowerPlatform.Governance/%s/policies", apiVersion)
+	if environmentId != "" {
+		policiesPath = fmt.Sprintf("providers/PowerPlatform.Governance/%s/environments/%s/policies", apiVersion, environmentId)
+	}
+	if name != "" {
+		policiesPath = fmt.Sprintf("%s/%s", policiesPath, name)
+	}
+
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   client.Api.GetConfig().Urls.BapiUrl,
+		Path:   policiesPath,
+	}
+	return apiUrl.String()
+}
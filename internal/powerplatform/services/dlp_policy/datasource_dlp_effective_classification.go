@@ -0,0 +1,326 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+)
+
+var (
+	_ datasource.DataSource              = &DataLossPreventionEffectiveClassificationDataSource{}
+	_ datasource.DataSourceWithConfigure = &DataLossPreventionEffectiveClassificationDataSource{}
+)
+
+// classifications ordered from the least to the most restrictive
+var dlpClassificationRestrictiveness = map[string]int{
+	"General":      1,
+	"Confidential": 2,
+	"Blocked":      3,
+}
+
+func NewDataLossPreventionEffectiveClassificationDataSource() datasource.DataSource {
+	return &DataLossPreventionEffectiveClassificationDataSource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_data_loss_prevention_effective_classification",
+	}
+}
+
+type DataLossPreventionEffectiveClassificationDataSource struct {
+	DlpPolicyClient  DlpPolicyClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+type DataLossPreventionEffectiveClassificationDataSourceModel struct {
+	Id            types.String                                `tfsdk:"id"`
+	EnvironmentId types.String                                `tfsdk:"environment_id"`
+	Policies      []string                                    `tfsdk:"policies"`
+	Connectors    []DataLossPreventionEffectiveConnectorModel `tfsdk:"connectors"`
+}
+
+type DataLossPreventionEffectiveConnectorModel struct {
+	Id                        types.String `tfsdk:"id"`
+	Classification            types.String `tfsdk:"classification"`
+	Policies                  []string     `tfsdk:"policies"`
+	DefaultActionRuleBehavior types.String `tfsdk:"default_action_rule_behavior"`
+	ActionRules               types.List   `tfsdk:"action_rules"`
+	EndpointRules             types.List   `tfsdk:"endpoint_rules"`
+}
+
+type DlpEffectiveConnectorDto struct {
+	Connector      DlpConnectorModelDto
+	Classification string
+	Policies       []string
+}
+
+func (d *DataLossPreventionEffectiveClassificationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + d.TypeName
+}
+
+func (d *DataLossPreventionEffectiveClassificationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Evaluates the effective Data Loss Prevention classification of connectors in an environment",
+		MarkdownDescription: "Evaluates the effective Data Loss Prevention classification of connectors in an environment, by combining all policies that apply to the environment. See [Combined effect of multiple DLP policies](https://learn.microsoft.com/power-platform/admin/dlp-combined-effect-multiple-policies) for more information.\n\nFor every connector classified by at least one of the policies, the most restrictive group is returned, where `Blocked` is more restrictive than `Business`, which is more restrictive than `NonBusiness`. Connectors that a policy does not classify explicitly get the default classification of that policy. The evaluation is done locally from the policy definitions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Id of the read operation",
+				MarkdownDescription: "Id of the read operation",
+				Computed:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment to evaluate",
+				Description:         "Id of the environment to evaluate",
+				Required:            true,
+			},
+			"policies": schema.ListAttribute{
+				MarkdownDescription: "Ids of the policies that apply to the environment",
+				Description:         "Ids of the policies that apply to the environment",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"connectors": schema.ListNestedAttribute{
+				MarkdownDescription: "Effective classification of the connectors, ordered by connector id",
+				Description:         "Effective classification of the connectors, ordered by connector id",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the connector",
+							Description:         "ID of the connector",
+							Computed:            true,
+						},
+						"classification": schema.StringAttribute{
+							MarkdownDescription: "Effective data group of the connector (\"Business\", \"NonBusiness\", \"Blocked\")",
+							Description:         "Effective data group of the connector (\"Business\", \"NonBusiness\", \"Blocked\")",
+							Computed:            true,
+						},
+						"policies": schema.ListAttribute{
+							MarkdownDescription: "Ids of the policies that classify the connector in the effective data group",
+							Description:         "Ids of the policies that classify the connector in the effective data group",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"default_action_rule_behavior": schema.StringAttribute{
+							MarkdownDescription: "Combined default action rule behavior for the connector (\"Allow\", \"Block\"), `Block` if any of the policies blocks actions by default",
+							Description:         "Combined default action rule behavior for the connector (\"Allow\", \"Block\"), Block if any of the policies blocks actions by default",
+							Computed:            true,
+						},
+						"action_rules": schema.ListNestedAttribute{
+							MarkdownDescription: "Combined action rules for the connector, an action blocked by any of the policies is blocked",
+							Description:         "Combined action rules for the connector, an action blocked by any of the policies is blocked",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"action_id": schema.StringAttribute{
+										MarkdownDescription: "ID of the action rule",
+										Description:         "ID of the action rule",
+										Computed:            true,
+									},
+									"behavior": schema.StringAttribute{
+										MarkdownDescription: "Behavior of the action rule (\"Allow\", \"Block\")",
+										Description:         "Behavior of the action rule (\"Allow\", \"Block\")",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"endpoint_rules": schema.ListNestedAttribute{
+							MarkdownDescription: "Combined endpoint rules for the connector. The rules of all policies are evaluated in the order of the policies, so an endpoint has to be allowed by every policy",
+							Description:         "Combined endpoint rules for the connector. The rules of all policies are evaluated in the order of the policies, so an endpoint has to be allowed by every policy",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"order": schema.Int64Attribute{
+										MarkdownDescription: "Order of the endpoint rule",
+										Description:         "Order of the endpoint rule",
+										Computed:            true,
+									},
+									"behavior": schema.StringAttribute{
+										MarkdownDescription: "Behavior of the endpoint rule (\"Allow\", \"Deny\")",
+										Description:         "Behavior of the endpoint rule (\"Allow\", \"Deny\")",
+										Computed:            true,
+									},
+									"endpoint": schema.StringAttribute{
+										MarkdownDescription: "Endpoint of the endpoint rule",
+										Description:         "Endpoint of the endpoint rule",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DataLossPreventionEffectiveClassificationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client := req.ProviderData.(*api.ProviderClient).Api
+
+	if client == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.DlpPolicyClient = NewDlpPolicyClient(client)
+}
+
+func (d *DataLossPreventionEffectiveClassificationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DataLossPreventionEffectiveClassificationDataSourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE EFFECTIVE CLASSIFICATION START: %s_%s", d.ProviderTypeName, d.TypeName))
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := d.DlpPolicyClient.GetPolicies(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s_%s", d.ProviderTypeName, d.TypeName), err.Error())
+		return
+	}
+
+	state.Id = types.StringValue(state.EnvironmentId.ValueString())
+	state.Policies = []string{}
+	applicablePolicies := []DlpPolicyModelDto{}
+	for _, policy := range policies {
+		if policy.AppliesToEnvironment(state.EnvironmentId.ValueString()) {
+			applicablePolicies = append(applicablePolicies, policy)
+			state.Policies = append(state.Policies, policy.Name)
+		}
+	}
+
+	state.Connectors = []DataLossPreventionEffectiveConnectorModel{}
+	for _, effectiveConnector := range evaluateDlpPolicies(applicablePolicies) {
+		state.Connectors = append(state.Connectors, DataLossPreventionEffectiveConnectorModel{
+			Id:                        types.StringValue(effectiveConnector.Connector.Id),
+			Classification:            types.StringValue(convertConnectorGroupClassificationToDataGroup(effectiveConnector.Classification)),
+			Policies:                  effectiveConnector.Policies,
+			DefaultActionRuleBehavior: types.StringValue(effectiveConnector.Connector.DefaultActionRuleBehavior),
+			ActionRules:               types.ListValueMust(actionRuleListObjectType, convertToAtrValueActionRule(effectiveConnector.Connector)),
+			EndpointRules:             types.ListValueMust(endpointRuleListObjectType, convertToAtrValueEndpointRule(effectiveConnector.Connector)),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE EFFECTIVE CLASSIFICATION END: %s_%s", d.ProviderTypeName, d.TypeName))
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// evaluateDlpPolicies combines the connector groups and connector rules of the given policies into the effective classification of every connector.
+func evaluateDlpPolicies(policies []DlpPolicyModelDto) []DlpEffectiveConnectorDto {
+	connectorIds := []string{}
+	seen := map[string]bool{}
+	for _, policy := range policies {
+		for _, connectorGroup := range policy.ConnectorGroups {
+			for _, connector := range connectorGroup.Connectors {
+				key := strings.ToLower(connector.Id)
+				if !seen[key] {
+					seen[key] = true
+					connectorIds = append(connectorIds, connector.Id)
+				}
+			}
+		}
+	}
+	sort.Slice(connectorIds, func(i, j int) bool {
+		return strings.ToLower(connectorIds[i]) < strings.ToLower(connectorIds[j])
+	})
+
+	effectiveConnectors := []DlpEffectiveConnectorDto{}
+	for _, connectorId := range connectorIds {
+		effective := DlpEffectiveConnectorDto{
+			Connector: DlpConnectorModelDto{
+				Id:            connectorId,
+				ActionRules:   []DlpActionRuleDto{},
+				EndpointRules: []DlpEndpointRuleDto{},
+			},
+			Policies: []string{},
+		}
+
+		actionRuleIndexes := map[string]int{}
+		for _, policy := range policies {
+			classification, connector := classifyDlpConnector(policy, connectorId)
+
+			if dlpClassificationRestrictiveness[classification] > dlpClassificationRestrictiveness[effective.Classification] {
+				effective.Classification = classification
+				effective.Policies = []string{policy.Name}
+			} else if classification == effective.Classification {
+				effective.Policies = append(effective.Policies, policy.Name)
+			}
+
+			if connector == nil {
+				continue
+			}
+
+			if connector.DefaultActionRuleBehavior == "Block" || (connector.DefaultActionRuleBehavior == "Allow" && effective.Connector.DefaultActionRuleBehavior == "") {
+				effective.Connector.DefaultActionRuleBehavior = connector.DefaultActionRuleBehavior
+			}
+
+			for _, actionRule := range connector.ActionRules {
+				if inx, ok := actionRuleIndexes[actionRule.ActionId]; ok {
+					if actionRule.Behavior == "Block" {
+						effective.Connector.ActionRules[inx].Behavior = actionRule.Behavior
+					}
+					continue
+				}
+				actionRuleIndexes[actionRule.ActionId] = len(effective.Connector.ActionRules)
+				effective.Connector.ActionRules = append(effective.Connector.ActionRules, actionRule)
+			}
+
+			for _, endpointRule := range connector.EndpointRules {
+				endpointRule.Order = int64(len(effective.Connector.EndpointRules) + 1)
+				effective.Connector.EndpointRules = append(effective.Connector.EndpointRules, endpointRule)
+			}
+		}
+		effectiveConnectors = append(effectiveConnectors, effective)
+	}
+	return effectiveConnectors
+}
+
+// classifyDlpConnector returns the connector group classification of a connector in a policy, and the connector with its rules if it is classified explicitly.
+func classifyDlpConnector(policy DlpPolicyModelDto, connectorId string) (string, *DlpConnectorModelDto) {
+	for _, connectorGroup := range policy.ConnectorGroups {
+		for inx, connector := range connectorGroup.Connectors {
+			if strings.EqualFold(connector.Id, connectorId) {
+				return connectorGroup.Classification, &connectorGroup.Connectors[inx]
+			}
+		}
+	}
+	return policy.DefaultConnectorsClassification, nil
+}
+
+func convertConnectorGroupClassificationToDataGroup(classification string) string {
+	switch classification {
+	case "Confidential":
+		return "Business"
+	case "General":
+		return "NonBusiness"
+	default:
+		return classification
+	}
+}
@@ -6,11 +6,13 @@ package powerplatform
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -40,7 +42,60 @@ func (d *DataLossPreventionPolicyDataSource) Metadata(_ context.Context, req dat
 }
 
 func (d *DataLossPreventionPolicyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Fetches the list of Data Loss Prevention Policies in a Power Platform tenant",
+		MarkdownDescription: "Fetches the list of Data Loss Prevention Policies in a Power Platform tenant. See [Manage data loss prevention policies](https://learn.microsoft.com/power-platform/admin/prevent-data-loss) for more information.\n\nThe optional filter arguments are combined, only policies matching all of them are returned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Id of the read operation",
+				MarkdownDescription: "Id of the read operation",
+				Computed:            true,
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Only return policies with this display name",
+				Description:         "Only return policies with this display name",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("display_name_regex")),
+				},
+			},
+			"display_name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return policies with a display name matching this regular expression",
+				Description:         "Only return policies with a display name matching this regular expression",
+				Optional:            true,
+			},
+			"environment_type": schema.StringAttribute{
+				MarkdownDescription: "Only return policies with this environment handling (\"AllEnvironments\", \"ExceptEnvironments\", \"OnlyEnvironments\")",
+				Description:         "Only return policies with this environment handling (\"AllEnvironments\", \"ExceptEnvironments\", \"OnlyEnvironments\")",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("AllEnvironments", "ExceptEnvironments", "OnlyEnvironments"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Only return policies that apply to this environment. Policies for all environments, policies that do not exclude the environment and policies that include the environment apply to it",
+				Description:         "Only return policies that apply to this environment. Policies for all environments, policies that do not exclude the environment and policies that include the environment apply to it",
+				Optional:            true,
+			},
+			"connector_id": schema.StringAttribute{
+				MarkdownDescription: "Only return policies that classify this connector as business, non-business or blocked, for example `/providers/Microsoft.PowerApps/apis/shared_sql`",
+				Description:         "Only return policies that classify this connector as business, non-business or blocked",
+				Optional:            true,
+			},
+			"policies": schema.ListNestedAttribute{
+				Description:         "List of Data Loss Prevention Policies",
+				MarkdownDescription: "List of Data Loss Prevention Policies",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: dlpPolicyDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
 
+// dlpPolicyDataSourceAttributes returns the computed attributes of a policy, shared by the policy data sources.
+func dlpPolicyDataSourceAttributes() map[string]schema.Attribute {
 	connectorSchema := schema.NestedAttributeObject{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -109,117 +164,104 @@ func (d *DataLossPreventionPolicyDataSource) Schema(_ context.Context, _ datasou
 		},
 	}
 
-	resp.Schema = schema.Schema{
-		Description:         "Fetches the list of Data Loss Prevention Policies in a Power Platform tenant",
-		MarkdownDescription: "Fetches the list of Data Loss Prevention Policies in a Power Platform tenant. See [Manage data loss prevention policies](https://learn.microsoft.com/power-platform/admin/prevent-data-loss) for more information.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description:         "Id of the read operation",
-				MarkdownDescription: "Id of the read operation",
-				Computed:            true,
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Unique name of the policy",
+			Description:         "Unique name of the policy",
+			Computed:            true,
+		},
+		"display_name": schema.StringAttribute{
+			MarkdownDescription: "Display name of the policy",
+			Description:         "The display name of the policy",
+			Computed:            true,
+		},
+		"scope": schema.StringAttribute{
+			MarkdownDescription: "Scope of the policy (\"tenant\", \"environment\")",
+			Description:         "Scope of the policy (\"tenant\", \"environment\")",
+			Computed:            true,
+		},
+		"created_by": schema.StringAttribute{
+			MarkdownDescription: "User who created the policy",
+			Description:         "User who created the policy",
+			Computed:            true,
+		},
+		"created_time": schema.StringAttribute{
+			MarkdownDescription: "Time when the policy was created",
+			Description:         "Time when the policy was created",
+			Computed:            true,
+		},
+		"last_modified_by": schema.StringAttribute{
+			MarkdownDescription: "User who last modified the policy",
+			Description:         "User who last modified the policy",
+			Computed:            true,
+		},
+		"last_modified_time": schema.StringAttribute{
+			MarkdownDescription: "Time when the policy was last modified",
+			Description:         "Time when the policy was last modified",
+			Computed:            true,
+		},
+		"environment_type": schema.StringAttribute{
+			MarkdownDescription: "Default environment handling for the policy (\"AllEnvironments\", \"ExceptEnvironments\", \"OnlyEnvironments\")",
+			Description:         "Default environment handling for the policy (\"AllEnvironments\", \"ExceptEnvironments\", \"OnlyEnvironments\")",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("AllEnvironments", "ExceptEnvironments", "OnlyEnvironments"),
 			},
-			"policies": schema.ListNestedAttribute{
-				Description:         "List of Data Loss Prevention Policies",
-				MarkdownDescription: "List of Data Loss Prevention Policies",
-				Computed:            true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							MarkdownDescription: "Unique name of the policy",
-							Description:         "Unique name of the policy",
-							Computed:            true,
-						},
-						"display_name": schema.StringAttribute{
-							MarkdownDescription: "Display name of the policy",
-							Description:         "The display name of the policy",
-							Computed:            true,
-						},
-						"created_by": schema.StringAttribute{
-							MarkdownDescription: "User who created the policy",
-							Description:         "User who created the policy",
-							Computed:            true,
-						},
-						"created_time": schema.StringAttribute{
-							MarkdownDescription: "Time when the policy was created",
-							Description:         "Time when the policy was created",
-							Computed:            true,
-						},
-						"last_modified_by": schema.StringAttribute{
-							MarkdownDescription: "User who last modified the policy",
-							Description:         "User who last modified the policy",
-							Computed:            true,
-						},
-						"last_modified_time": schema.StringAttribute{
-							MarkdownDescription: "Time when the policy was last modified",
-							Description:         "Time when the policy was last modified",
-							Computed:            true,
-						},
-						"environment_type": schema.StringAttribute{
-							MarkdownDescription: "Default environment handling for the policy (\"AllEnvironments\", \"ExceptEnvironments\", \"OnlyEnvironments\")",
-							Description:         "Default environment handling for the policy (\"AllEnvironments\", \"ExceptEnvironments\", \"OnlyEnvironments\")",
-							Required:            true,
-							Validators: []validator.String{
-								stringvalidator.OneOf("AllEnvironments", "ExceptEnvironments", "OnlyEnvironments"),
-							},
-						},
-						"default_connectors_classification": schema.StringAttribute{
-							MarkdownDescription: "Default classification for connectors (\"General\", \"Confidential\", \"Blocked\")",
-							Description:         "Default classification for connectors (\"General\", \"Confidential\", \"Blocked\")",
-							Computed:            true,
-							Validators: []validator.String{
-								stringvalidator.OneOf("General", "Confidential", "Blocked"),
-							},
-						},
-						"environments": schema.SetAttribute{
-							Description:         "Environment to which the policy is applied",
-							MarkdownDescription: "Environment to which the policy is applied",
-							ElementType:         types.StringType,
-							Computed:            true,
-						},
-						"business_connectors": schema.SetNestedAttribute{
-							MarkdownDescription: "Connectors for sensitive data",
-							Description:         "Connectors for sensitive data",
-							Computed:            true,
-							NestedObject:        connectorSchema,
-						},
-						"non_business_connectors": schema.SetNestedAttribute{
-							MarkdownDescription: "Connectors for non-sensitive data",
-							Description:         "Connectors for non-sensitive data",
-							Computed:            true,
-							NestedObject:        connectorSchema,
-						},
-						"blocked_connectors": schema.SetNestedAttribute{
-							MarkdownDescription: "Blocked connectors can’t be used where this policy is applied.",
-							Description:         "Blocked connectors can’t be used where this policy is applied.",
-							Computed:            true,
-							NestedObject:        connectorSchema,
-						},
-						"custom_connectors_patterns": schema.SetNestedAttribute{
-							MarkdownDescription: "Custom connectors patterns",
-							Description:         "Custom connectors patterns",
-							Computed:            true,
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"order": schema.Int64Attribute{
-										MarkdownDescription: "Order of the connector",
-										Description:         "Order of the connector",
-										Computed:            true,
-									},
-									"host_url_pattern": schema.StringAttribute{
-										MarkdownDescription: "Pattern of the connector",
-										Description:         "Pattern of the connector",
-										Computed:            true,
-									},
-									"data_group": schema.StringAttribute{
-										MarkdownDescription: "Data group of the connector (\"Business\", \"NonBusiness\", \"Blocked\", \"Ignore\")",
-										Description:         "Data group of the connector (\"Business\", \"NonBusiness\", \"Blocked\", \"Ignore\")",
-										Computed:            true,
-										Validators: []validator.String{
-											stringvalidator.OneOf("Business", "NonBusiness", "Blocked", "Ignore"),
-										},
-									},
-								},
-							},
+		},
+		"default_connectors_classification": schema.StringAttribute{
+			MarkdownDescription: "Default classification for connectors (\"General\", \"Confidential\", \"Blocked\")",
+			Description:         "Default classification for connectors (\"General\", \"Confidential\", \"Blocked\")",
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("General", "Confidential", "Blocked"),
+			},
+		},
+		"environments": schema.SetAttribute{
+			Description:         "Environment to which the policy is applied",
+			MarkdownDescription: "Environment to which the policy is applied",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"business_connectors": schema.SetNestedAttribute{
+			MarkdownDescription: "Connectors for sensitive data",
+			Description:         "Connectors for sensitive data",
+			Computed:            true,
+			NestedObject:        connectorSchema,
+		},
+		"non_business_connectors": schema.SetNestedAttribute{
+			MarkdownDescription: "Connectors for non-sensitive data",
+			Description:         "Connectors for non-sensitive data",
+			Computed:            true,
+			NestedObject:        connectorSchema,
+		},
+		"blocked_connectors": schema.SetNestedAttribute{
+			MarkdownDescription: "Blocked connectors can’t be used where this policy is applied.",
+			Description:         "Blocked connectors can’t be used where this policy is applied.",
+			Computed:            true,
+			NestedObject:        connectorSchema,
+		},
+		"custom_connectors_patterns": schema.SetNestedAttribute{
+			MarkdownDescription: "Custom connectors patterns",
+			Description:         "Custom connectors patterns",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"order": schema.Int64Attribute{
+						MarkdownDescription: "Order of the connector",
+						Description:         "Order of the connector",
+						Computed:            true,
+					},
+					"host_url_pattern": schema.StringAttribute{
+						MarkdownDescription: "Pattern of the connector",
+						Description:         "Pattern of the connector",
+						Computed:            true,
+					},
+					"data_group": schema.StringAttribute{
+						MarkdownDescription: "Data group of the connector (\"Business\", \"NonBusiness\", \"Blocked\", \"Ignore\")",
+						Description:         "Data group of the connector (\"Business\", \"NonBusiness\", \"Blocked\", \"Ignore\")",
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("Business", "NonBusiness", "Blocked", "Ignore"),
 						},
 					},
 				},
@@ -252,33 +294,49 @@ func (d *DataLossPreventionPolicyDataSource) Read(ctx context.Context, req datas
 
 	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE POLICIES START: %s_%s", d.ProviderTypeName, d.TypeName))
 
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var displayNameRegex *regexp.Regexp
+	if !state.DisplayNameRegex.IsNull() {
+		var err error
+		displayNameRegex, err = regexp.Compile(state.DisplayNameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("display_name_regex"), "Invalid regular expression", err.Error())
+			return
+		}
+	}
+
 	policies, err := d.DlpPolicyClient.GetPolicies(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s_%s", d.ProviderTypeName, d.TypeName), err.Error())
 		return
 	}
 
-	state.Id = types.StringValue(strconv.Itoa(len(policies)))
-
+	state.Policies = []DataLossPreventionPolicyDataSourceModel{}
 	for _, policy := range policies {
-
-		policyModel := DataLossPreventionPolicyResourceModel{}
-		policyModel.Id = types.StringValue(policy.Name)
-		policyModel.DefaultConnectorsClassification = types.StringValue(policy.DefaultConnectorsClassification)
-		policyModel.DisplayName = types.StringValue(policy.DisplayName)
-		policyModel.CreatedBy = types.StringValue(policy.CreatedBy)
-		policyModel.CreatedTime = types.StringValue(policy.CreatedTime)
-		policyModel.LastModifiedBy = types.StringValue(policy.LastModifiedBy)
-		policyModel.LastModifiedTime = types.StringValue(policy.LastModifiedTime)
-		policyModel.EnvironmentType = types.StringValue(policy.EnvironmentType)
-		policyModel.Environments = convertToAttrValueEnvironments(policy.Environments)
-		policyModel.CustomConnectorsPatterns = convertToAttrValueCustomConnectorUrlPatternsDefinition(policy.CustomConnectorUrlPatternsDefinition)
-		policyModel.BusinessGeneralConnectors = convertToAttrValueConnectorsGroup("Confidential", policy.ConnectorGroups)
-		policyModel.NonBusinessConfidentialConnectors = convertToAttrValueConnectorsGroup("General", policy.ConnectorGroups)
-		policyModel.BlockedConnectors = convertToAttrValueConnectorsGroup("Blocked", policy.ConnectorGroups)
-		state.Policies = append(state.Policies, policyModel)
+		if !state.DisplayName.IsNull() && policy.DisplayName != state.DisplayName.ValueString() {
+			continue
+		}
+		if displayNameRegex != nil && !displayNameRegex.MatchString(policy.DisplayName) {
+			continue
+		}
+		if !state.EnvironmentType.IsNull() && policy.modelEnvironmentType() != state.EnvironmentType.ValueString() {
+			continue
+		}
+		if !state.EnvironmentId.IsNull() && !policy.AppliesToEnvironment(state.EnvironmentId.ValueString()) {
+			continue
+		}
+		if !state.ConnectorId.IsNull() && !policy.ClassifiesConnector(state.ConnectorId.ValueString()) {
+			continue
+		}
+		state.Policies = append(state.Policies, convertToDlpPolicyDataSourceModel(policy))
 	}
 
+	state.Id = types.StringValue(strconv.Itoa(len(state.Policies)))
+
 	diags := resp.State.Set(ctx, &state)
 
 	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE POLICIES END: %s_%s", d.ProviderTypeName, d.TypeName))
@@ -288,3 +346,22 @@ func (d *DataLossPreventionPolicyDataSource) Read(ctx context.Context, req datas
 		return
 	}
 }
+
+func convertToDlpPolicyDataSourceModel(policy DlpPolicyModelDto) DataLossPreventionPolicyDataSourceModel {
+	policyModel := DataLossPreventionPolicyDataSourceModel{}
+	policyModel.Id = types.StringValue(policy.Name)
+	policyModel.Scope = types.StringValue(policy.Scope())
+	policyModel.DefaultConnectorsClassification = types.StringValue(policy.DefaultConnectorsClassification)
+	policyModel.DisplayName = types.StringValue(policy.DisplayName)
+	policyModel.CreatedBy = types.StringValue(policy.CreatedBy)
+	policyModel.CreatedTime = types.StringValue(policy.CreatedTime)
+	policyModel.LastModifiedBy = types.StringValue(policy.LastModifiedBy)
+	policyModel.LastModifiedTime = types.StringValue(policy.LastModifiedTime)
+	policyModel.EnvironmentType = types.StringValue(policy.modelEnvironmentType())
+	policyModel.Environments = convertToAttrValueEnvironments(policy.Environments)
+	policyModel.CustomConnectorsPatterns = convertToAttrValueCustomConnectorUrlPatternsDefinition(policy.CustomConnectorUrlPatternsDefinition)
+	policyModel.BusinessGeneralConnectors = convertToAttrValueConnectorsGroup("Confidential", policy.ConnectorGroups)
+	policyModel.NonBusinessConfidentialConnectors = convertToAttrValueConnectorsGroup("General", policy.ConnectorGroups)
+	policyModel.BlockedConnectors = convertToAttrValueConnectorsGroup("Blocked", policy.ConnectorGroups)
+	return policyModel
+}
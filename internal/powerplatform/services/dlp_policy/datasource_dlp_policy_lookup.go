@@ -0,0 +1,145 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+var (
+	_ datasource.DataSource              = &DataLossPreventionPolicyLookupDataSource{}
+	_ datasource.DataSourceWithConfigure = &DataLossPreventionPolicyLookupDataSource{}
+)
+
+func NewDataLossPreventionPolicyLookupDataSource() datasource.DataSource {
+	return &DataLossPreventionPolicyLookupDataSource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_data_loss_prevention_policy",
+	}
+}
+
+type DataLossPreventionPolicyLookupDataSource struct {
+	DlpPolicyClient  DlpPolicyClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+func (d *DataLossPreventionPolicyLookupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + d.TypeName
+}
+
+func (d *DataLossPreventionPolicyLookupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := dlpPolicyDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Unique name of the policy. Either `id` or `display_name` has to be provided",
+		Description:         "Unique name of the policy. Either `id` or `display_name` has to be provided",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("display_name")),
+		},
+	}
+	attributes["display_name"] = schema.StringAttribute{
+		MarkdownDescription: "Display name of the policy. Either `id` or `display_name` has to be provided, the display name has to be unique in the tenant",
+		Description:         "Display name of the policy. Either `id` or `display_name` has to be provided, the display name has to be unique in the tenant",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["environment_type"] = schema.StringAttribute{
+		MarkdownDescription: "Default environment handling for the policy (\"AllEnvironments\", \"ExceptEnvironments\", \"OnlyEnvironments\")",
+		Description:         "Default environment handling for the policy (\"AllEnvironments\", \"ExceptEnvironments\", \"OnlyEnvironments\")",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Fetches a single Data Loss Prevention Policy in a Power Platform tenant by its id or display name",
+		MarkdownDescription: "Fetches a single Data Loss Prevention Policy in a Power Platform tenant by its id or display name. See [Manage data loss prevention policies](https://learn.microsoft.com/power-platform/admin/prevent-data-loss) for more information.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *DataLossPreventionPolicyLookupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client := req.ProviderData.(*api.ProviderClient).Api
+
+	if client == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.DlpPolicyClient = NewDlpPolicyClient(client)
+}
+
+func (d *DataLossPreventionPolicyLookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DataLossPreventionPolicyDataSourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE POLICY START: %s_%s", d.ProviderTypeName, d.TypeName))
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var policy *DlpPolicyModelDto
+	if !config.Id.IsNull() {
+		var err error
+		policy, err = d.DlpPolicyClient.GetPolicy(ctx, "", config.Id.ValueString())
+		if err != nil {
+			if powerplatform_helpers.Code(err) == powerplatform_helpers.ERROR_OBJECT_NOT_FOUND {
+				resp.Diagnostics.AddError("Data Loss Prevention Policy not found", fmt.Sprintf("No policy with id '%s' exists", config.Id.ValueString()))
+				return
+			}
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s_%s", d.ProviderTypeName, d.TypeName), err.Error())
+			return
+		}
+	} else {
+		policies, err := d.DlpPolicyClient.GetPolicies(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s_%s", d.ProviderTypeName, d.TypeName), err.Error())
+			return
+		}
+		for inx, p := range policies {
+			if p.DisplayName != config.DisplayName.ValueString() {
+				continue
+			}
+			if policy != nil {
+				resp.Diagnostics.AddError("Multiple Data Loss Prevention Policies found", fmt.Sprintf("More than one policy with display name '%s' exists, use the id to look up the policy", config.DisplayName.ValueString()))
+				return
+			}
+			policy = &policies[inx]
+		}
+		if policy == nil {
+			resp.Diagnostics.AddError("Data Loss Prevention Policy not found", fmt.Sprintf("No policy with display name '%s' exists", config.DisplayName.ValueString()))
+			return
+		}
+	}
+
+	state := convertToDlpPolicyDataSourceModel(*policy)
+
+	diags := resp.State.Set(ctx, &state)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE POLICY END: %s_%s", d.ProviderTypeName, d.TypeName))
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
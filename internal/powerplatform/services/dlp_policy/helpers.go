@@ -5,6 +5,9 @@ package powerplatform
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -75,6 +78,65 @@ func covertDlpPolicyToPolicyModelDto(policy DlpPolicyDto) (*DlpPolicyModelDto, e
 	return &policyModel, nil
 }
 
+// convertPolicyDefinitionJsonToPolicyModelDto parses a native v2 policy definition, as exported from the admin center or with Get-DlpPolicy.
+func convertPolicyDefinitionJsonToPolicyModelDto(policyDefinitionJson string) (*DlpPolicyModelDto, error) {
+	policy := DlpPolicyDto{}
+	err := json.Unmarshal([]byte(policyDefinitionJson), &policy)
+	if err != nil {
+		return nil, err
+	}
+	if policy.PolicyDefinition.DisplayName == "" {
+		return nil, errors.New("the policy definition has no 'policyDefinition.displayName'")
+	}
+	return covertDlpPolicyToPolicyModelDto(policy)
+}
+
+// convertPolicyModelDtoToCanonicalJson returns the native v2 policy definition without the name and audit fields of the policy.
+// Connectors and url patterns are sorted and the keys are ordered, so that equal policies have equal definitions.
+func convertPolicyModelDtoToCanonicalJson(policy DlpPolicyModelDto) string {
+	connectorGroups := []DlpConnectorGroupsModelDto{}
+	for _, connectorGroup := range policy.ConnectorGroups {
+		connectors := append([]DlpConnectorModelDto{}, connectorGroup.Connectors...)
+		sort.Slice(connectors, func(i, j int) bool {
+			return connectors[i].Id < connectors[j].Id
+		})
+		connectorGroups = append(connectorGroups, DlpConnectorGroupsModelDto{
+			Classification: connectorGroup.Classification,
+			Connectors:     connectors,
+		})
+	}
+	policy.ConnectorGroups = connectorGroups
+
+	urlPatterns := append([]DlpConnectorUrlPatternsDefinitionDto{}, policy.CustomConnectorUrlPatternsDefinition...)
+	sort.Slice(urlPatterns, func(i, j int) bool {
+		return urlPatterns[i].Rules[0].Order < urlPatterns[j].Rules[0].Order
+	})
+	policy.CustomConnectorUrlPatternsDefinition = urlPatterns
+
+	policyDefinition, err := json.Marshal(convertPolicyModelToDlpPolicy(policy))
+	if err != nil {
+		return ""
+	}
+
+	//the definition is round tripped through a map, as maps are marshalled with sorted keys
+	canonicalPolicyDefinition := map[string]interface{}{}
+	err = json.Unmarshal(policyDefinition, &canonicalPolicyDefinition)
+	if err != nil {
+		return ""
+	}
+	if definition, ok := canonicalPolicyDefinition["policyDefinition"].(map[string]interface{}); ok {
+		for _, key := range []string{"name", "etag", "createdBy", "createdTime", "lastModifiedBy", "lastModifiedTime"} {
+			delete(definition, key)
+		}
+	}
+
+	canonicalJson, err := json.Marshal(canonicalPolicyDefinition)
+	if err != nil {
+		return ""
+	}
+	return string(canonicalJson)
+}
+
 func convertConnectorRuleClassificationValues(value string) string {
 	if value == "Business" {
 		return "General"
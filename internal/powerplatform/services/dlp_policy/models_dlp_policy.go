@@ -4,6 +4,8 @@
 package powerplatform
 
 import (
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -124,12 +126,37 @@ type DlpActionRuleDto struct {
 }
 
 type PoliciesListDataSourceModel struct {
-	Id       types.String                            `tfsdk:"id"`
-	Policies []DataLossPreventionPolicyResourceModel `tfsdk:"policies"`
+	Id               types.String                              `tfsdk:"id"`
+	DisplayName      types.String                              `tfsdk:"display_name"`
+	DisplayNameRegex types.String                              `tfsdk:"display_name_regex"`
+	EnvironmentType  types.String                              `tfsdk:"environment_type"`
+	EnvironmentId    types.String                              `tfsdk:"environment_id"`
+	ConnectorId      types.String                              `tfsdk:"connector_id"`
+	Policies         []DataLossPreventionPolicyDataSourceModel `tfsdk:"policies"`
+}
+
+type DataLossPreventionPolicyDataSourceModel struct {
+	Id                                types.String `tfsdk:"id"`
+	Scope                             types.String `tfsdk:"scope"`
+	DisplayName                       types.String `tfsdk:"display_name"`
+	DefaultConnectorsClassification   types.String `tfsdk:"default_connectors_classification"`
+	EnvironmentType                   types.String `tfsdk:"environment_type"`
+	CreatedBy                         types.String `tfsdk:"created_by"`
+	CreatedTime                       types.String `tfsdk:"created_time"`
+	LastModifiedBy                    types.String `tfsdk:"last_modified_by"`
+	LastModifiedTime                  types.String `tfsdk:"last_modified_time"`
+	Environments                      []string     `tfsdk:"environments"`
+	NonBusinessConfidentialConnectors types.Set    `tfsdk:"non_business_connectors"`
+	BusinessGeneralConnectors         types.Set    `tfsdk:"business_connectors"`
+	BlockedConnectors                 types.Set    `tfsdk:"blocked_connectors"`
+	CustomConnectorsPatterns          types.Set    `tfsdk:"custom_connectors_patterns"`
 }
 
 type DataLossPreventionPolicyResourceModel struct {
 	Id                                types.String `tfsdk:"id"`
+	Scope                             types.String `tfsdk:"scope"`
+	PolicyDefinitionJson              types.String `tfsdk:"policy_definition_json"`
+	CanonicalPolicyDefinitionJson     types.String `tfsdk:"canonical_policy_definition_json"`
 	DisplayName                       types.String `tfsdk:"display_name"`
 	DefaultConnectorsClassification   types.String `tfsdk:"default_connectors_classification"`
 	EnvironmentType                   types.String `tfsdk:"environment_type"`
@@ -144,6 +171,14 @@ type DataLossPreventionPolicyResourceModel struct {
 	CustomConnectorsPatterns          types.Set    `tfsdk:"custom_connectors_patterns"`
 }
 
+// scopeEnvironmentId returns the environment of an environment-level policy, or an empty string for tenant-level policies.
+func (model *DataLossPreventionPolicyResourceModel) scopeEnvironmentId() string {
+	if model.Scope.ValueString() != DLP_POLICY_SCOPE_ENVIRONMENT || len(model.Environments) == 0 {
+		return ""
+	}
+	return model.Environments[0]
+}
+
 type DataLossPreventionPolicyResourceCustomConnectorPattern struct {
 	Order          types.Int64  `tfsdk:"order"`
 	HostUrlPattern types.String `tfsdk:"host_url_pattern"`
@@ -208,4 +243,63 @@ var actionRuleListObjectType = types.ObjectType{
 		"action_id": types.StringType,
 		"behavior":  types.StringType,
 	},
-}
\ No newline at end of file
+}
+
+// Scope returns the scope of the policy, the tenant policies api reports environment-level policies with the SingleEnvironment environment type.
+func (policy *DlpPolicyModelDto) Scope() string {
+	if policy.EnvironmentType == DLP_POLICY_ENVIRONMENT_TYPE_SINGLE_ENVIRONMENT {
+		return DLP_POLICY_SCOPE_ENVIRONMENT
+	}
+	return DLP_POLICY_SCOPE_TENANT
+}
+
+// modelEnvironmentType returns the environment type of the policy as it is modelled in terraform,
+// environment-level policies are modelled as policies applied to OnlyEnvironments.
+func (policy *DlpPolicyModelDto) modelEnvironmentType() string {
+	if policy.EnvironmentType == DLP_POLICY_ENVIRONMENT_TYPE_SINGLE_ENVIRONMENT {
+		return DLP_POLICY_ENVIRONMENT_TYPE_ONLY_ENVIRONMENTS
+	}
+	return policy.EnvironmentType
+}
+
+// convertToApiEnvironmentType returns the environment type sent to the api for the modelled scope and environment type of a policy.
+func convertToApiEnvironmentType(scope string, environmentType string) string {
+	if scope == DLP_POLICY_SCOPE_ENVIRONMENT {
+		return DLP_POLICY_ENVIRONMENT_TYPE_SINGLE_ENVIRONMENT
+	}
+	return environmentType
+}
+
+// AppliesToEnvironment returns true if the policy is enforced in the given environment.
+func (policy *DlpPolicyModelDto) AppliesToEnvironment(environmentId string) bool {
+	listed := false
+	for _, environment := range policy.Environments {
+		if strings.EqualFold(environment.Name, environmentId) {
+			listed = true
+			break
+		}
+	}
+
+	switch policy.EnvironmentType {
+	case "AllEnvironments":
+		return true
+	case "ExceptEnvironments":
+		return !listed
+	case "OnlyEnvironments", DLP_POLICY_ENVIRONMENT_TYPE_SINGLE_ENVIRONMENT:
+		return listed
+	default:
+		return false
+	}
+}
+
+// ClassifiesConnector returns true if the connector is explicitly assigned to one of the connector groups of the policy.
+func (policy *DlpPolicyModelDto) ClassifiesConnector(connectorId string) bool {
+	for _, connectorGroup := range policy.ConnectorGroups {
+		for _, connector := range connectorGroup.Connectors {
+			if strings.EqualFold(connector.Id, connectorId) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -6,22 +6,40 @@ package powerplatform
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
 	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+	connectors "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/connectors"
 )
 
 var _ resource.Resource = &DataLossPreventionPolicyResource{}
 var _ resource.ResourceWithImportState = &DataLossPreventionPolicyResource{}
+var _ resource.ResourceWithValidateConfig = &DataLossPreventionPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &DataLossPreventionPolicyResource{}
+
+const (
+	DLP_POLICY_SCOPE_TENANT      = "tenant"
+	DLP_POLICY_SCOPE_ENVIRONMENT = "environment"
+
+	DLP_POLICY_ENVIRONMENT_TYPE_ONLY_ENVIRONMENTS  = "OnlyEnvironments"
+	DLP_POLICY_ENVIRONMENT_TYPE_SINGLE_ENVIRONMENT = "SingleEnvironment"
+)
 
 func NewDataLossPreventionPolicyResource() resource.Resource {
 	return &DataLossPreventionPolicyResource{
@@ -32,6 +50,7 @@ func NewDataLossPreventionPolicyResource() resource.Resource {
 
 type DataLossPreventionPolicyResource struct {
 	DlpPolicyClient  DlpPolicyClient
+	ConnectorsClient connectors.ConnectorsClient
 	ProviderTypeName string
 	TypeName         string
 }
@@ -122,10 +141,34 @@ func (r *DataLossPreventionPolicyResource) Schema(ctx context.Context, req resou
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"policy_definition_json": schema.StringAttribute{
+				MarkdownDescription: "Native policy definition in JSON, as exported from the Power Platform admin center or with `Get-DlpPolicy`. When set, the display name, environments and connector classifications of the policy are taken from the definition and the corresponding attributes must not be set",
+				Description:         "Native policy definition in JSON, as exported from the Power Platform admin center or with Get-DlpPolicy. When set, the display name, environments and connector classifications of the policy are taken from the definition and the corresponding attributes must not be set",
+				Optional:            true,
+			},
+			"canonical_policy_definition_json": schema.StringAttribute{
+				MarkdownDescription: "Canonical JSON of the policy definition, without the name and the audit fields of the policy, for auditing and for moving the policy to another tenant",
+				Description:         "Canonical JSON of the policy definition, without the name and the audit fields of the policy, for auditing and for moving the policy to another tenant",
+				Computed:            true,
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Scope of the policy (\"tenant\", \"environment\"). Tenant-level policies require tenant admin rights. Environment-level policies can be managed by the admins of the environment, they apply to the single environment set in `environments` and require `environment_type` to be `OnlyEnvironments`",
+				Description:         "Scope of the policy (\"tenant\", \"environment\"). Tenant-level policies require tenant admin rights. Environment-level policies can be managed by the admins of the environment, they apply to the single environment set in `environments` and require `environment_type` to be `OnlyEnvironments`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(DLP_POLICY_SCOPE_TENANT),
+				Validators: []validator.String{
+					stringvalidator.OneOf(DLP_POLICY_SCOPE_TENANT, DLP_POLICY_SCOPE_ENVIRONMENT),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"display_name": schema.StringAttribute{
-				MarkdownDescription: "Display name of the policy",
-				Description:         "The display name of the policy",
-				Required:            true,
+				MarkdownDescription: "Display name of the policy. Required unless `policy_definition_json` is set",
+				Description:         "The display name of the policy. Required unless `policy_definition_json` is set",
+				Optional:            true,
+				Computed:            true,
 			},
 			"created_by": schema.StringAttribute{
 				MarkdownDescription: "User who created the policy",
@@ -154,17 +197,19 @@ func (r *DataLossPreventionPolicyResource) Schema(ctx context.Context, req resou
 				Computed:            true,
 			},
 			"environment_type": schema.StringAttribute{
-				MarkdownDescription: "Default environment handling for the policy (\"AllEnvironments\", \"ExceptEnvironments\", \"OnlyEnvironments\")",
-				Description:         "Default environment handling for the policy (\"AllEnvironments\", \"ExceptEnvironments\", \"OnlyEnvironments\")",
-				Required:            true,
+				MarkdownDescription: "Default environment handling for the policy (\"AllEnvironments\", \"ExceptEnvironments\", \"OnlyEnvironments\"). Required unless `policy_definition_json` is set",
+				Description:         "Default environment handling for the policy (\"AllEnvironments\", \"ExceptEnvironments\", \"OnlyEnvironments\"). Required unless `policy_definition_json` is set",
+				Optional:            true,
+				Computed:            true,
 				Validators: []validator.String{
 					stringvalidator.OneOf("AllEnvironments", "ExceptEnvironments", "OnlyEnvironments"),
 				},
 			},
 			"default_connectors_classification": schema.StringAttribute{
-				MarkdownDescription: "Default classification for connectors (\"General\", \"Confidential\", \"Blocked\")",
-				Description:         "Default classification for connectors (\"General\", \"Confidential\", \"Blocked\")",
-				Required:            true,
+				MarkdownDescription: "Default classification for connectors (\"General\", \"Confidential\", \"Blocked\"). Required unless `policy_definition_json` is set",
+				Description:         "Default classification for connectors (\"General\", \"Confidential\", \"Blocked\"). Required unless `policy_definition_json` is set",
+				Optional:            true,
+				Computed:            true,
 				Validators: []validator.String{
 					stringvalidator.OneOf("General", "Confidential", "Blocked"),
 				},
@@ -174,29 +219,43 @@ func (r *DataLossPreventionPolicyResource) Schema(ctx context.Context, req resou
 				MarkdownDescription: "Environment to which the policy is applied",
 				ElementType:         types.StringType,
 				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+						//environment-level policies are created in the environment they apply to
+						var scope types.String
+						resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("scope"), &scope)...)
+						resp.RequiresReplace = scope.ValueString() == DLP_POLICY_SCOPE_ENVIRONMENT
+					}, "Changing the environment of an environment-level policy requires replacement", "Changing the environment of an environment-level policy requires replacement"),
+				},
 			},
 			"business_connectors": schema.SetNestedAttribute{
-				MarkdownDescription: "Connectors for sensitive data",
-				Description:         "Connectors for sensitive data",
-				Required:            true,
+				MarkdownDescription: "Connectors for sensitive data. Required unless `policy_definition_json` is set",
+				Description:         "Connectors for sensitive data. Required unless `policy_definition_json` is set",
+				Optional:            true,
+				Computed:            true,
 				NestedObject:        connectorSchema,
 			},
 			"non_business_connectors": schema.SetNestedAttribute{
-				MarkdownDescription: "Connectors for non-sensitive data",
-				Description:         "Connectors for non-sensitive data",
-				Required:            true,
+				MarkdownDescription: "Connectors for non-sensitive data. Required unless `policy_definition_json` is set",
+				Description:         "Connectors for non-sensitive data. Required unless `policy_definition_json` is set",
+				Optional:            true,
+				Computed:            true,
 				NestedObject:        connectorSchema,
 			},
 			"blocked_connectors": schema.SetNestedAttribute{
-				MarkdownDescription: "Blocked connectors can’t be used where this policy is applied.",
-				Description:         "Blocked connectors can’t be used where this policy is applied.",
-				Required:            true,
+				MarkdownDescription: "Blocked connectors can’t be used where this policy is applied. Required unless `policy_definition_json` is set",
+				Description:         "Blocked connectors can’t be used where this policy is applied. Required unless `policy_definition_json` is set",
+				Optional:            true,
+				Computed:            true,
 				NestedObject:        connectorSchema,
 			},
 			"custom_connectors_patterns": schema.SetNestedAttribute{
-				MarkdownDescription: "Custom connectors patterns",
-				Description:         "Custom connectors patterns",
-				Required:            true,
+				MarkdownDescription: "Custom connectors patterns. Required unless `policy_definition_json` is set",
+				Description:         "Custom connectors patterns. Required unless `policy_definition_json` is set",
+				Optional:            true,
+				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"order": schema.Int64Attribute{
@@ -241,6 +300,250 @@ func (r *DataLossPreventionPolicyResource) Configure(ctx context.Context, req re
 	}
 
 	r.DlpPolicyClient = NewDlpPolicyClient(client)
+	r.ConnectorsClient = connectors.NewConnectorsClient(client)
+}
+
+func (r *DataLossPreventionPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var scope, environmentType, policyDefinitionJson types.String
+	var environments types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("scope"), &scope)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("environment_type"), &environmentType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("environments"), &environments)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("policy_definition_json"), &policyDefinitionJson)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !policyDefinitionJson.IsUnknown() {
+		//the policy is described either by its definition or by its attributes, never by both
+		for _, attributeName := range []string{"display_name", "default_connectors_classification", "environment_type"} {
+			var value types.String
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attributeName), &value)...)
+			validatePolicyDefinitionAttribute(attributeName, policyDefinitionJson, value.IsNull(), true, resp)
+		}
+		for _, attributeName := range []string{"environments", "business_connectors", "non_business_connectors", "blocked_connectors", "custom_connectors_patterns"} {
+			var value types.Set
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attributeName), &value)...)
+			validatePolicyDefinitionAttribute(attributeName, policyDefinitionJson, value.IsNull(), attributeName != "environments", resp)
+		}
+	}
+
+	if scope.ValueString() != DLP_POLICY_SCOPE_ENVIRONMENT || !policyDefinitionJson.IsNull() {
+		return
+	}
+
+	if !environmentType.IsUnknown() && environmentType.ValueString() != DLP_POLICY_ENVIRONMENT_TYPE_ONLY_ENVIRONMENTS {
+		resp.Diagnostics.AddAttributeError(path.Root("environment_type"), "Invalid environment type", "Environment-level policies require `environment_type` to be `OnlyEnvironments`")
+	}
+	if !environments.IsUnknown() && len(environments.Elements()) != 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("environments"), "Invalid environments", "Environment-level policies have to be applied to exactly one environment")
+	}
+}
+
+// validatePolicyDefinitionAttribute checks that a policy attribute is set when the policy has no `policy_definition_json`,
+// and that it is not set when it has one.
+func validatePolicyDefinitionAttribute(attributeName string, policyDefinitionJson types.String, isNull bool, required bool, resp *resource.ValidateConfigResponse) {
+	if policyDefinitionJson.IsNull() && required && isNull {
+		resp.Diagnostics.AddAttributeError(path.Root(attributeName), "Missing required argument", fmt.Sprintf("The argument %q is required when `policy_definition_json` is not set", attributeName))
+	}
+	if !policyDefinitionJson.IsNull() && !isNull {
+		resp.Diagnostics.AddAttributeError(path.Root(attributeName), "Conflicting configuration arguments", fmt.Sprintf("The argument %q can't be set together with `policy_definition_json`", attributeName))
+	}
+}
+
+func (r *DataLossPreventionPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.applyPolicyDefinitionJson(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.validateConnectorsAgainstCatalog(ctx, req, resp)
+}
+
+func (r *DataLossPreventionPolicyResource) applyPolicyDefinitionJson(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var policyDefinitionJson types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("policy_definition_json"), &policyDefinitionJson)...)
+	if resp.Diagnostics.HasError() || policyDefinitionJson.IsNull() || policyDefinitionJson.IsUnknown() {
+		return
+	}
+
+	//the policy definition is planned as if its content was set in the attributes of the resource
+	policy, err := convertPolicyDefinitionJsonToPolicyModelDto(policyDefinitionJson.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("policy_definition_json"), "Invalid policy definition", err.Error())
+		return
+	}
+
+	var scope types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("scope"), &scope)...)
+	if scope.ValueString() == DLP_POLICY_SCOPE_ENVIRONMENT && (policy.modelEnvironmentType() != DLP_POLICY_ENVIRONMENT_TYPE_ONLY_ENVIRONMENTS || len(policy.Environments) != 1) {
+		resp.Diagnostics.AddAttributeError(path.Root("policy_definition_json"), "Invalid policy definition", "Environment-level policies have to be applied to exactly one environment with the `OnlyEnvironments` environment type")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("display_name"), policy.DisplayName)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("default_connectors_classification"), policy.DefaultConnectorsClassification)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("environment_type"), policy.modelEnvironmentType())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("environments"), convertToAttrValueEnvironments(policy.Environments))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("custom_connectors_patterns"), convertToAttrValueCustomConnectorUrlPatternsDefinition(policy.CustomConnectorUrlPatternsDefinition))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("business_connectors"), convertToAttrValueConnectorsGroup("Confidential", policy.ConnectorGroups))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("non_business_connectors"), convertToAttrValueConnectorsGroup("General", policy.ConnectorGroups))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("blocked_connectors"), convertToAttrValueConnectorsGroup("Blocked", policy.ConnectorGroups))...)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+
+	//the default of `environments` differs from the state when the policy comes from its definition,
+	//so the computed attributes are kept from the state when the definition plans the policy as it is
+	var plannedPolicy, statePolicy DataLossPreventionPolicyResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plannedPolicy)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &statePolicy)...)
+	if resp.Diagnostics.HasError() || !plannedPolicy.PolicyDefinitionJson.Equal(statePolicy.PolicyDefinitionJson) ||
+		!plannedPolicy.Scope.Equal(statePolicy.Scope) ||
+		!plannedPolicy.DisplayName.Equal(statePolicy.DisplayName) ||
+		!plannedPolicy.DefaultConnectorsClassification.Equal(statePolicy.DefaultConnectorsClassification) ||
+		!plannedPolicy.EnvironmentType.Equal(statePolicy.EnvironmentType) ||
+		!slices.Equal(plannedPolicy.Environments, statePolicy.Environments) ||
+		!plannedPolicy.BusinessGeneralConnectors.Equal(statePolicy.BusinessGeneralConnectors) ||
+		!plannedPolicy.NonBusinessConfidentialConnectors.Equal(statePolicy.NonBusinessConfidentialConnectors) ||
+		!plannedPolicy.BlockedConnectors.Equal(statePolicy.BlockedConnectors) ||
+		!plannedPolicy.CustomConnectorsPatterns.Equal(statePolicy.CustomConnectorsPatterns) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &statePolicy)...)
+}
+
+// validateConnectorsAgainstCatalog checks the planned connectors against the connectors available in the tenant,
+// or in the environment of an environment-level policy.
+func (r *DataLossPreventionPolicyResource) validateConnectorsAgainstCatalog(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	//the provider is not configured yet when the plan is validated without credentials
+	if r.ConnectorsClient.Api == nil {
+		return
+	}
+
+	connectorGroups := map[string]types.Set{}
+	changed := req.State.Raw.IsNull()
+	for _, attributeName := range []string{"business_connectors", "non_business_connectors", "blocked_connectors"} {
+		var plannedConnectors, stateConnectors types.Set
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root(attributeName), &plannedConnectors)...)
+		if !req.State.Raw.IsNull() {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(attributeName), &stateConnectors)...)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plannedConnectors.IsUnknown() {
+			//the connectors are only validated once the whole classification is known
+			return
+		}
+		if plannedConnectors.IsNull() {
+			continue
+		}
+		connectorGroups[attributeName] = plannedConnectors
+		changed = changed || !plannedConnectors.Equal(stateConnectors)
+	}
+	if !changed || len(connectorGroups) == 0 {
+		return
+	}
+
+	var scope types.String
+	var environments types.Set
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("scope"), &scope)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("environments"), &environments)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if scope.IsUnknown() {
+		return
+	}
+
+	environmentId := ""
+	if scope.ValueString() == DLP_POLICY_SCOPE_ENVIRONMENT {
+		if environments.IsUnknown() || len(environments.Elements()) != 1 {
+			return
+		}
+		environment := environments.Elements()[0].(types.String)
+		if environment.IsUnknown() {
+			return
+		}
+		environmentId = environment.ValueString()
+	}
+
+	var catalog []connectors.ConnectorDto
+	var err error
+	if environmentId == "" {
+		catalog, err = r.ConnectorsClient.GetConnectors(ctx)
+	} else {
+		catalog, err = r.ConnectorsClient.GetEnvironmentConnectors(ctx, environmentId)
+	}
+	if err != nil {
+		//the policy can still be applied, the service validates the connectors as well
+		resp.Diagnostics.AddWarning("Unable to validate the connectors of the policy", fmt.Sprintf("The connectors catalog could not be read: %s", err.Error()))
+		return
+	}
+
+	catalogConnectors := map[string]connectors.ConnectorDto{}
+	for _, connector := range catalog {
+		catalogConnectors[strings.ToLower(connector.Id)] = connector
+	}
+
+	for _, attributeName := range []string{"business_connectors", "non_business_connectors", "blocked_connectors"} {
+		for _, element := range connectorGroups[attributeName].Elements() {
+			var connector DataLossPreventionPolicyResourceConnectorModel
+			resp.Diagnostics.Append(element.(types.Object).As(ctx, &connector, basetypes.ObjectAsOptions{})...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if connector.Id.IsUnknown() {
+				continue
+			}
+			connectorPath := path.Root(attributeName).AtSetValue(element)
+
+			catalogConnector, ok := catalogConnectors[strings.ToLower(connector.Id.ValueString())]
+			if !ok {
+				if environmentId == "" {
+					//custom connectors are not part of the tenant catalog
+					resp.Diagnostics.AddAttributeWarning(connectorPath.AtName("id"), "Unknown connector", fmt.Sprintf("Connector '%s' was not found in the connectors of the tenant. Ignore this warning if it is a custom connector.", connector.Id.ValueString()))
+				} else {
+					resp.Diagnostics.AddAttributeError(connectorPath.AtName("id"), "Unknown connector", fmt.Sprintf("Connector '%s' was not found in the connectors of environment '%s'", connector.Id.ValueString(), environmentId))
+				}
+				continue
+			}
+
+			if attributeName == "blocked_connectors" && catalogConnector.Properties.Unblockable {
+				resp.Diagnostics.AddAttributeError(connectorPath.AtName("id"), "Unblockable connector", fmt.Sprintf("Connector '%s' can't be blocked, it has to be in the business or non-business connectors", connector.Id.ValueString()))
+			}
+
+			if len(connector.ActionRules) == 0 || catalogConnector.Properties.Virtual {
+				continue
+			}
+
+			catalogEnvironment := "~Default"
+			if environmentId != "" {
+				catalogEnvironment = environmentId
+			}
+			actions, err := r.ConnectorsClient.GetConnectorActions(ctx, catalogEnvironment, catalogConnector.Name)
+			if err != nil {
+				resp.Diagnostics.AddAttributeWarning(connectorPath.AtName("action_rules"), "Unable to validate the action rules of the connector", err.Error())
+				continue
+			}
+			for inx, actionRule := range connector.ActionRules {
+				if actionRule.ActionId.IsUnknown() {
+					continue
+				}
+				if !slices.ContainsFunc(actions, func(action connectors.ConnectorActionDto) bool { return action.Id == actionRule.ActionId.ValueString() }) {
+					resp.Diagnostics.AddAttributeError(connectorPath.AtName("action_rules").AtListIndex(inx).AtName("action_id"), "Unknown action", fmt.Sprintf("Connector '%s' has no action '%s'", connector.Id.ValueString(), actionRule.ActionId.ValueString()))
+				}
+			}
+		}
+	}
 }
 
 func (r *DataLossPreventionPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -254,7 +557,11 @@ func (r *DataLossPreventionPolicyResource) Read(ctx context.Context, req resourc
 		return
 	}
 
-	policy, err := r.DlpPolicyClient.GetPolicy(ctx, state.Id.ValueString())
+	if state.Scope.IsNull() {
+		state.Scope = types.StringValue(DLP_POLICY_SCOPE_TENANT)
+	}
+
+	policy, err := r.DlpPolicyClient.GetPolicy(ctx, state.scopeEnvironmentId(), state.Id.ValueString())
 	if err != nil {
 		if powerplatform_helpers.Code(err) == powerplatform_helpers.ERROR_OBJECT_NOT_FOUND {
 			resp.State.RemoveResource(ctx)
@@ -272,12 +579,13 @@ func (r *DataLossPreventionPolicyResource) Read(ctx context.Context, req resourc
 	state.CreatedTime = types.StringValue(policy.CreatedTime)
 	state.LastModifiedBy = types.StringValue(policy.LastModifiedBy)
 	state.LastModifiedTime = types.StringValue(policy.LastModifiedTime)
-	state.EnvironmentType = types.StringValue(policy.EnvironmentType)
+	state.EnvironmentType = types.StringValue(policy.modelEnvironmentType())
 	state.Environments = convertToAttrValueEnvironments(policy.Environments)
 	state.CustomConnectorsPatterns = convertToAttrValueCustomConnectorUrlPatternsDefinition(policy.CustomConnectorUrlPatternsDefinition)
 	state.BusinessGeneralConnectors = convertToAttrValueConnectorsGroup("Confidential", policy.ConnectorGroups)
 	state.NonBusinessConfidentialConnectors = convertToAttrValueConnectorsGroup("General", policy.ConnectorGroups)
 	state.BlockedConnectors = convertToAttrValueConnectorsGroup("Blocked", policy.ConnectorGroups)
+	state.CanonicalPolicyDefinitionJson = types.StringValue(convertPolicyModelDtoToCanonicalJson(*policy))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE END: %s", r.TypeName))
@@ -297,7 +605,7 @@ func (r *DataLossPreventionPolicyResource) Create(ctx context.Context, req resou
 	policyToCreate := DlpPolicyModelDto{
 		DefaultConnectorsClassification:      plan.DefaultConnectorsClassification.ValueString(),
 		DisplayName:                          plan.DisplayName.ValueString(),
-		EnvironmentType:                      plan.EnvironmentType.ValueString(),
+		EnvironmentType:                      convertToApiEnvironmentType(plan.Scope.ValueString(), plan.EnvironmentType.ValueString()),
 		Environments:                         []DlpEnvironmentDto{},
 		ConnectorGroups:                      []DlpConnectorGroupsModelDto{},
 		CustomConnectorUrlPatternsDefinition: []DlpConnectorUrlPatternsDefinitionDto{},
@@ -310,7 +618,7 @@ func (r *DataLossPreventionPolicyResource) Create(ctx context.Context, req resou
 	policyToCreate.ConnectorGroups = append(policyToCreate.ConnectorGroups, convertToDlpConnectorGroup(ctx, resp.Diagnostics, "General", plan.NonBusinessConfidentialConnectors))
 	policyToCreate.ConnectorGroups = append(policyToCreate.ConnectorGroups, convertToDlpConnectorGroup(ctx, resp.Diagnostics, "Blocked", plan.BlockedConnectors))
 
-	policy, err_client := r.DlpPolicyClient.CreatePolicy(ctx, policyToCreate)
+	policy, err_client := r.DlpPolicyClient.CreatePolicy(ctx, plan.scopeEnvironmentId(), policyToCreate)
 	if err_client != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s_%s", r.ProviderTypeName, r.TypeName), err_client.Error())
 		return
@@ -323,12 +631,13 @@ func (r *DataLossPreventionPolicyResource) Create(ctx context.Context, req resou
 	plan.CreatedTime = types.StringValue(policy.CreatedTime)
 	plan.LastModifiedBy = types.StringValue(policy.LastModifiedBy)
 	plan.LastModifiedTime = types.StringValue(policy.LastModifiedTime)
-	plan.EnvironmentType = types.StringValue(policy.EnvironmentType)
+	plan.EnvironmentType = types.StringValue(policy.modelEnvironmentType())
 	plan.Environments = convertToAttrValueEnvironments(policy.Environments)
 	plan.CustomConnectorsPatterns = convertToAttrValueCustomConnectorUrlPatternsDefinition(policy.CustomConnectorUrlPatternsDefinition)
 	plan.BusinessGeneralConnectors = convertToAttrValueConnectorsGroup("Confidential", policy.ConnectorGroups)
 	plan.NonBusinessConfidentialConnectors = convertToAttrValueConnectorsGroup("General", policy.ConnectorGroups)
 	plan.BlockedConnectors = convertToAttrValueConnectorsGroup("Blocked", policy.ConnectorGroups)
+	plan.CanonicalPolicyDefinitionJson = types.StringValue(convertPolicyModelDtoToCanonicalJson(*policy))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 
@@ -352,7 +661,7 @@ func (r *DataLossPreventionPolicyResource) Update(ctx context.Context, req resou
 	policyToUpdate := DlpPolicyModelDto{
 		Name:                            plan.Id.ValueString(),
 		DisplayName:                     plan.DisplayName.ValueString(),
-		EnvironmentType:                 plan.EnvironmentType.ValueString(),
+		EnvironmentType:                 convertToApiEnvironmentType(plan.Scope.ValueString(), plan.EnvironmentType.ValueString()),
 		DefaultConnectorsClassification: plan.DefaultConnectorsClassification.ValueString(),
 		Environments:                    []DlpEnvironmentDto{},
 		ConnectorGroups:                 []DlpConnectorGroupsModelDto{},
@@ -365,7 +674,7 @@ func (r *DataLossPreventionPolicyResource) Update(ctx context.Context, req resou
 	policyToUpdate.ConnectorGroups = append(policyToUpdate.ConnectorGroups, convertToDlpConnectorGroup(ctx, resp.Diagnostics, "General", plan.NonBusinessConfidentialConnectors))
 	policyToUpdate.ConnectorGroups = append(policyToUpdate.ConnectorGroups, convertToDlpConnectorGroup(ctx, resp.Diagnostics, "Blocked", plan.BlockedConnectors))
 
-	policy, err_client := r.DlpPolicyClient.UpdatePolicy(ctx, plan.Id.ValueString(), policyToUpdate)
+	policy, err_client := r.DlpPolicyClient.UpdatePolicy(ctx, plan.scopeEnvironmentId(), plan.Id.ValueString(), policyToUpdate)
 	if err_client != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.TypeName), err_client.Error())
 		return
@@ -378,12 +687,13 @@ func (r *DataLossPreventionPolicyResource) Update(ctx context.Context, req resou
 	plan.CreatedTime = types.StringValue(policy.CreatedTime)
 	plan.LastModifiedBy = types.StringValue(policy.LastModifiedBy)
 	plan.LastModifiedTime = types.StringValue(policy.LastModifiedTime)
-	plan.EnvironmentType = types.StringValue(policy.EnvironmentType)
+	plan.EnvironmentType = types.StringValue(policy.modelEnvironmentType())
 	plan.Environments = convertToAttrValueEnvironments(policy.Environments)
 	plan.CustomConnectorsPatterns = convertToAttrValueCustomConnectorUrlPatternsDefinition(policy.CustomConnectorUrlPatternsDefinition)
 	plan.BusinessGeneralConnectors = convertToAttrValueConnectorsGroup("Confidential", policy.ConnectorGroups)
 	plan.NonBusinessConfidentialConnectors = convertToAttrValueConnectorsGroup("General", policy.ConnectorGroups)
 	plan.BlockedConnectors = convertToAttrValueConnectorsGroup("Blocked", policy.ConnectorGroups)
+	plan.CanonicalPolicyDefinitionJson = types.StringValue(convertPolicyModelDtoToCanonicalJson(*policy))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 
@@ -402,7 +712,7 @@ func (r *DataLossPreventionPolicyResource) Delete(ctx context.Context, req resou
 		return
 	}
 
-	err := r.DlpPolicyClient.DeletePolicy(ctx, state.Id.ValueString())
+	err := r.DlpPolicyClient.DeletePolicy(ctx, state.scopeEnvironmentId(), state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.TypeName), err.Error())
 		return
@@ -412,5 +722,19 @@ func (r *DataLossPreventionPolicyResource) Delete(ctx context.Context, req resou
 }
 
 func (r *DataLossPreventionPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	//tenant-level policies are imported by their id, environment-level policies with the format <environment_id>_<policy_id>
+	parts := strings.Split(req.ID, "_")
+	if len(parts) == 1 {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scope"), DLP_POLICY_SCOPE_TENANT)...)
+		return
+	}
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected import identifier", fmt.Sprintf("Expected import identifier with format: <policy_id> or <environment_id>_<policy_id>. Got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scope"), DLP_POLICY_SCOPE_ENVIRONMENT)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environments"), []string{parts[0]})...)
 }
@@ -462,6 +462,34 @@ func (client *EnvironmentClient) GetEnvironments(ctx context.Context) ([]Environ
 	return envArray.Value, nil
 }
 
+func (client *EnvironmentClient) GetLifecycleOperations(ctx context.Context, environmentId string) ([]api.LifecycleDto, error) {
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   client.Api.GetConfig().Urls.BapiUrl,
+		Path:   fmt.Sprintf("/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/%s/lifecycleOperations", environmentId),
+	}
+	values := url.Values{}
+	values.Add("api-version", "2023-06-01")
+	apiUrl.RawQuery = values.Encode()
+
+	operations := []api.LifecycleDto{}
+	nextLink := apiUrl.String()
+	for nextLink != "" {
+		operationArray := LifecycleOperationArrayDto{}
+		_, err := client.Api.Execute(ctx, "GET", nextLink, nil, nil, []int{http.StatusOK}, &operationArray)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil, powerplatform_helpers.WrapIntoProviderError(err, powerplatform_helpers.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("environment '%s' not found", environmentId))
+			}
+			return nil, err
+		}
+		operations = append(operations, operationArray.Value...)
+		nextLink = operationArray.NextLink
+	}
+
+	return operations, nil
+}
+
 func (client *EnvironmentClient) GetDefaultCurrencyForEnvironment(ctx context.Context, environmentId string) (*TransactionCurrencyDto, error) {
 	orgSettings := OrganizationSettingsArrayDto{}
 	err := client.solutionClient.GetTableData(ctx, environmentId, "organizations", "", &orgSettings)
@@ -0,0 +1,167 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+var (
+	_ datasource.DataSource              = &EnvironmentDataSource{}
+	_ datasource.DataSourceWithConfigure = &EnvironmentDataSource{}
+)
+
+func NewEnvironmentDataSource() datasource.DataSource {
+	return &EnvironmentDataSource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_environment",
+	}
+}
+
+type EnvironmentDataSource struct {
+	EnvironmentClient EnvironmentClient
+	ProviderTypeName  string
+	TypeName          string
+}
+
+func (d *EnvironmentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + d.TypeName
+}
+
+func (d *EnvironmentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := environmentDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Unique environment id (guid). Either `id` or `domain_name` has to be provided",
+		Description:         "Unique environment id (guid). Either `id` or `domain_name` has to be provided",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("domain_name")),
+		},
+	}
+	attributes["domain_name"] = schema.StringAttribute{
+		MarkdownDescription: "Dataverse domain name of the environment, i.e. the first part of the environment url. Either `id` or `domain_name` has to be provided",
+		Description:         "Dataverse domain name of the environment, i.e. the first part of the environment url. Either `id` or `domain_name` has to be provided",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Fetches a single environment in a tenant by its id or Dataverse domain name",
+		MarkdownDescription: "Fetches a single environment in a tenant by its id or Dataverse domain name. See [Environments overview](https://learn.microsoft.com/power-platform/admin/environments-overview) for more information.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *EnvironmentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clientApi := req.ProviderData.(*api.ProviderClient).Api
+
+	if clientApi == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.EnvironmentClient = NewEnvironmentClient(clientApi)
+}
+
+func (d *EnvironmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config EnvironmentLookupDataSourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE ENVIRONMENT START: %s_%s", d.ProviderTypeName, d.TypeName))
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var env *EnvironmentDto
+	if !config.Id.IsNull() {
+		var err error
+		env, err = d.EnvironmentClient.GetEnvironment(ctx, config.Id.ValueString())
+		if err != nil {
+			if powerplatform_helpers.Code(err) == powerplatform_helpers.ERROR_OBJECT_NOT_FOUND {
+				resp.Diagnostics.AddError("Environment not found", fmt.Sprintf("No environment with id '%s' exists", config.Id.ValueString()))
+				return
+			}
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s_%s", d.ProviderTypeName, d.TypeName), err.Error())
+			return
+		}
+	} else {
+		envs, err := d.EnvironmentClient.GetEnvironments(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s_%s", d.ProviderTypeName, d.TypeName), err.Error())
+			return
+		}
+		for inx, e := range envs {
+			if e.HasDataverse() && strings.EqualFold(e.DomainName(), config.DomainName.ValueString()) {
+				env = &envs[inx]
+				break
+			}
+		}
+		if env == nil {
+			resp.Diagnostics.AddError("Environment not found", fmt.Sprintf("No environment with domain name '%s' exists", config.DomainName.ValueString()))
+			return
+		}
+	}
+
+	currencyCode := ""
+	if env.HasDataverse() {
+		defaultCurrency, err := d.EnvironmentClient.GetDefaultCurrencyForEnvironment(ctx, env.Name)
+		if err != nil {
+			resp.Diagnostics.AddWarning(fmt.Sprintf("Error when reading default currency for environment %s", env.Name), err.Error())
+		} else {
+			currencyCode = defaultCurrency.IsoCurrencyCode
+		}
+	}
+
+	environment, err := ConvertDataSourceModelFromEnvironmentDto(*env, &currencyCode)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error when converting environment %s", env.Properties.DisplayName), err.Error())
+		return
+	}
+
+	state := EnvironmentLookupDataSourceModel{
+		Id:                environment.Id,
+		DomainName:        types.StringValue(env.DomainName()),
+		Location:          environment.Location,
+		AzureRegion:       environment.AzureRegion,
+		DisplayName:       environment.DisplayName,
+		EnvironmentType:   environment.EnvironmentType,
+		BillingPolicyId:   environment.BillingPolicyId,
+		States:            environment.States,
+		ProvisioningState: environment.ProvisioningState,
+		TenantId:          environment.TenantId,
+		ProtectionLevel:   environment.ProtectionLevel,
+		CreatedBy:         environment.CreatedBy,
+		CreatedTime:       environment.CreatedTime,
+		Dataverse:         environment.Dataverse,
+	}
+
+	diags := resp.State.Set(ctx, &state)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE ENVIRONMENT END: %s_%s", d.ProviderTypeName, d.TypeName))
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
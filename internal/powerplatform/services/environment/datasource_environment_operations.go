@@ -0,0 +1,208 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+var (
+	_ datasource.DataSource              = &EnvironmentOperationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &EnvironmentOperationsDataSource{}
+)
+
+func NewEnvironmentOperationsDataSource() datasource.DataSource {
+	return &EnvironmentOperationsDataSource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_environment_operations",
+	}
+}
+
+type EnvironmentOperationsDataSource struct {
+	EnvironmentClient EnvironmentClient
+	ProviderTypeName  string
+	TypeName          string
+}
+
+func (d *EnvironmentOperationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + d.TypeName
+}
+
+func (d *EnvironmentOperationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Fetches the recent lifecycle operations of an environment, such as create, copy, restore, reset, delete and convert",
+		MarkdownDescription: "Fetches the recent lifecycle operations of an environment, such as create, copy, restore, reset, delete and convert. Operations are returned most recent first. See [Environments overview](https://learn.microsoft.com/power-platform/admin/environments-overview) for more information.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Id of the read operation",
+				MarkdownDescription: "Id of the read operation",
+				Computed:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				Description:         "Id of the environment (guid)",
+				MarkdownDescription: "Id of the environment (guid)",
+				Required:            true,
+			},
+			"operations": schema.ListNestedAttribute{
+				Description:         "List of lifecycle operations of the environment",
+				MarkdownDescription: "List of lifecycle operations of the environment",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "Unique id of the operation (guid)",
+							MarkdownDescription: "Unique id of the operation (guid)",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							Description:         "Type of the operation (Create, Copy, Restore, Reset, Delete, Convert etc.)",
+							MarkdownDescription: "Type of the operation (Create, Copy, Restore, Reset, Delete, Convert etc.)",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							Description:         "State of the operation (Succeeded, Failed, Running etc.)",
+							MarkdownDescription: "State of the operation (Succeeded, Failed, Running etc.)",
+							Computed:            true,
+						},
+						"created_time": schema.StringAttribute{
+							Description:         "Time when the operation was requested",
+							MarkdownDescription: "Time when the operation was requested",
+							Computed:            true,
+						},
+						"last_action_time": schema.StringAttribute{
+							Description:         "Time of the last action of the operation",
+							MarkdownDescription: "Time of the last action of the operation",
+							Computed:            true,
+						},
+						"requested_by": schema.SingleNestedAttribute{
+							Description:         "User or application that requested the operation",
+							MarkdownDescription: "User or application that requested the operation",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Description:         "Id of the user or application",
+									MarkdownDescription: "Id of the user or application",
+									Computed:            true,
+								},
+								"display_name": schema.StringAttribute{
+									Description:         "Display name of the user or application",
+									MarkdownDescription: "Display name of the user or application",
+									Computed:            true,
+								},
+								"type": schema.StringAttribute{
+									Description:         "Type of the requester (User, ServicePrincipal etc.)",
+									MarkdownDescription: "Type of the requester (User, ServicePrincipal etc.)",
+									Computed:            true,
+								},
+							},
+						},
+						"stages": schema.ListNestedAttribute{
+							Description:         "Stages of the operation",
+							MarkdownDescription: "Stages of the operation",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description:         "Id of the stage",
+										MarkdownDescription: "Id of the stage",
+										Computed:            true,
+									},
+									"name": schema.StringAttribute{
+										Description:         "Name of the stage (Validate, Prepare, Run, Finalize etc.)",
+										MarkdownDescription: "Name of the stage (Validate, Prepare, Run, Finalize etc.)",
+										Computed:            true,
+									},
+									"state": schema.StringAttribute{
+										Description:         "State of the stage",
+										MarkdownDescription: "State of the stage",
+										Computed:            true,
+									},
+									"first_action_time": schema.StringAttribute{
+										Description:         "Time of the first action of the stage",
+										MarkdownDescription: "Time of the first action of the stage",
+										Computed:            true,
+									},
+									"last_action_time": schema.StringAttribute{
+										Description:         "Time of the last action of the stage",
+										MarkdownDescription: "Time of the last action of the stage",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EnvironmentOperationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clientApi := req.ProviderData.(*api.ProviderClient).Api
+
+	if clientApi == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.EnvironmentClient = NewEnvironmentClient(clientApi)
+}
+
+func (d *EnvironmentOperationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state EnvironmentOperationsDataSourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE ENVIRONMENT OPERATIONS START: %s_%s", d.ProviderTypeName, d.TypeName))
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operations, err := d.EnvironmentClient.GetLifecycleOperations(ctx, state.EnvironmentId.ValueString())
+	if err != nil {
+		if powerplatform_helpers.Code(err) == powerplatform_helpers.ERROR_OBJECT_NOT_FOUND {
+			resp.Diagnostics.AddError("Environment not found", fmt.Sprintf("No environment with id '%s' exists", state.EnvironmentId.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s_%s", d.ProviderTypeName, d.TypeName), err.Error())
+		return
+	}
+
+	sort.SliceStable(operations, func(i, j int) bool {
+		ti, _ := time.Parse(time.RFC3339, operations[i].CreatedDateTime)
+		tj, _ := time.Parse(time.RFC3339, operations[j].CreatedDateTime)
+		return ti.After(tj)
+	})
+
+	state.Id = types.StringValue(state.EnvironmentId.ValueString())
+	state.Operations = []EnvironmentOperationDataSourceModel{}
+	for _, operation := range operations {
+		state.Operations = append(state.Operations, ConvertFromLifecycleDto(operation))
+	}
+
+	diags := resp.State.Set(ctx, &state)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE ENVIRONMENT OPERATIONS END: %s_%s", d.ProviderTypeName, d.TypeName))
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
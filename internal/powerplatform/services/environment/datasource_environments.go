@@ -6,9 +6,15 @@ package powerplatform
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
@@ -39,124 +45,209 @@ func (d *EnvironmentsDataSource) Metadata(_ context.Context, req datasource.Meta
 func (d *EnvironmentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description:         "Fetches the list of environments in a tenant",
-		MarkdownDescription: "Fetches the list of environments in a tenant.  See [Environments overview](https://learn.microsoft.com/power-platform/admin/environments-overview) for more information.",
+		MarkdownDescription: "Fetches the list of environments in a tenant.  See [Environments overview](https://learn.microsoft.com/power-platform/admin/environments-overview) for more information.\n\nThe optional filter arguments are combined, only environments matching all of them are returned.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Description:         "Id of the read operation",
 				MarkdownDescription: "Id of the read operation",
 				Computed:            true,
 			},
+			"environment_type": schema.StringAttribute{
+				Description:         "Only return environments of this type (Default, Sandbox, Production, Trial, Developer, Teams)",
+				MarkdownDescription: "Only return environments of this type (`Default`, `Sandbox`, `Production`, `Trial`, `Developer`, `Teams`)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(append([]string{"Default", "Teams"}, EnvironmentTypes...)...),
+				},
+			},
+			"location": schema.StringAttribute{
+				Description:         "Only return environments in this location (europe, unitedstates etc.)",
+				MarkdownDescription: "Only return environments in this location (europe, unitedstates etc.)",
+				Optional:            true,
+			},
+			"display_name_regex": schema.StringAttribute{
+				Description:         "Only return environments with a display name matching this regular expression",
+				MarkdownDescription: "Only return environments with a display name matching this regular expression",
+				Optional:            true,
+			},
+			"has_dataverse": schema.BoolAttribute{
+				Description:         "Only return environments with Dataverse when true, or environments without Dataverse when false",
+				MarkdownDescription: "Only return environments with Dataverse when `true`, or environments without Dataverse when `false`",
+				Optional:            true,
+			},
+			"is_managed": schema.BoolAttribute{
+				Description:         "Only return managed environments when true, or unmanaged environments when false",
+				MarkdownDescription: "Only return managed environments when `true`, or unmanaged environments when `false`",
+				Optional:            true,
+			},
+			"billing_policy_id": schema.StringAttribute{
+				Description:         "Only return environments linked to this billing policy id (guid)",
+				MarkdownDescription: "Only return environments linked to this billing policy id (guid)",
+				Optional:            true,
+			},
+			"created_after": schema.StringAttribute{
+				Description:         "Only return environments created after this time, in RFC3339 format (e.g. 2024-01-01T00:00:00Z)",
+				MarkdownDescription: "Only return environments created after this time, in RFC3339 format (e.g. `2024-01-01T00:00:00Z`)",
+				Optional:            true,
+			},
 			"environments": schema.ListNestedAttribute{
 				Description:         "List of environments",
 				MarkdownDescription: "List of environments",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							MarkdownDescription: "Unique environment id (guid)",
-							Description:         "Unique environment id (guid)",
-							Computed:            true,
-						},
-						"location": schema.StringAttribute{
-							Description:         "Location of the environment (europe, unitedstates etc.). Can be queried using the `powerplatform_locations` data source.",
-							MarkdownDescription: "Location of the environment (europe, unitedstates etc.). Can be queried using the `powerplatform_locations` data source.",
-							Computed:            true,
-						},
-						"azure_region": schema.StringAttribute{
-							Description:         "Azure region of the environment (westeurope, eastus etc.). Can be queried using the `powerplatform_locations` data source.",
-							MarkdownDescription: "Azure region of the environment (westeurope, eastus etc.). Can be queried using the `powerplatform_locations` data source.",
-							Computed:            true,
-						},
-						"environment_type": schema.StringAttribute{
-							Description:         "Type of the environment (Sandbox, Production etc.)",
-							MarkdownDescription: "Type of the environment (Sandbox, Production etc.)",
-							Computed:            true,
-						},
-						"display_name": schema.StringAttribute{
-							MarkdownDescription: "Display name",
-							Description:         "Display name",
-							Computed:            true,
-						},
-						"billing_policy_id": &schema.StringAttribute{
-							Description:         "Billing policy id (guid) for pay-as-you-go environments using Azure subscription billing",
-							MarkdownDescription: "Billing policy id (guid) for pay-as-you-go environments using Azure subscription billing",
-							Computed:            true,
-						},
-						"dataverse": schema.SingleNestedAttribute{
-							MarkdownDescription: "Dataverse environment details",
-							Description:         "Dataverse environment details",
-							Computed:            true,
-							Attributes: map[string]schema.Attribute{
-								"url": schema.StringAttribute{
-									Description:         "Url of the environment",
-									MarkdownDescription: "Url of the environment",
-									Computed:            true,
-								},
-								"domain": schema.StringAttribute{
-									Description:         "Domain name of the environment",
-									MarkdownDescription: "Domain name of the environment",
-									Computed:            true,
-								},
-								"organization_id": schema.StringAttribute{
-									Description:         "Unique organization id (guid)",
-									MarkdownDescription: "Unique organization id (guid)",
-									Computed:            true,
-								},
-								"security_group_id": schema.StringAttribute{
-									Description:         "Unique security group id (guid)",
-									MarkdownDescription: "Unique security group id (guid)",
-									Computed:            true,
-								},
-								"language_code": schema.Int64Attribute{
-									Description:         "Unique language LCID (integer)",
-									MarkdownDescription: "Unique language LCID (integer)",
-									Computed:            true,
-								},
-								"version": schema.StringAttribute{
-									Description:         "Version of the environment",
-									MarkdownDescription: "Version of the environment",
-									Computed:            true,
-								},
-								//Not available in BAPI as for now
-								// "currency_name": &schema.StringAttribute{
-								// 	Description:         "Unique currency name (EUR, USE, GBP etc.)",
-								// 	MarkdownDescription: "Unique currency name (EUR, USE, GBP etc.)",
-								// 	Computed:            true,
-								// },
-								"linked_app_type": schema.StringAttribute{
-									Description:         "Type of the linked app (Internal, External etc.)",
-									MarkdownDescription: "Type of the linked app (Internal, External etc.)",
-									Computed:            true,
-								},
-								"linked_app_id": schema.StringAttribute{
-									Description:         "Unique linked app id (guid)",
-									MarkdownDescription: "Unique linked app id (guid)",
-									Computed:            true,
-								},
-								"linked_app_url": schema.StringAttribute{
-									Description:         "URL of the linked D365 app",
-									MarkdownDescription: "URL of the linked D365 app",
-									Computed:            true,
-								},
-								"currency_code": &schema.StringAttribute{
-									Description:         "Unique currency name (EUR, USE, GBP etc.)",
-									MarkdownDescription: "Unique currency name (EUR, USE, GBP etc.)",
-									Computed:            true,
-								},
-								"templates": schema.ListAttribute{
-									Description:         "The selected instance provisioning template (if any)",
-									MarkdownDescription: "The selected instance provisioning template (if any)",
-									Computed:            true,
-									ElementType:         types.StringType,
-								},
-								"template_metadata": schema.StringAttribute{
-									Description:         "Additional D365 environment template metadata (if any)",
-									MarkdownDescription: "Additional D365 environment template metadata (if any)",
-									Computed:            true,
-								},
-							},
-						},
-					},
+					Attributes: environmentDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// environmentDataSourceAttributes returns the computed attributes of an environment, shared by the environment data sources.
+func environmentDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Unique environment id (guid)",
+			Description:         "Unique environment id (guid)",
+			Computed:            true,
+		},
+		"location": schema.StringAttribute{
+			Description:         "Location of the environment (europe, unitedstates etc.). Can be queried using the `powerplatform_locations` data source.",
+			MarkdownDescription: "Location of the environment (europe, unitedstates etc.). Can be queried using the `powerplatform_locations` data source.",
+			Computed:            true,
+		},
+		"azure_region": schema.StringAttribute{
+			Description:         "Azure region of the environment (westeurope, eastus etc.). Can be queried using the `powerplatform_locations` data source.",
+			MarkdownDescription: "Azure region of the environment (westeurope, eastus etc.). Can be queried using the `powerplatform_locations` data source.",
+			Computed:            true,
+		},
+		"environment_type": schema.StringAttribute{
+			Description:         "Type of the environment (Sandbox, Production etc.)",
+			MarkdownDescription: "Type of the environment (Sandbox, Production etc.)",
+			Computed:            true,
+		},
+		"display_name": schema.StringAttribute{
+			MarkdownDescription: "Display name",
+			Description:         "Display name",
+			Computed:            true,
+		},
+		"billing_policy_id": &schema.StringAttribute{
+			Description:         "Billing policy id (guid) for pay-as-you-go environments using Azure subscription billing",
+			MarkdownDescription: "Billing policy id (guid) for pay-as-you-go environments using Azure subscription billing",
+			Computed:            true,
+		},
+		"states": schema.SingleNestedAttribute{
+			MarkdownDescription: "States of the environment",
+			Description:         "States of the environment",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"management": schema.StringAttribute{
+					Description:         "Management state of the environment (Ready, NotSpecified etc.)",
+					MarkdownDescription: "Management state of the environment (Ready, NotSpecified etc.)",
+					Computed:            true,
+				},
+				"runtime": schema.StringAttribute{
+					Description:         "Runtime state of the environment (Enabled, Disabled etc.)",
+					MarkdownDescription: "Runtime state of the environment (Enabled, Disabled etc.)",
+					Computed:            true,
+				},
+			},
+		},
+		"provisioning_state": schema.StringAttribute{
+			Description:         "Provisioning state of the environment (Succeeded, Failed etc.)",
+			MarkdownDescription: "Provisioning state of the environment (Succeeded, Failed etc.)",
+			Computed:            true,
+		},
+		"tenant_id": schema.StringAttribute{
+			Description:         "Id of the tenant (guid) the environment belongs to",
+			MarkdownDescription: "Id of the tenant (guid) the environment belongs to",
+			Computed:            true,
+		},
+		"protection_level": schema.StringAttribute{
+			Description:         "Governance protection level of the environment. 'Standard' for managed environments, 'Basic' otherwise",
+			MarkdownDescription: "Governance protection level of the environment. `Standard` for managed environments, `Basic` otherwise",
+			Computed:            true,
+		},
+		"created_by": schema.StringAttribute{
+			Description:         "Display name of the user or system that created the environment",
+			MarkdownDescription: "Display name of the user or system that created the environment",
+			Computed:            true,
+		},
+		"created_time": schema.StringAttribute{
+			Description:         "Time when the environment was created",
+			MarkdownDescription: "Time when the environment was created",
+			Computed:            true,
+		},
+		"dataverse": schema.SingleNestedAttribute{
+			MarkdownDescription: "Dataverse environment details",
+			Description:         "Dataverse environment details",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"url": schema.StringAttribute{
+					Description:         "Url of the environment",
+					MarkdownDescription: "Url of the environment",
+					Computed:            true,
+				},
+				"domain": schema.StringAttribute{
+					Description:         "Domain name of the environment",
+					MarkdownDescription: "Domain name of the environment",
+					Computed:            true,
+				},
+				"organization_id": schema.StringAttribute{
+					Description:         "Unique organization id (guid)",
+					MarkdownDescription: "Unique organization id (guid)",
+					Computed:            true,
+				},
+				"security_group_id": schema.StringAttribute{
+					Description:         "Unique security group id (guid)",
+					MarkdownDescription: "Unique security group id (guid)",
+					Computed:            true,
+				},
+				"language_code": schema.Int64Attribute{
+					Description:         "Unique language LCID (integer)",
+					MarkdownDescription: "Unique language LCID (integer)",
+					Computed:            true,
+				},
+				"version": schema.StringAttribute{
+					Description:         "Version of the environment",
+					MarkdownDescription: "Version of the environment",
+					Computed:            true,
+				},
+				//Not available in BAPI as for now
+				// "currency_name": &schema.StringAttribute{
+				// 	Description:         "Unique currency name (EUR, USE, GBP etc.)",
+				// 	MarkdownDescription: "Unique currency name (EUR, USE, GBP etc.)",
+				// 	Computed:            true,
+				// },
+				"linked_app_type": schema.StringAttribute{
+					Description:         "Type of the linked app (Internal, External etc.)",
+					MarkdownDescription: "Type of the linked app (Internal, External etc.)",
+					Computed:            true,
+				},
+				"linked_app_id": schema.StringAttribute{
+					Description:         "Unique linked app id (guid)",
+					MarkdownDescription: "Unique linked app id (guid)",
+					Computed:            true,
+				},
+				"linked_app_url": schema.StringAttribute{
+					Description:         "URL of the linked D365 app",
+					MarkdownDescription: "URL of the linked D365 app",
+					Computed:            true,
+				},
+				"currency_code": &schema.StringAttribute{
+					Description:         "Unique currency name (EUR, USE, GBP etc.)",
+					MarkdownDescription: "Unique currency name (EUR, USE, GBP etc.)",
+					Computed:            true,
+				},
+				"templates": schema.ListAttribute{
+					Description:         "The selected instance provisioning template (if any)",
+					MarkdownDescription: "The selected instance provisioning template (if any)",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"template_metadata": schema.StringAttribute{
+					Description:         "Additional D365 environment template metadata (if any)",
+					MarkdownDescription: "Additional D365 environment template metadata (if any)",
+					Computed:            true,
 				},
 			},
 		},
@@ -185,6 +276,31 @@ func (d *EnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE ENVIRONMENTS START: %s", d.ProviderTypeName))
 
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var displayNameRegex *regexp.Regexp
+	if !state.DisplayNameRegex.IsNull() {
+		var err error
+		displayNameRegex, err = regexp.Compile(state.DisplayNameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("display_name_regex"), "Invalid regular expression", err.Error())
+			return
+		}
+	}
+
+	var createdAfter *time.Time
+	if !state.CreatedAfter.IsNull() {
+		t, err := time.Parse(time.RFC3339, state.CreatedAfter.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("created_after"), "Invalid time", fmt.Sprintf("'%s' is not a valid RFC3339 time: %s", state.CreatedAfter.ValueString(), err.Error()))
+			return
+		}
+		createdAfter = &t
+	}
+
 	envs, err := d.EnvironmentClient.GetEnvironments(ctx)
 
 	if err != nil {
@@ -192,22 +308,50 @@ func (d *EnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	state.Environments = []EnvironmentDataSourceModel{}
 	for _, env := range envs {
+		if !state.EnvironmentType.IsNull() && env.Properties.EnvironmentSku != state.EnvironmentType.ValueString() {
+			continue
+		}
+		if !state.Location.IsNull() && env.Location != state.Location.ValueString() {
+			continue
+		}
+		if displayNameRegex != nil && !displayNameRegex.MatchString(env.Properties.DisplayName) {
+			continue
+		}
+		if !state.HasDataverse.IsNull() && env.HasDataverse() != state.HasDataverse.ValueBool() {
+			continue
+		}
+		if !state.IsManaged.IsNull() && env.IsManaged() != state.IsManaged.ValueBool() {
+			continue
+		}
+		if !state.BillingPolicyId.IsNull() && (env.Properties.BillingPolicy == nil || !strings.EqualFold(env.Properties.BillingPolicy.Id, state.BillingPolicyId.ValueString())) {
+			continue
+		}
+		if createdAfter != nil {
+			createdTime, err := time.Parse(time.RFC3339, env.Properties.CreatedTime)
+			if err != nil || !createdTime.After(*createdAfter) {
+				continue
+			}
+		}
+
 		currencyCode := ""
-		defaultCurrency, err := d.EnvironmentClient.GetDefaultCurrencyForEnvironment(ctx, env.Name)
-		if err != nil {
-			resp.Diagnostics.AddWarning(fmt.Sprintf("Error when reading default currency for environment %s", env.Name), err.Error())
-		} else {
-			currencyCode = defaultCurrency.IsoCurrencyCode
+		if env.HasDataverse() {
+			defaultCurrency, err := d.EnvironmentClient.GetDefaultCurrencyForEnvironment(ctx, env.Name)
+			if err != nil {
+				resp.Diagnostics.AddWarning(fmt.Sprintf("Error when reading default currency for environment %s", env.Name), err.Error())
+			} else {
+				currencyCode = defaultCurrency.IsoCurrencyCode
+			}
 		}
-		env, err := ConvertSourceModelFromEnvironmentDto(env, &currencyCode, nil, nil)
+		environment, err := ConvertDataSourceModelFromEnvironmentDto(env, &currencyCode)
 		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error when converting environment %s", env.DisplayName), err.Error())
+			resp.Diagnostics.AddError(fmt.Sprintf("Error when converting environment %s", env.Properties.DisplayName), err.Error())
 			return
 		}
-		state.Environments = append(state.Environments, *env)
+		state.Environments = append(state.Environments, *environment)
 	}
-	state.Id = types.Int64Value(int64(len(envs)))
+	state.Id = types.Int64Value(int64(len(state.Environments)))
 
 	diags := resp.State.Set(ctx, &state)
 
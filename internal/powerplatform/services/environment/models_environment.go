@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
 )
 
 var (
@@ -38,6 +39,16 @@ type EnvironmentPropertiesDto struct {
 	GovernanceConfiguration   GovernanceConfigurationDto    `json:"governanceConfiguration"`
 	BillingPolicy             *BillingPolicyDto             `json:"billingPolicy,omitempty"`
 	ProvisioningState         string                        `json:"provisioningState,omitempty"`
+	CreatedTime               string                        `json:"createdTime,omitempty"`
+	CreatedBy                 *EnvironmentCreatedByDto      `json:"createdBy,omitempty"`
+}
+
+type EnvironmentCreatedByDto struct {
+	Id                string `json:"id"`
+	DisplayName       string `json:"displayName"`
+	Email             string `json:"email,omitempty"`
+	Type              string `json:"type"`
+	UserPrincipalName string `json:"userPrincipalName,omitempty"`
 }
 
 type BillingPolicyDto struct {
@@ -87,6 +98,11 @@ type LinkedAppMetadataDto struct {
 
 type StatesEnvironmentDto struct {
 	Management StatesManagementEnvironmentDto `json:"management"`
+	Runtime    *StatesRuntimeEnvironmentDto   `json:"runtime,omitempty"`
+}
+
+type StatesRuntimeEnvironmentDto struct {
+	Id string `json:"id"`
 }
 
 type StatesManagementEnvironmentDto struct {
@@ -183,8 +199,50 @@ type ValidateEnvironmentDetailsDto struct {
 }
 
 type EnvironmentsListDataSourceModel struct {
-	Environments []EnvironmentSourceModel `tfsdk:"environments"`
-	Id           types.Int64              `tfsdk:"id"`
+	Environments     []EnvironmentDataSourceModel `tfsdk:"environments"`
+	Id               types.Int64                  `tfsdk:"id"`
+	EnvironmentType  types.String                 `tfsdk:"environment_type"`
+	Location         types.String                 `tfsdk:"location"`
+	DisplayNameRegex types.String                 `tfsdk:"display_name_regex"`
+	HasDataverse     types.Bool                   `tfsdk:"has_dataverse"`
+	IsManaged        types.Bool                   `tfsdk:"is_managed"`
+	BillingPolicyId  types.String                 `tfsdk:"billing_policy_id"`
+	CreatedAfter     types.String                 `tfsdk:"created_after"`
+}
+
+type EnvironmentDataSourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Location          types.String `tfsdk:"location"`
+	AzureRegion       types.String `tfsdk:"azure_region"`
+	DisplayName       types.String `tfsdk:"display_name"`
+	EnvironmentType   types.String `tfsdk:"environment_type"`
+	BillingPolicyId   types.String `tfsdk:"billing_policy_id"`
+	States            types.Object `tfsdk:"states"`
+	ProvisioningState types.String `tfsdk:"provisioning_state"`
+	TenantId          types.String `tfsdk:"tenant_id"`
+	ProtectionLevel   types.String `tfsdk:"protection_level"`
+	CreatedBy         types.String `tfsdk:"created_by"`
+	CreatedTime       types.String `tfsdk:"created_time"`
+
+	Dataverse types.Object `tfsdk:"dataverse"`
+}
+
+type EnvironmentLookupDataSourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	DomainName        types.String `tfsdk:"domain_name"`
+	Location          types.String `tfsdk:"location"`
+	AzureRegion       types.String `tfsdk:"azure_region"`
+	DisplayName       types.String `tfsdk:"display_name"`
+	EnvironmentType   types.String `tfsdk:"environment_type"`
+	BillingPolicyId   types.String `tfsdk:"billing_policy_id"`
+	States            types.Object `tfsdk:"states"`
+	ProvisioningState types.String `tfsdk:"provisioning_state"`
+	TenantId          types.String `tfsdk:"tenant_id"`
+	ProtectionLevel   types.String `tfsdk:"protection_level"`
+	CreatedBy         types.String `tfsdk:"created_by"`
+	CreatedTime       types.String `tfsdk:"created_time"`
+
+	Dataverse types.Object `tfsdk:"dataverse"`
 }
 
 type EnvironmentSourceModel struct {
@@ -427,6 +485,133 @@ func ConvertSourceModelFromEnvironmentDto(environmentDto EnvironmentDto, currenc
 	return model, nil
 }
 
+// HasDataverse returns true if a Dataverse database is provisioned in the environment.
+func (environmentDto *EnvironmentDto) HasDataverse() bool {
+	return environmentDto.Properties.LinkedEnvironmentMetadata != nil
+}
+
+// IsManaged returns true if the environment is a managed environment.
+func (environmentDto *EnvironmentDto) IsManaged() bool {
+	return environmentDto.Properties.GovernanceConfiguration.ProtectionLevel == "Standard"
+}
+
+// DomainName returns the Dataverse domain name of the environment, or an empty string for environments without Dataverse.
+func (environmentDto *EnvironmentDto) DomainName() string {
+	if environmentDto.Properties.LinkedEnvironmentMetadata == nil {
+		return ""
+	}
+	return environmentDto.Properties.LinkedEnvironmentMetadata.DomainName
+}
+
+var environmentStatesObjectType = map[string]attr.Type{
+	"management": types.StringType,
+	"runtime":    types.StringType,
+}
+
+func ConvertDataSourceModelFromEnvironmentDto(environmentDto EnvironmentDto, currencyCode *string) (*EnvironmentDataSourceModel, error) {
+	environment, err := ConvertSourceModelFromEnvironmentDto(environmentDto, currencyCode, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	model := &EnvironmentDataSourceModel{
+		Id:                environment.Id,
+		Location:          environment.Location,
+		AzureRegion:       environment.AzureRegion,
+		DisplayName:       environment.DisplayName,
+		EnvironmentType:   environment.EnvironmentType,
+		BillingPolicyId:   environment.BillingPolicyId,
+		ProvisioningState: types.StringValue(environmentDto.Properties.ProvisioningState),
+		TenantId:          types.StringValue(environmentDto.Properties.TenantID),
+		ProtectionLevel:   types.StringValue(environmentDto.Properties.GovernanceConfiguration.ProtectionLevel),
+		CreatedTime:       types.StringValue(environmentDto.Properties.CreatedTime),
+		Dataverse:         environment.Dataverse,
+	}
+
+	if environmentDto.Properties.CreatedBy != nil {
+		if environmentDto.Properties.CreatedBy.DisplayName != "" {
+			model.CreatedBy = types.StringValue(environmentDto.Properties.CreatedBy.DisplayName)
+		} else {
+			model.CreatedBy = types.StringValue(environmentDto.Properties.CreatedBy.Id)
+		}
+	} else {
+		model.CreatedBy = types.StringNull()
+	}
+
+	runtimeState := types.StringNull()
+	if environmentDto.Properties.States.Runtime != nil {
+		runtimeState = types.StringValue(environmentDto.Properties.States.Runtime.Id)
+	}
+	model.States = types.ObjectValueMust(environmentStatesObjectType, map[string]attr.Value{
+		"management": types.StringValue(environmentDto.Properties.States.Management.Id),
+		"runtime":    runtimeState,
+	})
+
+	return model, nil
+}
+
+type LifecycleOperationArrayDto struct {
+	Value    []api.LifecycleDto `json:"value"`
+	NextLink string             `json:"nextLink,omitempty"`
+}
+
+type EnvironmentOperationsDataSourceModel struct {
+	Id            types.String                          `tfsdk:"id"`
+	EnvironmentId types.String                          `tfsdk:"environment_id"`
+	Operations    []EnvironmentOperationDataSourceModel `tfsdk:"operations"`
+}
+
+type EnvironmentOperationDataSourceModel struct {
+	Id             types.String                               `tfsdk:"id"`
+	Type           types.String                               `tfsdk:"type"`
+	State          types.String                               `tfsdk:"state"`
+	CreatedTime    types.String                               `tfsdk:"created_time"`
+	LastActionTime types.String                               `tfsdk:"last_action_time"`
+	RequestedBy    EnvironmentOperationRequestedByModel       `tfsdk:"requested_by"`
+	Stages         []EnvironmentOperationStageDataSourceModel `tfsdk:"stages"`
+}
+
+type EnvironmentOperationRequestedByModel struct {
+	Id          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Type        types.String `tfsdk:"type"`
+}
+
+type EnvironmentOperationStageDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	State           types.String `tfsdk:"state"`
+	FirstActionTime types.String `tfsdk:"first_action_time"`
+	LastActionTime  types.String `tfsdk:"last_action_time"`
+}
+
+func ConvertFromLifecycleDto(lifecycle api.LifecycleDto) EnvironmentOperationDataSourceModel {
+	model := EnvironmentOperationDataSourceModel{
+		Id:             types.StringValue(lifecycle.Id),
+		Type:           types.StringValue(lifecycle.Type.Id),
+		State:          types.StringValue(lifecycle.State.Id),
+		CreatedTime:    types.StringValue(lifecycle.CreatedDateTime),
+		LastActionTime: types.StringValue(lifecycle.LastActionDateTime),
+		RequestedBy: EnvironmentOperationRequestedByModel{
+			Id:          types.StringValue(lifecycle.RequestedBy.Id),
+			DisplayName: types.StringValue(lifecycle.RequestedBy.DisplayName),
+			Type:        types.StringValue(lifecycle.RequestedBy.Type),
+		},
+		Stages: []EnvironmentOperationStageDataSourceModel{},
+	}
+
+	for _, stage := range lifecycle.Stages {
+		model.Stages = append(model.Stages, EnvironmentOperationStageDataSourceModel{
+			Id:              types.StringValue(stage.Id),
+			Name:            types.StringValue(stage.Name),
+			State:           types.StringValue(stage.State.Id),
+			FirstActionTime: types.StringValue(stage.FirstActionDateTime),
+			LastActionTime:  types.StringValue(stage.LastActionDateTime),
+		})
+	}
+	return model
+}
+
 type LocationArrayDto struct {
 	Value []LocationDto `json:"value"`
 }
@@ -0,0 +1,157 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	constants "github.com/microsoft/terraform-provider-power-platform/constants"
+)
+
+// GetOrganizationAttributes returns the metadata of the columns of the organization table, indexed by logical name.
+func (client *EnvironmentSettingsClient) GetOrganizationAttributes(ctx context.Context, environmentId string) (map[string]OrganizationAttributeMetadataDto, error) {
+	environmentUrl, err := client.GetEnvironmentUrlById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   strings.TrimPrefix(environmentUrl, "https://"),
+		Path:   fmt.Sprintf("/api/data/%s/EntityDefinitions(LogicalName='organization')/Attributes", constants.DATAVERSE_API_VERSION),
+	}
+	values := url.Values{}
+	values.Add("$select", "LogicalName,AttributeType,IsValidForUpdate")
+	apiUrl.RawQuery = values.Encode()
+
+	attributes := OrganizationAttributeMetadataArrayDto{}
+	_, err = client.Api.Execute(ctx, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	attributesByName := map[string]OrganizationAttributeMetadataDto{}
+	for _, attribute := range attributes.Value {
+		attributesByName[attribute.LogicalName] = attribute
+	}
+	return attributesByName, nil
+}
+
+// ConvertToOrganizationColumns checks the given settings against the organization table metadata
+// and converts their values to the type of the matching column.
+func (client *EnvironmentSettingsClient) ConvertToOrganizationColumns(ctx context.Context, environmentId string, settings map[string]string) (map[string]any, error) {
+	attributes, err := client.GetOrganizationAttributes(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(settings))
+	for name := range settings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	columns := map[string]any{}
+	errors := []string{}
+	for _, name := range names {
+		attribute, ok := attributes[name]
+		if !ok {
+			errors = append(errors, fmt.Sprintf("column '%s' does not exist in the organization table", name))
+			continue
+		}
+		value, err := ConvertToOrganizationColumnValue(attribute, settings[name])
+		if err != nil {
+			errors = append(errors, err.Error())
+			continue
+		}
+		columns[name] = value
+	}
+	if len(errors) > 0 {
+		return nil, fmt.Errorf("invalid organization settings: %s", strings.Join(errors, ", "))
+	}
+	return columns, nil
+}
+
+// GetOrganizationSettings reads the given columns and the orgdborgsettings column of the organization of an environment.
+func (client *EnvironmentSettingsClient) GetOrganizationSettings(ctx context.Context, environmentId string, columns []string) (map[string]any, error) {
+	environmentUrl, err := client.GetEnvironmentUrlById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	selectedColumns := []string{ORGANIZATION_ID_COLUMN, ORGANIZATION_ORGDB_SETTINGS_COLUMN}
+	for _, column := range columns {
+		if column != ORGANIZATION_ID_COLUMN && column != ORGANIZATION_ORGDB_SETTINGS_COLUMN {
+			selectedColumns = append(selectedColumns, column)
+		}
+	}
+
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   strings.TrimPrefix(environmentUrl, "https://"),
+		Path:   fmt.Sprintf("/api/data/%s/organizations", constants.DATAVERSE_API_VERSION),
+	}
+	values := url.Values{}
+	values.Add("$select", strings.Join(selectedColumns, ","))
+	apiUrl.RawQuery = values.Encode()
+
+	organizations := OrganizationValueDto{}
+	_, err = client.Api.Execute(ctx, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &organizations)
+	if err != nil {
+		return nil, err
+	}
+	if len(organizations.Value) == 0 {
+		return nil, fmt.Errorf("no organization found in environment '%s'", environmentId)
+	}
+	return organizations.Value[0], nil
+}
+
+// UpdateOrganizationSettings writes the given typed columns to the organization of an environment. The keys of
+// orgDbSettings are set in the orgdborgsettings column and the removedOrgDbSettings keys are removed from it,
+// all other keys of the orgdborgsettings column are left as they are.
+func (client *EnvironmentSettingsClient) UpdateOrganizationSettings(ctx context.Context, environmentId string, columns map[string]any, orgDbSettings map[string]string, removedOrgDbSettings []string) error {
+	organization, err := client.GetOrganizationSettings(ctx, environmentId, nil)
+	if err != nil {
+		return err
+	}
+
+	body := map[string]any{}
+	for name, value := range columns {
+		body[name] = value
+	}
+
+	if len(orgDbSettings) > 0 || len(removedOrgDbSettings) > 0 {
+		currentOrgDbSettings, _ := organization[ORGANIZATION_ORGDB_SETTINGS_COLUMN].(string)
+		mergedOrgDbSettings, err := MergeOrgDbOrgSettings(currentOrgDbSettings, orgDbSettings, removedOrgDbSettings)
+		if err != nil {
+			return err
+		}
+		if mergedOrgDbSettings != currentOrgDbSettings {
+			body[ORGANIZATION_ORGDB_SETTINGS_COLUMN] = mergedOrgDbSettings
+		}
+	}
+
+	if len(body) == 0 {
+		return nil
+	}
+
+	environmentUrl, err := client.GetEnvironmentUrlById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   strings.TrimPrefix(environmentUrl, "https://"),
+		Path:   fmt.Sprintf("/api/data/%s/organizations(%s)", constants.DATAVERSE_API_VERSION, organization[ORGANIZATION_ID_COLUMN]),
+	}
+
+	_, err = client.Api.Execute(ctx, "PATCH", apiUrl.String(), nil, body, []int{http.StatusNoContent}, nil)
+	return err
+}
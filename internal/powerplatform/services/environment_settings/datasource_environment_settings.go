@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
 )
@@ -141,6 +142,11 @@ func (d *EnvironmentSettingsDataSource) Schema(_ context.Context, _ datasource.S
 								MarkdownDescription: "Is read audit enabled",
 								Optional:            true,
 							},
+							"log_retention_period_in_days": schema.Int64Attribute{
+								Description:         "Number of days audit logs are retained, -1 when they are retained forever",
+								MarkdownDescription: "Number of days audit logs are retained, `-1` when they are retained forever",
+								Computed:            true,
+							},
 						},
 					},
 				},
@@ -195,6 +201,81 @@ func (d *EnvironmentSettingsDataSource) Schema(_ context.Context, _ datasource.S
 					},
 				},
 			},
+			"security": schema.SingleNestedAttribute{
+				Description:         "Security",
+				MarkdownDescription: "Security. See [Security settings](https://learn.microsoft.com/power-platform/admin/settings-privacy-security) for more details.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"ip_firewall": schema.SingleNestedAttribute{
+						Description:         "IP firewall",
+						MarkdownDescription: "IP firewall. See [IP firewall in Power Platform environments](https://learn.microsoft.com/power-platform/admin/ip-firewall) for more details.",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"enabled": schema.BoolAttribute{
+								Description:         "Is the IP firewall enabled",
+								MarkdownDescription: "Is the IP firewall enabled",
+								Computed:            true,
+							},
+							"audit_only": schema.BoolAttribute{
+								Description:         "Only log the requests that would be blocked by the IP firewall instead of blocking them",
+								MarkdownDescription: "Only log the requests that would be blocked by the IP firewall instead of blocking them",
+								Computed:            true,
+							},
+							"allowed_ip_ranges": schema.SetAttribute{
+								Description:         "IP addresses and ranges in CIDR notation that are allowed to access the environment",
+								MarkdownDescription: "IP addresses and ranges in CIDR notation that are allowed to access the environment",
+								ElementType:         types.StringType,
+								Computed:            true,
+							},
+							"allowed_service_tags": schema.SetAttribute{
+								Description:         "Azure service tags that are allowed to access the environment",
+								MarkdownDescription: "Azure service tags that are allowed to access the environment",
+								ElementType:         types.StringType,
+								Computed:            true,
+							},
+							"allow_application_users": schema.BoolAttribute{
+								Description:         "Allow application users to bypass the IP firewall",
+								MarkdownDescription: "Allow application users to bypass the IP firewall",
+								Computed:            true,
+							},
+							"allow_microsoft_trusted_services": schema.BoolAttribute{
+								Description:         "Allow Microsoft trusted services to bypass the IP firewall",
+								MarkdownDescription: "Allow Microsoft trusted services to bypass the IP firewall",
+								Computed:            true,
+							},
+						},
+					},
+					"ip_based_cookie_binding_enabled": schema.BoolAttribute{
+						Description:         "Bind session cookies to the IP address they were issued to",
+						MarkdownDescription: "Bind session cookies to the IP address they were issued to",
+						Computed:            true,
+					},
+					"session_timeout": schema.SingleNestedAttribute{
+						Description:         "Session timeout",
+						MarkdownDescription: "Session timeout",
+						Computed:            true,
+						Attributes:          timeoutDataSourceAttributes("session"),
+					},
+					"inactivity_timeout": schema.SingleNestedAttribute{
+						Description:         "Inactivity timeout",
+						MarkdownDescription: "Inactivity timeout",
+						Computed:            true,
+						Attributes:          timeoutDataSourceAttributes("inactivity"),
+					},
+					"blocked_file_extensions": schema.SetAttribute{
+						Description:         "File extensions that can't be attached to records",
+						MarkdownDescription: "File extensions that can't be attached to records",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+					"blocked_mime_types": schema.SetAttribute{
+						Description:         "MIME types that can't be attached to records",
+						MarkdownDescription: "MIME types that can't be attached to records",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -202,3 +283,23 @@ func (d *EnvironmentSettingsDataSource) Schema(_ context.Context, _ datasource.S
 func (d *EnvironmentSettingsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + d.TypeName
 }
+
+func timeoutDataSourceAttributes(name string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"enabled": schema.BoolAttribute{
+			Description:         fmt.Sprintf("Is the %s timeout enabled", name),
+			MarkdownDescription: fmt.Sprintf("Is the %s timeout enabled", name),
+			Computed:            true,
+		},
+		"timeout_in_minutes": schema.Int64Attribute{
+			Description:         fmt.Sprintf("Duration of the %s timeout in minutes", name),
+			MarkdownDescription: fmt.Sprintf("Duration of the %s timeout in minutes", name),
+			Computed:            true,
+		},
+		"warning_in_minutes": schema.Int64Attribute{
+			Description:         fmt.Sprintf("Number of minutes before the %s timeout when users are warned", name),
+			MarkdownDescription: fmt.Sprintf("Number of minutes before the %s timeout when users are warned", name),
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	ORGANIZATION_ID_COLUMN             = "organizationid"
+	ORGANIZATION_ORGDB_SETTINGS_COLUMN = "orgdborgsettings"
+)
+
+var orgDbOrgSettingKeyRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+
+type EnvironmentOrganizationSettingsResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	Settings      types.Map    `tfsdk:"settings"`
+	OrgDbSettings types.Map    `tfsdk:"orgdb_settings"`
+}
+
+type OrganizationAttributeMetadataArrayDto struct {
+	Value []OrganizationAttributeMetadataDto `json:"value"`
+}
+
+type OrganizationAttributeMetadataDto struct {
+	LogicalName      string `json:"LogicalName"`
+	AttributeType    string `json:"AttributeType"`
+	IsValidForUpdate bool   `json:"IsValidForUpdate"`
+}
+
+type OrganizationValueDto struct {
+	Value []map[string]any `json:"value"`
+}
+
+type orgDbOrgSettingsDto struct {
+	XMLName  xml.Name             `xml:"OrgSettings"`
+	Settings []orgDbOrgSettingDto `xml:",any"`
+}
+
+type orgDbOrgSettingDto struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+// ConvertToOrganizationColumnValue converts the string value of a setting to the type of its organization column.
+func ConvertToOrganizationColumnValue(attribute OrganizationAttributeMetadataDto, value string) (any, error) {
+	if !attribute.IsValidForUpdate {
+		return nil, fmt.Errorf("column '%s' is read-only", attribute.LogicalName)
+	}
+
+	switch attribute.AttributeType {
+	case "Boolean":
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("column '%s' expects a boolean value, got '%s'", attribute.LogicalName, value)
+		}
+		return v, nil
+	case "Integer", "BigInt", "Picklist", "State", "Status":
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("column '%s' expects an integer value, got '%s'", attribute.LogicalName, value)
+		}
+		return v, nil
+	case "Decimal", "Double", "Money":
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("column '%s' expects a decimal value, got '%s'", attribute.LogicalName, value)
+		}
+		return v, nil
+	case "DateTime":
+		if value == "" {
+			return nil, nil
+		}
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			return nil, fmt.Errorf("column '%s' expects an RFC3339 date and time value, got '%s'", attribute.LogicalName, value)
+		}
+		return value, nil
+	case "String", "Memo":
+		if value == "" {
+			return nil, nil
+		}
+		return value, nil
+	default:
+		return nil, fmt.Errorf("column '%s' of type '%s' is not supported", attribute.LogicalName, attribute.AttributeType)
+	}
+}
+
+// ConvertFromOrganizationColumnValue converts the value of an organization column returned by the Web API to a string.
+func ConvertFromOrganizationColumnValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// OrganizationColumnValuesEqual returns true when two string values of a column represent the same value,
+// i.e. "1.0" and "1", "True" and "true" or two equal dates in different time zones.
+func OrganizationColumnValuesEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	if af, err := strconv.ParseFloat(a, 64); err == nil {
+		if bf, err := strconv.ParseFloat(b, 64); err == nil {
+			return af == bf
+		}
+	}
+	if ab, err := strconv.ParseBool(a); err == nil {
+		if bb, err := strconv.ParseBool(b); err == nil {
+			return ab == bb
+		}
+	}
+	if at, err := time.Parse(time.RFC3339, a); err == nil {
+		if bt, err := time.Parse(time.RFC3339, b); err == nil {
+			return at.Equal(bt)
+		}
+	}
+	return false
+}
+
+// ParseOrgDbOrgSettings returns the keys of an orgdborgsettings xml document with their values.
+func ParseOrgDbOrgSettings(orgDbOrgSettings string) (map[string]string, error) {
+	settings := map[string]string{}
+	if strings.TrimSpace(orgDbOrgSettings) == "" {
+		return settings, nil
+	}
+
+	dto := orgDbOrgSettingsDto{}
+	if err := xml.Unmarshal([]byte(orgDbOrgSettings), &dto); err != nil {
+		return nil, fmt.Errorf("unable to parse the orgdborgsettings column: %s", err.Error())
+	}
+	for _, setting := range dto.Settings {
+		settings[setting.XMLName.Local] = setting.Value
+	}
+	return settings, nil
+}
+
+// MergeOrgDbOrgSettings sets and removes keys of an orgdborgsettings xml document. The other keys keep their value and order,
+// new keys are appended in alphabetical order.
+func MergeOrgDbOrgSettings(orgDbOrgSettings string, settings map[string]string, removed []string) (string, error) {
+	dto := orgDbOrgSettingsDto{}
+	if strings.TrimSpace(orgDbOrgSettings) != "" {
+		if err := xml.Unmarshal([]byte(orgDbOrgSettings), &dto); err != nil {
+			return "", fmt.Errorf("unable to parse the orgdborgsettings column: %s", err.Error())
+		}
+	}
+
+	removedKeys := map[string]bool{}
+	for _, key := range removed {
+		removedKeys[key] = true
+	}
+
+	merged := orgDbOrgSettingsDto{}
+	existingKeys := map[string]bool{}
+	changed := false
+	for _, setting := range dto.Settings {
+		key := setting.XMLName.Local
+		existingKeys[key] = true
+		if removedKeys[key] {
+			changed = true
+			continue
+		}
+		if value, ok := settings[key]; ok && value != setting.Value {
+			setting.Value = value
+			changed = true
+		}
+		merged.Settings = append(merged.Settings, orgDbOrgSettingDto{XMLName: xml.Name{Local: key}, Value: setting.Value})
+	}
+
+	newKeys := []string{}
+	for key := range settings {
+		if !existingKeys[key] {
+			newKeys = append(newKeys, key)
+		}
+	}
+	sort.Strings(newKeys)
+	for _, key := range newKeys {
+		merged.Settings = append(merged.Settings, orgDbOrgSettingDto{XMLName: xml.Name{Local: key}, Value: settings[key]})
+		changed = true
+	}
+
+	if !changed {
+		return orgDbOrgSettings, nil
+	}
+
+	output, err := xml.Marshal(merged)
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
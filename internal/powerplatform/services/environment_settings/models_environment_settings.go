@@ -5,6 +5,8 @@ package powerplatform
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -17,6 +19,7 @@ type EnvironmentSettingsSourceModel struct {
 	AuditAndLogs  types.Object `tfsdk:"audit_and_logs"`
 	Email         types.Object `tfsdk:"email"`
 	Product       types.Object `tfsdk:"product"`
+	Security      types.Object `tfsdk:"security"`
 }
 
 type AuditAndLogsSourceModel struct {
@@ -25,9 +28,10 @@ type AuditAndLogsSourceModel struct {
 }
 
 type AuditSettingsSourceModel struct {
-	IsAuditEnabled           types.Bool `tfsdk:"is_audit_enabled"`
-	IsUserAccessAuditEnabled types.Bool `tfsdk:"is_user_access_audit_enabled"`
-	IsReadAuditEnabled       types.Bool `tfsdk:"is_read_audit_enabled"`
+	IsAuditEnabled           types.Bool  `tfsdk:"is_audit_enabled"`
+	IsUserAccessAuditEnabled types.Bool  `tfsdk:"is_user_access_audit_enabled"`
+	IsReadAuditEnabled       types.Bool  `tfsdk:"is_read_audit_enabled"`
+	LogRetentionPeriodInDays types.Int64 `tfsdk:"log_retention_period_in_days"`
 }
 
 type EmailSourceModel struct {
@@ -51,6 +55,30 @@ type FeaturesSourceModel struct {
 	PowerAppsComponentFrameworkForCanvasApps types.Bool `tfsdk:"power_apps_component_framework_for_canvas_apps"`
 }
 
+type SecuritySourceModel struct {
+	IpFirewall                  types.Object `tfsdk:"ip_firewall"`
+	IpBasedCookieBindingEnabled types.Bool   `tfsdk:"ip_based_cookie_binding_enabled"`
+	SessionTimeout              types.Object `tfsdk:"session_timeout"`
+	InactivityTimeout           types.Object `tfsdk:"inactivity_timeout"`
+	BlockedFileExtensions       types.Set    `tfsdk:"blocked_file_extensions"`
+	BlockedMimeTypes            types.Set    `tfsdk:"blocked_mime_types"`
+}
+
+type IpFirewallSourceModel struct {
+	Enabled                       types.Bool `tfsdk:"enabled"`
+	AuditOnly                     types.Bool `tfsdk:"audit_only"`
+	AllowedIpRanges               types.Set  `tfsdk:"allowed_ip_ranges"`
+	AllowedServiceTags            types.Set  `tfsdk:"allowed_service_tags"`
+	AllowApplicationUsers         types.Bool `tfsdk:"allow_application_users"`
+	AllowMicrosoftTrustedServices types.Bool `tfsdk:"allow_microsoft_trusted_services"`
+}
+
+type TimeoutSourceModel struct {
+	Enabled          types.Bool  `tfsdk:"enabled"`
+	TimeoutInMinutes types.Int64 `tfsdk:"timeout_in_minutes"`
+	WarningInMinutes types.Int64 `tfsdk:"warning_in_minutes"`
+}
+
 func ConvertFromEnvironmentSettingsModel(ctx context.Context, environmentSettings EnvironmentSettingsSourceModel) EnvironmentSettingsDto {
 	environmentSettingsDto := EnvironmentSettingsDto{}
 	auditSettingsObject := environmentSettings.AuditAndLogs.Attributes()["audit_settings"]
@@ -67,6 +95,9 @@ func ConvertFromEnvironmentSettingsModel(ctx context.Context, environmentSetting
 		if !auditAndLogsSourceModel.IsReadAuditEnabled.IsNull() && !auditAndLogsSourceModel.IsReadAuditEnabled.IsUnknown() {
 			environmentSettingsDto.IsReadAuditEnabled = auditAndLogsSourceModel.IsReadAuditEnabled.ValueBoolPointer()
 		}
+		if !auditAndLogsSourceModel.LogRetentionPeriodInDays.IsNull() && !auditAndLogsSourceModel.LogRetentionPeriodInDays.IsUnknown() {
+			environmentSettingsDto.AuditRetentionPeriodV2 = auditAndLogsSourceModel.LogRetentionPeriodInDays.ValueInt64Pointer()
+		}
 
 		pluginSettings := environmentSettings.AuditAndLogs.Attributes()["plugin_trace_log_setting"]
 		if pluginSettings != nil && !pluginSettings.IsNull() && !pluginSettings.IsUnknown() {
@@ -113,9 +144,106 @@ func ConvertFromEnvironmentSettingsModel(ctx context.Context, environmentSetting
 		}
 	}
 
+	if !environmentSettings.Security.IsNull() && !environmentSettings.Security.IsUnknown() {
+		var securitySourceModel SecuritySourceModel
+		environmentSettings.Security.As(ctx, &securitySourceModel, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+		convertFromSecuritySourceModel(ctx, securitySourceModel, &environmentSettingsDto)
+	}
+
 	return environmentSettingsDto
 }
 
+func convertFromSecuritySourceModel(ctx context.Context, security SecuritySourceModel, environmentSettingsDto *EnvironmentSettingsDto) {
+	if !security.IpFirewall.IsNull() && !security.IpFirewall.IsUnknown() {
+		var ipFirewall IpFirewallSourceModel
+		security.IpFirewall.As(ctx, &ipFirewall, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+
+		if !ipFirewall.Enabled.IsNull() && !ipFirewall.Enabled.IsUnknown() {
+			environmentSettingsDto.EnableIpBasedFirewallRule = ipFirewall.Enabled.ValueBoolPointer()
+		}
+		if !ipFirewall.AuditOnly.IsNull() && !ipFirewall.AuditOnly.IsUnknown() {
+			environmentSettingsDto.EnableIpBasedFirewallRuleInAuditMode = ipFirewall.AuditOnly.ValueBoolPointer()
+		}
+		if !ipFirewall.AllowedIpRanges.IsNull() && !ipFirewall.AllowedIpRanges.IsUnknown() {
+			environmentSettingsDto.AllowedIpRangeForFirewall = convertFromSettingsSet(ctx, ipFirewall.AllowedIpRanges, ",")
+		}
+		if !ipFirewall.AllowedServiceTags.IsNull() && !ipFirewall.AllowedServiceTags.IsUnknown() {
+			environmentSettingsDto.AllowedServiceTagsForFirewall = convertFromSettingsSet(ctx, ipFirewall.AllowedServiceTags, ",")
+		}
+		if !ipFirewall.AllowApplicationUsers.IsNull() && !ipFirewall.AllowApplicationUsers.IsUnknown() {
+			environmentSettingsDto.AllowApplicationUserAccess = ipFirewall.AllowApplicationUsers.ValueBoolPointer()
+		}
+		if !ipFirewall.AllowMicrosoftTrustedServices.IsNull() && !ipFirewall.AllowMicrosoftTrustedServices.IsUnknown() {
+			environmentSettingsDto.AllowMicrosoftTrustedServiceTags = ipFirewall.AllowMicrosoftTrustedServices.ValueBoolPointer()
+		}
+	}
+
+	if !security.IpBasedCookieBindingEnabled.IsNull() && !security.IpBasedCookieBindingEnabled.IsUnknown() {
+		environmentSettingsDto.EnableIpBasedCookieBinding = security.IpBasedCookieBindingEnabled.ValueBoolPointer()
+	}
+
+	if !security.SessionTimeout.IsNull() && !security.SessionTimeout.IsUnknown() {
+		var sessionTimeout TimeoutSourceModel
+		security.SessionTimeout.As(ctx, &sessionTimeout, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+
+		if !sessionTimeout.Enabled.IsNull() && !sessionTimeout.Enabled.IsUnknown() {
+			environmentSettingsDto.SessionTimeoutEnabled = sessionTimeout.Enabled.ValueBoolPointer()
+		}
+		if !sessionTimeout.TimeoutInMinutes.IsNull() && !sessionTimeout.TimeoutInMinutes.IsUnknown() {
+			environmentSettingsDto.SessionTimeoutInMinutes = sessionTimeout.TimeoutInMinutes.ValueInt64Pointer()
+		}
+		if !sessionTimeout.WarningInMinutes.IsNull() && !sessionTimeout.WarningInMinutes.IsUnknown() {
+			environmentSettingsDto.SessionTimeoutReminderInMinutes = sessionTimeout.WarningInMinutes.ValueInt64Pointer()
+		}
+	}
+
+	if !security.InactivityTimeout.IsNull() && !security.InactivityTimeout.IsUnknown() {
+		var inactivityTimeout TimeoutSourceModel
+		security.InactivityTimeout.As(ctx, &inactivityTimeout, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+
+		if !inactivityTimeout.Enabled.IsNull() && !inactivityTimeout.Enabled.IsUnknown() {
+			environmentSettingsDto.InactivityTimeoutEnabled = inactivityTimeout.Enabled.ValueBoolPointer()
+		}
+		if !inactivityTimeout.TimeoutInMinutes.IsNull() && !inactivityTimeout.TimeoutInMinutes.IsUnknown() {
+			environmentSettingsDto.InactivityTimeoutInMinutes = inactivityTimeout.TimeoutInMinutes.ValueInt64Pointer()
+		}
+		if !inactivityTimeout.WarningInMinutes.IsNull() && !inactivityTimeout.WarningInMinutes.IsUnknown() {
+			environmentSettingsDto.InactivityTimeoutReminderInMinutes = inactivityTimeout.WarningInMinutes.ValueInt64Pointer()
+		}
+	}
+
+	if !security.BlockedFileExtensions.IsNull() && !security.BlockedFileExtensions.IsUnknown() {
+		environmentSettingsDto.BlockedAttachments = convertFromSettingsSet(ctx, security.BlockedFileExtensions, ";")
+	}
+	if !security.BlockedMimeTypes.IsNull() && !security.BlockedMimeTypes.IsUnknown() {
+		environmentSettingsDto.BlockedMimeTypes = convertFromSettingsSet(ctx, security.BlockedMimeTypes, ";")
+	}
+}
+
+// convertFromSettingsSet joins the values of a set into the separated list format of the organization columns.
+func convertFromSettingsSet(ctx context.Context, set types.Set, separator string) *string {
+	values := []string{}
+	set.ElementsAs(ctx, &values, false)
+	sort.Strings(values)
+	joined := strings.Join(values, separator)
+	return &joined
+}
+
+// convertToSettingsSet splits a separated list of an organization column into a set, null columns are empty sets.
+func convertToSettingsSet(value *string, separator string) types.Set {
+	values := []attr.Value{}
+	if value != nil {
+		seen := map[string]bool{}
+		for _, v := range strings.Split(*value, separator) {
+			if v = strings.TrimSpace(v); v != "" && !seen[v] {
+				seen[v] = true
+				values = append(values, types.StringValue(v))
+			}
+		}
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
 func ConvertFromEnvironmentSettingsDto(environmentSettingsDto *EnvironmentSettingsDto) EnvironmentSettingsSourceModel {
 	environmentSettings := EnvironmentSettingsSourceModel{}
 
@@ -131,16 +259,24 @@ func ConvertFromEnvironmentSettingsDto(environmentSettingsDto *EnvironmentSettin
 		}
 	}
 
+	//environments created before the retention period in days was introduced only have the legacy column set
+	auditRetentionPeriod := environmentSettingsDto.AuditRetentionPeriodV2
+	if auditRetentionPeriod == nil {
+		auditRetentionPeriod = environmentSettingsDto.AuditRetentionPeriod
+	}
+
 	attrValuesAuditSettingsProperties := map[string]attr.Value{
 		"is_audit_enabled":             types.BoolValue(*environmentSettingsDto.IsAuditEnabled),
 		"is_user_access_audit_enabled": types.BoolValue(*environmentSettingsDto.IsUserAccessAuditEnabled),
 		"is_read_audit_enabled":        types.BoolValue(*environmentSettingsDto.IsReadAuditEnabled),
+		"log_retention_period_in_days": types.Int64PointerValue(auditRetentionPeriod),
 	}
 
 	attrAuditSettingsObject := map[string]attr.Type{
 		"is_audit_enabled":             types.BoolType,
 		"is_user_access_audit_enabled": types.BoolType,
 		"is_read_audit_enabled":        types.BoolType,
+		"log_retention_period_in_days": types.Int64Type,
 	}
 
 	attrTypesAuditAndLogsObject := map[string]attr.Type{
@@ -192,10 +328,63 @@ func ConvertFromEnvironmentSettingsDto(environmentSettingsDto *EnvironmentSettin
 	environmentSettings.AuditAndLogs = types.ObjectValueMust(attrTypesAuditAndLogsObject, attrValuesAuditAndLogsProperties)
 	environmentSettings.Email = types.ObjectValueMust(attrTypesEmailObject, attrValuesEmailProperties)
 	environmentSettings.Product = types.ObjectValueMust(attrTypesProductObject, attrValuesProductProperties)
+	environmentSettings.Security = convertToSecurityObject(environmentSettingsDto)
 
 	return environmentSettings
 }
 
+func convertToSecurityObject(environmentSettingsDto *EnvironmentSettingsDto) types.Object {
+	attrIpFirewallObject := map[string]attr.Type{
+		"enabled":                          types.BoolType,
+		"audit_only":                       types.BoolType,
+		"allowed_ip_ranges":                types.SetType{ElemType: types.StringType},
+		"allowed_service_tags":             types.SetType{ElemType: types.StringType},
+		"allow_application_users":          types.BoolType,
+		"allow_microsoft_trusted_services": types.BoolType,
+	}
+
+	attrTimeoutObject := map[string]attr.Type{
+		"enabled":            types.BoolType,
+		"timeout_in_minutes": types.Int64Type,
+		"warning_in_minutes": types.Int64Type,
+	}
+
+	attrTypesSecurityObject := map[string]attr.Type{
+		"ip_firewall":                     types.ObjectType{AttrTypes: attrIpFirewallObject},
+		"ip_based_cookie_binding_enabled": types.BoolType,
+		"session_timeout":                 types.ObjectType{AttrTypes: attrTimeoutObject},
+		"inactivity_timeout":              types.ObjectType{AttrTypes: attrTimeoutObject},
+		"blocked_file_extensions":         types.SetType{ElemType: types.StringType},
+		"blocked_mime_types":              types.SetType{ElemType: types.StringType},
+	}
+
+	attrValuesSecurityProperties := map[string]attr.Value{
+		"ip_firewall": types.ObjectValueMust(attrIpFirewallObject, map[string]attr.Value{
+			"enabled":                          types.BoolPointerValue(environmentSettingsDto.EnableIpBasedFirewallRule),
+			"audit_only":                       types.BoolPointerValue(environmentSettingsDto.EnableIpBasedFirewallRuleInAuditMode),
+			"allowed_ip_ranges":                convertToSettingsSet(environmentSettingsDto.AllowedIpRangeForFirewall, ","),
+			"allowed_service_tags":             convertToSettingsSet(environmentSettingsDto.AllowedServiceTagsForFirewall, ","),
+			"allow_application_users":          types.BoolPointerValue(environmentSettingsDto.AllowApplicationUserAccess),
+			"allow_microsoft_trusted_services": types.BoolPointerValue(environmentSettingsDto.AllowMicrosoftTrustedServiceTags),
+		}),
+		"ip_based_cookie_binding_enabled": types.BoolPointerValue(environmentSettingsDto.EnableIpBasedCookieBinding),
+		"session_timeout": types.ObjectValueMust(attrTimeoutObject, map[string]attr.Value{
+			"enabled":            types.BoolPointerValue(environmentSettingsDto.SessionTimeoutEnabled),
+			"timeout_in_minutes": types.Int64PointerValue(environmentSettingsDto.SessionTimeoutInMinutes),
+			"warning_in_minutes": types.Int64PointerValue(environmentSettingsDto.SessionTimeoutReminderInMinutes),
+		}),
+		"inactivity_timeout": types.ObjectValueMust(attrTimeoutObject, map[string]attr.Value{
+			"enabled":            types.BoolPointerValue(environmentSettingsDto.InactivityTimeoutEnabled),
+			"timeout_in_minutes": types.Int64PointerValue(environmentSettingsDto.InactivityTimeoutInMinutes),
+			"warning_in_minutes": types.Int64PointerValue(environmentSettingsDto.InactivityTimeoutReminderInMinutes),
+		}),
+		"blocked_file_extensions": convertToSettingsSet(environmentSettingsDto.BlockedAttachments, ";"),
+		"blocked_mime_types":      convertToSettingsSet(environmentSettingsDto.BlockedMimeTypes, ";"),
+	}
+
+	return types.ObjectValueMust(attrTypesSecurityObject, attrValuesSecurityProperties)
+}
+
 type EnvironmentSettingsValueDto struct {
 	Value []EnvironmentSettingsDto `json:"value"`
 }
@@ -209,6 +398,23 @@ type EnvironmentSettingsDto struct {
 	BoundDashboardDefaultCardExpanded        *bool   `json:"bounddashboarddefaultcardexpanded,omitempty"`
 	OrganizationId                           *string `json:"organizationid,omitempty"`
 	PowerAppsComponentFrameworkForCanvasApps *bool   `json:"iscustomcontrolsincanvasappsenabled,omitempty"`
+	AuditRetentionPeriod                     *int64  `json:"auditretentionperiod,omitempty"`
+	AuditRetentionPeriodV2                   *int64  `json:"auditretentionperiodv2,omitempty"`
+	EnableIpBasedFirewallRule                *bool   `json:"enableipbasedfirewallrule,omitempty"`
+	EnableIpBasedFirewallRuleInAuditMode     *bool   `json:"enableipbasedfirewallruleinauditmode,omitempty"`
+	AllowedIpRangeForFirewall                *string `json:"allowediprangeforfirewall,omitempty"`
+	AllowedServiceTagsForFirewall            *string `json:"allowedservicetagsforfirewall,omitempty"`
+	AllowApplicationUserAccess               *bool   `json:"allowapplicationuseraccess,omitempty"`
+	AllowMicrosoftTrustedServiceTags         *bool   `json:"allowmicrosofttrustedservicetags,omitempty"`
+	EnableIpBasedCookieBinding               *bool   `json:"enableipbasedcookiebinding,omitempty"`
+	SessionTimeoutEnabled                    *bool   `json:"sessiontimeoutenabled,omitempty"`
+	SessionTimeoutInMinutes                  *int64  `json:"sessiontimeoutinmins,omitempty"`
+	SessionTimeoutReminderInMinutes          *int64  `json:"sessiontimeoutreminderinmins,omitempty"`
+	InactivityTimeoutEnabled                 *bool   `json:"inactivitytimeoutenabled,omitempty"`
+	InactivityTimeoutInMinutes               *int64  `json:"inactivitytimeoutinmins,omitempty"`
+	InactivityTimeoutReminderInMinutes       *int64  `json:"inactivitytimeoutreminderinmins,omitempty"`
+	BlockedAttachments                       *string `json:"blockedattachments,omitempty"`
+	BlockedMimeTypes                         *string `json:"blockedmimetypes,omitempty"`
 }
 
 type EnvironmentIdDto struct {
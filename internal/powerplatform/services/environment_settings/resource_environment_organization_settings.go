@@ -0,0 +1,381 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+)
+
+var _ resource.Resource = &EnvironmentOrganizationSettingsResource{}
+var _ resource.ResourceWithImportState = &EnvironmentOrganizationSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &EnvironmentOrganizationSettingsResource{}
+var _ resource.ResourceWithModifyPlan = &EnvironmentOrganizationSettingsResource{}
+
+func NewEnvironmentOrganizationSettingsResource() resource.Resource {
+	return &EnvironmentOrganizationSettingsResource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_environment_organization_settings",
+	}
+}
+
+type EnvironmentOrganizationSettingsResource struct {
+	EnvironmentSettingClient EnvironmentSettingsClient
+	ProviderTypeName         string
+	TypeName                 string
+}
+
+func (r *EnvironmentOrganizationSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.TypeName
+}
+
+func (r *EnvironmentOrganizationSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages columns of the Dataverse organization table and keys of its orgdborgsettings column for a given environment.",
+		MarkdownDescription: "Manages columns of the Dataverse `organization` table and keys of its `orgdborgsettings` column for a given environment. Only the configured columns and keys are managed, the other settings of the environment are left as they are. Column names and values are checked against the metadata of the `organization` table. Removing a key from `orgdb_settings` or destroying the resource removes the key from `orgdborgsettings`, which restores its default value, while columns keep their last value. See [Organization table](https://learn.microsoft.com/power-apps/developer/data-platform/reference/entities/organization) and [OrgDBOrgSettings tool](https://learn.microsoft.com/power-platform/admin/environment-database-settings) for more details.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Id of the organization settings, equal to the environment id",
+				MarkdownDescription: "Id of the organization settings, equal to the environment id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				Description:         "Environment Id",
+				MarkdownDescription: "Environment Id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"settings": schema.MapAttribute{
+				Description:         "Values of organization table columns, indexed by column logical name",
+				MarkdownDescription: "Values of `organization` table columns, indexed by column logical name. Values are given as strings and converted to the type of the column: `true`/`false` for Boolean columns, integers for Integer, BigInt and Choice columns, decimals for Decimal, Double and Money columns and RFC3339 timestamps for DateTime columns. An empty string clears String, Memo and DateTime columns",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"orgdb_settings": schema.MapAttribute{
+				Description:         "Values of keys of the orgdborgsettings column, indexed by key name",
+				MarkdownDescription: "Values of keys of the `orgdborgsettings` column, indexed by key name, i.e. `IsMailboxInactiveBackoffEnabled = \"true\"`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *EnvironmentOrganizationSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client := req.ProviderData.(*api.ProviderClient).Api
+
+	if client == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.EnvironmentSettingClient = NewEnvironmentSettingsClient(client)
+}
+
+func (r *EnvironmentOrganizationSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config EnvironmentOrganizationSettingsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Settings.IsNull() && !config.Settings.IsUnknown() {
+		if _, ok := config.Settings.Elements()[ORGANIZATION_ORGDB_SETTINGS_COLUMN]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("settings"), "Invalid organization setting", "The `orgdborgsettings` column can't be set as a whole, use `orgdb_settings` to manage its keys")
+		}
+	}
+
+	if !config.OrgDbSettings.IsNull() && !config.OrgDbSettings.IsUnknown() {
+		for key := range config.OrgDbSettings.Elements() {
+			if !orgDbOrgSettingKeyRegex.MatchString(key) {
+				resp.Diagnostics.AddAttributeError(path.Root("orgdb_settings"), "Invalid orgdborgsettings key", fmt.Sprintf("'%s' is not a valid orgdborgsettings key name", key))
+			}
+		}
+	}
+}
+
+func (r *EnvironmentOrganizationSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	//the settings are checked against the organization metadata only once the provider is configured
+	if req.Plan.Raw.IsNull() || r.EnvironmentSettingClient.Api == nil {
+		return
+	}
+
+	var plan EnvironmentOrganizationSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.EnvironmentId.IsUnknown() || plan.Settings.IsNull() || plan.Settings.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state EnvironmentOrganizationSettingsResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || (plan.Settings.Equal(state.Settings) && plan.EnvironmentId.Equal(state.EnvironmentId)) {
+			return
+		}
+	}
+
+	settings := map[string]string{}
+	for name, value := range plan.Settings.Elements() {
+		if value.IsUnknown() {
+			continue
+		}
+		settings[name] = value.(types.String).ValueString()
+	}
+
+	dvExits, err := r.EnvironmentSettingClient.DataverseExists(ctx, plan.EnvironmentId.ValueString())
+	if err != nil || !dvExits {
+		//the environment is checked again when the settings are applied
+		return
+	}
+
+	_, err = r.EnvironmentSettingClient.ConvertToOrganizationColumns(ctx, plan.EnvironmentId.ValueString(), settings)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("settings"), "Invalid organization settings", err.Error())
+	}
+}
+
+func (r *EnvironmentOrganizationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EnvironmentOrganizationSettingsResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE ENVIRONMENT ORGANIZATION SETTINGS RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dvExits, err := r.EnvironmentSettingClient.DataverseExists(ctx, plan.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when checking if Dataverse exists in environment '%s'", plan.EnvironmentId.ValueString()), err.Error())
+		return
+	}
+
+	if !dvExits {
+		resp.Diagnostics.AddError(fmt.Sprintf("No Dataverse exists in environment '%s'", plan.EnvironmentId.ValueString()), "")
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyOrganizationSettings(ctx, plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.readOrganizationSettings(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID %s", state.Id.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, fmt.Sprintf("CREATE ENVIRONMENT ORGANIZATION SETTINGS RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *EnvironmentOrganizationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EnvironmentOrganizationSettingsResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ ENVIRONMENT ORGANIZATION SETTINGS RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.readOrganizationSettings(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	tflog.Debug(ctx, fmt.Sprintf("READ ENVIRONMENT ORGANIZATION SETTINGS RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *EnvironmentOrganizationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan EnvironmentOrganizationSettingsResourceModel
+	var state EnvironmentOrganizationSettingsResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE ENVIRONMENT ORGANIZATION SETTINGS RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//orgdborgsettings keys that are no longer configured are removed, so that they get their default value back
+	removedOrgDbSettings := []string{}
+	plannedOrgDbSettings := plan.OrgDbSettings.Elements()
+	for key := range state.OrgDbSettings.Elements() {
+		if _, ok := plannedOrgDbSettings[key]; !ok {
+			removedOrgDbSettings = append(removedOrgDbSettings, key)
+		}
+	}
+
+	resp.Diagnostics.Append(r.applyOrganizationSettings(ctx, plan, removedOrgDbSettings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.readOrganizationSettings(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE ENVIRONMENT ORGANIZATION SETTINGS RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *EnvironmentOrganizationSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EnvironmentOrganizationSettingsResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE ENVIRONMENT ORGANIZATION SETTINGS RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//columns have no default value to go back to, only the managed orgdborgsettings keys are removed
+	removedOrgDbSettings := []string{}
+	for key := range state.OrgDbSettings.Elements() {
+		removedOrgDbSettings = append(removedOrgDbSettings, key)
+	}
+	if len(removedOrgDbSettings) == 0 {
+		return
+	}
+
+	err := r.EnvironmentSettingClient.UpdateOrganizationSettings(ctx, state.EnvironmentId.ValueString(), nil, nil, removedOrgDbSettings)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE ENVIRONMENT ORGANIZATION SETTINGS RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *EnvironmentOrganizationSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("environment_id"), req, resp)
+}
+
+func (r *EnvironmentOrganizationSettingsResource) applyOrganizationSettings(ctx context.Context, plan EnvironmentOrganizationSettingsResourceModel, removedOrgDbSettings []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	settings := map[string]string{}
+	orgDbSettings := map[string]string{}
+	diags.Append(plan.Settings.ElementsAs(ctx, &settings, false)...)
+	diags.Append(plan.OrgDbSettings.ElementsAs(ctx, &orgDbSettings, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	columns, err := r.EnvironmentSettingClient.ConvertToOrganizationColumns(ctx, plan.EnvironmentId.ValueString(), settings)
+	if err != nil {
+		diags.AddAttributeError(path.Root("settings"), "Invalid organization settings", err.Error())
+		return diags
+	}
+
+	err = r.EnvironmentSettingClient.UpdateOrganizationSettings(ctx, plan.EnvironmentId.ValueString(), columns, orgDbSettings, removedOrgDbSettings)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Client error when updating %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+	}
+	return diags
+}
+
+// readOrganizationSettings reads the columns and orgdborgsettings keys managed by the given model. Values that are equal
+// to the ones of the model but written differently, i.e. "1.0" and "1", keep the value of the model.
+func (r *EnvironmentOrganizationSettingsResource) readOrganizationSettings(ctx context.Context, model EnvironmentOrganizationSettingsResourceModel) (EnvironmentOrganizationSettingsResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	settings := map[string]string{}
+	orgDbSettings := map[string]string{}
+	diags.Append(model.Settings.ElementsAs(ctx, &settings, false)...)
+	diags.Append(model.OrgDbSettings.ElementsAs(ctx, &orgDbSettings, false)...)
+	if diags.HasError() {
+		return model, diags
+	}
+
+	columns := make([]string, 0, len(settings))
+	for name := range settings {
+		columns = append(columns, name)
+	}
+
+	organization, err := r.EnvironmentSettingClient.GetOrganizationSettings(ctx, model.EnvironmentId.ValueString(), columns)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Client error when reading %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return model, diags
+	}
+
+	newState := EnvironmentOrganizationSettingsResourceModel{
+		Id:            model.EnvironmentId,
+		EnvironmentId: model.EnvironmentId,
+		Settings:      types.MapNull(types.StringType),
+		OrgDbSettings: types.MapNull(types.StringType),
+	}
+
+	if !model.Settings.IsNull() {
+		values := map[string]string{}
+		for name, value := range settings {
+			currentValue := ConvertFromOrganizationColumnValue(organization[name])
+			if OrganizationColumnValuesEqual(value, currentValue) {
+				currentValue = value
+			}
+			values[name] = currentValue
+		}
+		settingsValue, d := types.MapValueFrom(ctx, types.StringType, values)
+		diags.Append(d...)
+		newState.Settings = settingsValue
+	}
+
+	if !model.OrgDbSettings.IsNull() {
+		currentOrgDbSettings, _ := organization[ORGANIZATION_ORGDB_SETTINGS_COLUMN].(string)
+		parsedOrgDbSettings, err := ParseOrgDbOrgSettings(currentOrgDbSettings)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Client error when reading %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+			return model, diags
+		}
+
+		//keys missing from orgdborgsettings are left out of the state, so that they are planned again
+		values := map[string]string{}
+		for key := range orgDbSettings {
+			if currentValue, ok := parsedOrgDbSettings[key]; ok {
+				values[key] = currentValue
+			}
+		}
+		orgDbSettingsValue, d := types.MapValueFrom(ctx, types.StringType, values)
+		diags.Append(d...)
+		newState.OrgDbSettings = orgDbSettingsValue
+	}
+
+	return newState, diags
+}
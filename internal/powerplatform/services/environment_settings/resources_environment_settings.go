@@ -6,7 +6,12 @@ package powerplatform
 import (
 	"context"
 	"fmt"
+	"net"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,14 +20,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
 )
 
 var _ resource.Resource = &EnvironmentSettingsResource{}
 var _ resource.ResourceWithImportState = &EnvironmentSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &EnvironmentSettingsResource{}
 
 func NewEnvironmentSettingsResource() resource.Resource {
 	return &EnvironmentSettingsResource{
@@ -107,6 +116,17 @@ func (r *EnvironmentSettingsResource) Schema(ctx context.Context, req resource.S
 									boolplanmodifier.UseStateForUnknown(),
 								},
 							},
+							"log_retention_period_in_days": schema.Int64Attribute{
+								Description:         "Number of days audit logs are retained, -1 to retain them forever",
+								MarkdownDescription: "Number of days audit logs are retained, `-1` to retain them forever. See [Start/stop auditing and set retention policy](https://learn.microsoft.com/power-platform/admin/manage-dataverse-auditing#startstop-auditing-for-an-environment-and-set-retention-policy) for more details.",
+								Optional:            true, Computed: true,
+								PlanModifiers: []planmodifier.Int64{
+									int64planmodifier.UseStateForUnknown(),
+								},
+								Validators: []validator.Int64{
+									int64validator.Any(int64validator.OneOf(-1), int64validator.AtLeast(1)),
+								},
+							},
 						},
 					},
 				},
@@ -168,7 +188,10 @@ func (r *EnvironmentSettingsResource) Schema(ctx context.Context, req resource.S
 					"features": schema.SingleNestedAttribute{
 						Description:         "Features",
 						MarkdownDescription: "Features. See [Features Overview](https://learn.microsoft.com/power-platform/admin/settings-features) for more details.",
-						Optional:            true,
+						Optional:            true, Computed: true,
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
 						Attributes: map[string]schema.Attribute{
 							"power_apps_component_framework_for_canvas_apps": schema.BoolAttribute{
 								Description:         "Power Apps component framework for canvas apps",
@@ -182,10 +205,225 @@ func (r *EnvironmentSettingsResource) Schema(ctx context.Context, req resource.S
 					},
 				},
 			},
+			"security": schema.SingleNestedAttribute{
+				Description:         "Security",
+				MarkdownDescription: "Security. See [Security settings](https://learn.microsoft.com/power-platform/admin/settings-privacy-security) for more details.",
+				Optional:            true, Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"ip_firewall": schema.SingleNestedAttribute{
+						Description:         "IP firewall",
+						MarkdownDescription: "IP firewall. See [IP firewall in Power Platform environments](https://learn.microsoft.com/power-platform/admin/ip-firewall) for more details.",
+						Optional:            true, Computed: true,
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
+						Attributes: map[string]schema.Attribute{
+							"enabled": schema.BoolAttribute{
+								Description:         "Is the IP firewall enabled",
+								MarkdownDescription: "Is the IP firewall enabled",
+								Optional:            true, Computed: true,
+								PlanModifiers: []planmodifier.Bool{
+									boolplanmodifier.UseStateForUnknown(),
+								},
+							},
+							"audit_only": schema.BoolAttribute{
+								Description:         "Only log the requests that would be blocked by the IP firewall instead of blocking them",
+								MarkdownDescription: "Only log the requests that would be blocked by the IP firewall instead of blocking them",
+								Optional:            true, Computed: true,
+								PlanModifiers: []planmodifier.Bool{
+									boolplanmodifier.UseStateForUnknown(),
+								},
+							},
+							"allowed_ip_ranges": schema.SetAttribute{
+								Description:         "IP addresses and ranges in CIDR notation that are allowed to access the environment",
+								MarkdownDescription: "IP addresses and ranges in CIDR notation that are allowed to access the environment, i.e. `10.0.0.0/16`",
+								ElementType:         types.StringType,
+								Optional:            true, Computed: true,
+								PlanModifiers: []planmodifier.Set{
+									setplanmodifier.UseStateForUnknown(),
+								},
+							},
+							"allowed_service_tags": schema.SetAttribute{
+								Description:         "Azure service tags that are allowed to access the environment",
+								MarkdownDescription: "Azure service tags that are allowed to access the environment, i.e. `AzureCloud`. See [Azure service tags](https://learn.microsoft.com/azure/virtual-network/service-tags-overview) for more details.",
+								ElementType:         types.StringType,
+								Optional:            true, Computed: true,
+								PlanModifiers: []planmodifier.Set{
+									setplanmodifier.UseStateForUnknown(),
+								},
+								Validators: []validator.Set{
+									setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9.]+$`), "must be a valid service tag name")),
+								},
+							},
+							"allow_application_users": schema.BoolAttribute{
+								Description:         "Allow application users to bypass the IP firewall",
+								MarkdownDescription: "Allow application users to bypass the IP firewall",
+								Optional:            true, Computed: true,
+								PlanModifiers: []planmodifier.Bool{
+									boolplanmodifier.UseStateForUnknown(),
+								},
+							},
+							"allow_microsoft_trusted_services": schema.BoolAttribute{
+								Description:         "Allow Microsoft trusted services to bypass the IP firewall",
+								MarkdownDescription: "Allow Microsoft trusted services to bypass the IP firewall",
+								Optional:            true, Computed: true,
+								PlanModifiers: []planmodifier.Bool{
+									boolplanmodifier.UseStateForUnknown(),
+								},
+							},
+						},
+					},
+					"ip_based_cookie_binding_enabled": schema.BoolAttribute{
+						Description:         "Bind session cookies to the IP address they were issued to",
+						MarkdownDescription: "Bind session cookies to the IP address they were issued to. See [IP address-based cookie binding](https://learn.microsoft.com/power-platform/admin/block-cookie-replay-attack) for more details.",
+						Optional:            true, Computed: true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"session_timeout": schema.SingleNestedAttribute{
+						Description:         "Session timeout",
+						MarkdownDescription: "Session timeout. See [User session timeout management](https://learn.microsoft.com/power-platform/admin/user-session-management) for more details.",
+						Optional:            true, Computed: true,
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
+						Attributes: timeoutResourceAttributes("session", 60, 1440),
+					},
+					"inactivity_timeout": schema.SingleNestedAttribute{
+						Description:         "Inactivity timeout",
+						MarkdownDescription: "Inactivity timeout. See [Inactivity timeout](https://learn.microsoft.com/power-platform/admin/user-session-management#inactivity-timeout) for more details.",
+						Optional:            true, Computed: true,
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
+						Attributes: timeoutResourceAttributes("inactivity", 5, 1440),
+					},
+					"blocked_file_extensions": schema.SetAttribute{
+						Description:         "File extensions that can't be attached to records, without the leading dot",
+						MarkdownDescription: "File extensions that can't be attached to records, without the leading dot, i.e. `exe`",
+						ElementType:         types.StringType,
+						Optional:            true, Computed: true,
+						PlanModifiers: []planmodifier.Set{
+							setplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9_-]+$`), "must be a file extension without the leading dot")),
+						},
+					},
+					"blocked_mime_types": schema.SetAttribute{
+						Description:         "MIME types that can't be attached to records",
+						MarkdownDescription: "MIME types that can't be attached to records, i.e. `application/x-msdownload`",
+						ElementType:         types.StringType,
+						Optional:            true, Computed: true,
+						PlanModifiers: []planmodifier.Set{
+							setplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9!#$&^_.+-]+/[A-Za-z0-9!#$&^_.+*-]+$`), "must be a valid MIME type")),
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func timeoutResourceAttributes(name string, minTimeout int64, maxTimeout int64) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"enabled": schema.BoolAttribute{
+			Description:         fmt.Sprintf("Is the %s timeout enabled", name),
+			MarkdownDescription: fmt.Sprintf("Is the %s timeout enabled", name),
+			Optional:            true, Computed: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"timeout_in_minutes": schema.Int64Attribute{
+			Description:         fmt.Sprintf("Duration of the %s timeout in minutes, between %d and %d", name, minTimeout, maxTimeout),
+			MarkdownDescription: fmt.Sprintf("Duration of the %s timeout in minutes, between `%d` and `%d`", name, minTimeout, maxTimeout),
+			Optional:            true, Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.Int64{
+				int64validator.Between(minTimeout, maxTimeout),
+			},
+		},
+		"warning_in_minutes": schema.Int64Attribute{
+			Description:         fmt.Sprintf("Number of minutes before the %s timeout when users are warned, lower than the timeout", name),
+			MarkdownDescription: fmt.Sprintf("Number of minutes before the %s timeout when users are warned, lower than `timeout_in_minutes`", name),
+			Optional:            true, Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	}
+}
+
+func (r *EnvironmentSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var security types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("security"), &security)...)
+	if resp.Diagnostics.HasError() || security.IsNull() || security.IsUnknown() {
+		return
+	}
+
+	var securitySourceModel SecuritySourceModel
+	resp.Diagnostics.Append(security.As(ctx, &securitySourceModel, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !securitySourceModel.IpFirewall.IsNull() && !securitySourceModel.IpFirewall.IsUnknown() {
+		var ipFirewall IpFirewallSourceModel
+		resp.Diagnostics.Append(securitySourceModel.IpFirewall.As(ctx, &ipFirewall, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})...)
+		for _, element := range ipFirewall.AllowedIpRanges.Elements() {
+			ipRange, ok := element.(types.String)
+			if !ok || ipRange.IsNull() || ipRange.IsUnknown() {
+				continue
+			}
+			if err := validateIpRange(ipRange.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("security").AtName("ip_firewall").AtName("allowed_ip_ranges"), "Invalid IP range", err.Error())
+			}
+		}
+	}
+
+	for _, attributeName := range []string{"session_timeout", "inactivity_timeout"} {
+		timeout, ok := security.Attributes()[attributeName].(types.Object)
+		if !ok || timeout.IsNull() || timeout.IsUnknown() {
+			continue
+		}
+		var timeoutSourceModel TimeoutSourceModel
+		resp.Diagnostics.Append(timeout.As(ctx, &timeoutSourceModel, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})...)
+		if timeoutSourceModel.TimeoutInMinutes.IsNull() || timeoutSourceModel.TimeoutInMinutes.IsUnknown() || timeoutSourceModel.WarningInMinutes.IsNull() || timeoutSourceModel.WarningInMinutes.IsUnknown() {
+			continue
+		}
+		if timeoutSourceModel.WarningInMinutes.ValueInt64() >= timeoutSourceModel.TimeoutInMinutes.ValueInt64() {
+			resp.Diagnostics.AddAttributeError(path.Root("security").AtName(attributeName).AtName("warning_in_minutes"), "Invalid timeout warning", fmt.Sprintf("`warning_in_minutes` (%d) has to be lower than `timeout_in_minutes` (%d)", timeoutSourceModel.WarningInMinutes.ValueInt64(), timeoutSourceModel.TimeoutInMinutes.ValueInt64()))
+		}
+	}
+}
+
+// validateIpRange accepts single IPv4 or IPv6 addresses and ranges in CIDR notation.
+func validateIpRange(ipRange string) error {
+	if strings.Contains(ipRange, "/") {
+		if _, _, err := net.ParseCIDR(ipRange); err != nil {
+			return fmt.Errorf("'%s' is not a valid IP range in CIDR notation", ipRange)
+		}
+		return nil
+	}
+	if net.ParseIP(ipRange) == nil {
+		return fmt.Errorf("'%s' is not a valid IP address", ipRange)
+	}
+	return nil
+}
+
 func (r *EnvironmentSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -3,6 +3,17 @@
 
 package powerplatform
 
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	environment "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/environment"
+)
+
 type OperationLifecycleDto struct {
 	Id                 string                           `json:"id"`
 	Links              OperationLifecycleLinksDto       `json:"links"`
@@ -48,4 +59,85 @@ type OperationLifecycleCreatedDto struct {
 
 type OperationLifecycleCreatedPropertiesDto struct {
 	ProvisioningState string `json:"provisioningState"`
-}
\ No newline at end of file
+}
+
+// ConvertToExtendedSettingsDto converts the typed attributes of the model to the string-typed extended settings of the governance configuration.
+func ConvertToExtendedSettingsDto(ctx context.Context, model ManagedEnvironmentResourceModel) environment.ExtendedSettingsDto {
+	ruleOverrides := []string{}
+	model.SolutionCheckerRuleOverrides.ElementsAs(ctx, &ruleOverrides, false)
+	sort.Strings(ruleOverrides)
+
+	return environment.ExtendedSettingsDto{
+		ExcludeEnvironmentFromAnalysis: strconv.FormatBool(model.IsUsageInsightsDisabled.ValueBool()),
+		IsGroupSharingDisabled:         strconv.FormatBool(model.IsGroupSharingDisabled.ValueBool()),
+		MaxLimitUserSharing:            strconv.FormatInt(model.MaxLimitUserSharing.ValueInt64(), 10),
+		DisableAiGeneratedDescriptions: strconv.FormatBool(model.AiGeneratedDescriptionsDisabled.ValueBool()),
+		IncludeOnHomepageInsights:      strconv.FormatBool(model.IncludeOnHomepageInsights.ValueBool()),
+		LimitSharingMode:               strings.ToLower(model.LimitSharingMode.ValueString()[:1]) + model.LimitSharingMode.ValueString()[1:],
+		SolutionCheckerMode:            strings.ToLower(model.SolutionCheckerMode.ValueString()),
+		SuppressValidationEmails:       strconv.FormatBool(model.SuppressValidationEmails.ValueBool()),
+		SolutionCheckerRuleOverrides:   strings.Join(ruleOverrides, ","),
+		MakerOnboardingUrl:             model.MakerOnboardingUrl.ValueString(),
+		MakerOnboardingMarkdown:        model.MakerOnboardingMarkdown.ValueString(),
+	}
+}
+
+// ConvertFromGovernanceConfigurationDto sets the typed attributes of the model from the governance configuration of an environment.
+func ConvertFromGovernanceConfigurationDto(governanceConfiguration environment.GovernanceConfigurationDto, model *ManagedEnvironmentResourceModel) {
+	model.ProtectionLevel = types.StringValue(governanceConfiguration.ProtectionLevel)
+
+	if governanceConfiguration.Settings == nil {
+		model.IsGroupSharingDisabled = types.BoolUnknown()
+		model.IsUsageInsightsDisabled = types.BoolUnknown()
+		model.MaxLimitUserSharing = types.Int64Unknown()
+		model.LimitSharingMode = types.StringUnknown()
+		model.SolutionCheckerMode = types.StringUnknown()
+		model.SuppressValidationEmails = types.BoolUnknown()
+		model.SolutionCheckerRuleOverrides = types.SetUnknown(types.StringType)
+		model.AiGeneratedDescriptionsDisabled = types.BoolUnknown()
+		model.IncludeOnHomepageInsights = types.BoolUnknown()
+		model.MakerOnboardingUrl = types.StringUnknown()
+		model.MakerOnboardingMarkdown = types.StringUnknown()
+		return
+	}
+
+	extendedSettings := governanceConfiguration.Settings.ExtendedSettings
+	maxLimitUserSharing, _ := strconv.ParseInt(extendedSettings.MaxLimitUserSharing, 10, 64)
+
+	model.IsUsageInsightsDisabled = types.BoolValue(convertFromExtendedSettingBool(extendedSettings.ExcludeEnvironmentFromAnalysis))
+	model.IsGroupSharingDisabled = types.BoolValue(convertFromExtendedSettingBool(extendedSettings.IsGroupSharingDisabled))
+	model.MaxLimitUserSharing = types.Int64Value(maxLimitUserSharing)
+	model.LimitSharingMode = types.StringValue(convertFromExtendedSettingMode(extendedSettings.LimitSharingMode))
+	model.SolutionCheckerMode = types.StringValue(convertFromExtendedSettingMode(extendedSettings.SolutionCheckerMode))
+	model.SuppressValidationEmails = types.BoolValue(convertFromExtendedSettingBool(extendedSettings.SuppressValidationEmails))
+	model.SolutionCheckerRuleOverrides = convertFromSolutionCheckerRuleOverrides(extendedSettings.SolutionCheckerRuleOverrides)
+	model.AiGeneratedDescriptionsDisabled = types.BoolValue(convertFromExtendedSettingBool(extendedSettings.DisableAiGeneratedDescriptions))
+	model.IncludeOnHomepageInsights = types.BoolValue(convertFromExtendedSettingBool(extendedSettings.IncludeOnHomepageInsights))
+	model.MakerOnboardingUrl = types.StringValue(extendedSettings.MakerOnboardingUrl)
+	model.MakerOnboardingMarkdown = types.StringValue(extendedSettings.MakerOnboardingMarkdown)
+}
+
+// convertFromExtendedSettingBool reads a boolean extended setting, the service returns them as "true" or "True".
+func convertFromExtendedSettingBool(value string) bool {
+	return strings.EqualFold(value, "true")
+}
+
+// convertFromExtendedSettingMode capitalizes a camel case extended setting, i.e. "noLimit" becomes "NoLimit".
+func convertFromExtendedSettingMode(value string) string {
+	if value == "" {
+		return value
+	}
+	return strings.ToUpper(value[:1]) + value[1:]
+}
+
+func convertFromSolutionCheckerRuleOverrides(value string) types.Set {
+	ruleOverrides := []attr.Value{}
+	seen := map[string]bool{}
+	for _, rule := range strings.Split(value, ",") {
+		if rule = strings.TrimSpace(rule); rule != "" && !seen[rule] {
+			seen[rule] = true
+			ruleOverrides = append(ruleOverrides, types.StringValue(rule))
+		}
+	}
+	return types.SetValueMust(types.StringType, ruleOverrides)
+}
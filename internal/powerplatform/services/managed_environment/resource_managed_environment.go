@@ -6,14 +6,17 @@ package powerplatform
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
+	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -40,18 +43,20 @@ type ManagedEnvironmentResource struct {
 }
 
 type ManagedEnvironmentResourceModel struct {
-	Id                       types.String `tfsdk:"id"`
-	EnvironmentId            types.String `tfsdk:"environment_id"`
-	ProtectionLevel          types.String `tfsdk:"protection_level"`
-	IsUsageInsightsDisabled  types.Bool   `tfsdk:"is_usage_insights_disabled"`
-	IsGroupSharingDisabled   types.Bool   `tfsdk:"is_group_sharing_disabled"`
-	MaxLimitUserSharing      types.Int64  `tfsdk:"max_limit_user_sharing"`
-	LimitSharingMode         types.String `tfsdk:"limit_sharing_mode"`
-	SolutionCheckerMode      types.String `tfsdk:"solution_checker_mode"`
-	SuppressValidationEmails types.Bool   `tfsdk:"suppress_validation_emails"`
-	//SolutionCheckerRuleOverrides  types.String `tfsdk:"solution_checker_rule_overrides"`
-	MakerOnboardingUrl      types.String `tfsdk:"maker_onboarding_url"`
-	MakerOnboardingMarkdown types.String `tfsdk:"maker_onboarding_markdown"`
+	Id                              types.String `tfsdk:"id"`
+	EnvironmentId                   types.String `tfsdk:"environment_id"`
+	ProtectionLevel                 types.String `tfsdk:"protection_level"`
+	IsUsageInsightsDisabled         types.Bool   `tfsdk:"is_usage_insights_disabled"`
+	IsGroupSharingDisabled          types.Bool   `tfsdk:"is_group_sharing_disabled"`
+	MaxLimitUserSharing             types.Int64  `tfsdk:"max_limit_user_sharing"`
+	LimitSharingMode                types.String `tfsdk:"limit_sharing_mode"`
+	SolutionCheckerMode             types.String `tfsdk:"solution_checker_mode"`
+	SuppressValidationEmails        types.Bool   `tfsdk:"suppress_validation_emails"`
+	SolutionCheckerRuleOverrides    types.Set    `tfsdk:"solution_checker_rule_overrides"`
+	AiGeneratedDescriptionsDisabled types.Bool   `tfsdk:"ai_generated_descriptions_disabled"`
+	IncludeOnHomepageInsights       types.Bool   `tfsdk:"include_on_homepage_insights"`
+	MakerOnboardingUrl              types.String `tfsdk:"maker_onboarding_url"`
+	MakerOnboardingMarkdown         types.String `tfsdk:"maker_onboarding_markdown"`
 }
 
 func (r *ManagedEnvironmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -87,8 +92,8 @@ func (r *ManagedEnvironmentResource) Schema(ctx context.Context, req resource.Sc
 				Computed:            true,
 			},
 			"is_usage_insights_disabled": schema.BoolAttribute{
-				MarkdownDescription: "[Weekly insights digest for the environment](https://learn.microsoft.com/power-platform/admin/managed-environment-usage-insights)",
-				Description:         "Weekly insights digest for the environment",
+				MarkdownDescription: "Excludes the environment from the [weekly insights digest](https://learn.microsoft.com/power-platform/admin/managed-environment-usage-insights) email",
+				Description:         "Excludes the environment from the weekly insights digest email",
 				Required:            true,
 			},
 			"is_group_sharing_disabled": schema.BoolAttribute{
@@ -122,6 +127,31 @@ func (r *ManagedEnvironmentResource) Schema(ctx context.Context, req resource.Sc
 				Description:         "Send emails only when a solution is blocked. If 'False', you'll also get emails when there are warnings",
 				Required:            true,
 			},
+			"solution_checker_rule_overrides": schema.SetAttribute{
+				MarkdownDescription: "Ids of the solution checker rules that are excluded from the solution checker enforcement, i.e. `meta-avoid-reg-no-attribute`. See [Solution Checker enforcement](https://learn.microsoft.com/power-platform/admin/managed-environment-solution-checker) for more details.",
+				Description:         "Ids of the solution checker rules that are excluded from the solution checker enforcement",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z]+(-[a-z0-9]+)+$`), "must be a solution checker rule id, i.e. `meta-avoid-reg-no-attribute`")),
+				},
+			},
+			"ai_generated_descriptions_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Disables AI generated descriptions of apps and flows",
+				Description:         "Disables AI generated descriptions of apps and flows",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"include_on_homepage_insights": schema.BoolAttribute{
+				MarkdownDescription: "Includes the insights of the environment in the cards of the Power Platform admin center home page. See [Usage insights](https://learn.microsoft.com/power-platform/admin/managed-environment-usage-insights) for more details.",
+				Description:         "Includes the insights of the environment in the cards of the Power Platform admin center home page",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"maker_onboarding_markdown": schema.StringAttribute{
 				MarkdownDescription: "First-time Power Apps makers will see this content in the Studio.  See [Maker welcome content](https://learn.microsoft.com/power-platform/admin/welcome-content) for more details.",
 				Description:         "First-time Power Apps makers will see this content in the Studio",
@@ -168,20 +198,7 @@ func (r *ManagedEnvironmentResource) Create(ctx context.Context, req resource.Cr
 	managedEnvironmentDto := environment.GovernanceConfigurationDto{
 		ProtectionLevel: "Standard", //plan.ProtectionLevel.ValueString(),
 		Settings: &environment.SettingsDto{
-			ExtendedSettings: environment.ExtendedSettingsDto{
-				ExcludeEnvironmentFromAnalysis: strconv.FormatBool(plan.IsUsageInsightsDisabled.ValueBool()),
-				IsGroupSharingDisabled:         strconv.FormatBool(plan.IsGroupSharingDisabled.ValueBool()),
-				MaxLimitUserSharing:            strconv.FormatInt(plan.MaxLimitUserSharing.ValueInt64(), 10),
-				DisableAiGeneratedDescriptions: "false",
-				IncludeOnHomepageInsights:      "false",
-				LimitSharingMode:               strings.ToLower(plan.LimitSharingMode.ValueString()[:1]) + plan.LimitSharingMode.ValueString()[1:],
-				SolutionCheckerMode:            strings.ToLower(plan.SolutionCheckerMode.ValueString()),
-				SuppressValidationEmails:       strconv.FormatBool(plan.SuppressValidationEmails.ValueBool()),
-				//SolutionCheckerRuleOverrides:   "",
-				MakerOnboardingUrl: plan.MakerOnboardingUrl.ValueString(),
-				//MakerOnboardingTimestamp:       nil
-				MakerOnboardingMarkdown: plan.MakerOnboardingMarkdown.ValueString(),
-			},
+			ExtendedSettings: ConvertToExtendedSettingsDto(ctx, *plan),
 		},
 	}
 
@@ -197,18 +214,8 @@ func (r *ManagedEnvironmentResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	maxLimitUserSharing, _ := strconv.ParseInt(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.MaxLimitUserSharing, 10, 64)
 	plan.Id = plan.EnvironmentId
-	plan.ProtectionLevel = types.StringValue(env.Properties.GovernanceConfiguration.ProtectionLevel)
-	plan.IsUsageInsightsDisabled = types.BoolValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.ExcludeEnvironmentFromAnalysis == "true")
-	plan.IsGroupSharingDisabled = types.BoolValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.IsGroupSharingDisabled == "true")
-	plan.MaxLimitUserSharing = types.Int64Value(maxLimitUserSharing)
-	plan.LimitSharingMode = types.StringValue(strings.ToUpper(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.LimitSharingMode[:1]) + env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.LimitSharingMode[1:])
-	plan.SolutionCheckerMode = types.StringValue(strings.ToUpper(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.SolutionCheckerMode[:1]) + env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.SolutionCheckerMode[1:])
-	plan.SuppressValidationEmails = types.BoolValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.SuppressValidationEmails == "true")
-	//plan.SolutionCheckerRuleOverrides = types.StringValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.SolutionCheckerRuleOverrides)
-	plan.MakerOnboardingUrl = types.StringValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.MakerOnboardingUrl)
-	plan.MakerOnboardingMarkdown = types.StringValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.MakerOnboardingMarkdown)
+	ConvertFromGovernanceConfigurationDto(env.Properties.GovernanceConfiguration, plan)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 
@@ -237,31 +244,7 @@ func (r *ManagedEnvironmentResource) Read(ctx context.Context, req resource.Read
 		}
 	}
 
-	state.ProtectionLevel = types.StringValue(env.Properties.GovernanceConfiguration.ProtectionLevel)
-
-	if env.Properties.GovernanceConfiguration.Settings != nil {
-		maxLimitUserSharing, _ := strconv.ParseInt(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.MaxLimitUserSharing, 10, 64)
-
-		state.IsUsageInsightsDisabled = types.BoolValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.ExcludeEnvironmentFromAnalysis == "true")
-		state.IsGroupSharingDisabled = types.BoolValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.IsGroupSharingDisabled == "true")
-		state.MaxLimitUserSharing = types.Int64Value(maxLimitUserSharing)
-		state.LimitSharingMode = types.StringValue(strings.ToUpper(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.LimitSharingMode[:1]) + env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.LimitSharingMode[1:])
-		state.SolutionCheckerMode = types.StringValue(strings.ToUpper(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.SolutionCheckerMode[:1]) + env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.SolutionCheckerMode[1:])
-		state.SuppressValidationEmails = types.BoolValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.SuppressValidationEmails == "true")
-		//state.SolutionCheckerRuleOverrides = types.StringValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.SolutionCheckerRuleOverrides)
-		state.MakerOnboardingUrl = types.StringValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.MakerOnboardingUrl)
-		state.MakerOnboardingMarkdown = types.StringValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.MakerOnboardingMarkdown)
-	} else {
-		state.IsGroupSharingDisabled = types.BoolUnknown()
-		state.IsUsageInsightsDisabled = types.BoolUnknown()
-		state.MaxLimitUserSharing = types.Int64Unknown()
-		state.LimitSharingMode = types.StringUnknown()
-		state.SolutionCheckerMode = types.StringUnknown()
-		state.SuppressValidationEmails = types.BoolUnknown()
-		//state.SolutionCheckerRuleOverrides = types.StringUnknown()
-		state.MakerOnboardingUrl = types.StringUnknown()
-		state.MakerOnboardingMarkdown = types.StringUnknown()
-	}
+	ConvertFromGovernanceConfigurationDto(env.Properties.GovernanceConfiguration, state)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
@@ -282,20 +265,7 @@ func (r *ManagedEnvironmentResource) Update(ctx context.Context, req resource.Up
 	managedEnvironmentDto := environment.GovernanceConfigurationDto{
 		ProtectionLevel: "Standard", //plan.ProtectionLevel.ValueString(),
 		Settings: &environment.SettingsDto{
-			ExtendedSettings: environment.ExtendedSettingsDto{
-				ExcludeEnvironmentFromAnalysis: strconv.FormatBool(plan.IsUsageInsightsDisabled.ValueBool()),
-				IsGroupSharingDisabled:         strconv.FormatBool(plan.IsGroupSharingDisabled.ValueBool()),
-				MaxLimitUserSharing:            strconv.FormatInt(plan.MaxLimitUserSharing.ValueInt64(), 10),
-				DisableAiGeneratedDescriptions: "false",
-				IncludeOnHomepageInsights:      "false",
-				LimitSharingMode:               strings.ToLower(plan.LimitSharingMode.ValueString()[:1]) + plan.LimitSharingMode.ValueString()[1:],
-				SolutionCheckerMode:            strings.ToLower(plan.SolutionCheckerMode.ValueString()),
-				SuppressValidationEmails:       strconv.FormatBool(plan.SuppressValidationEmails.ValueBool()),
-				//SolutionCheckerRuleOverrides:   "",
-				MakerOnboardingUrl: plan.MakerOnboardingUrl.ValueString(),
-				//MakerOnboardingTimestamp:       nil
-				MakerOnboardingMarkdown: plan.MakerOnboardingMarkdown.ValueString(),
-			},
+			ExtendedSettings: ConvertToExtendedSettingsDto(ctx, *plan),
 		},
 	}
 
@@ -311,18 +281,8 @@ func (r *ManagedEnvironmentResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	maxLimitUserSharing, _ := strconv.ParseInt(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.MaxLimitUserSharing, 10, 64)
 	plan.Id = plan.EnvironmentId
-	plan.ProtectionLevel = types.StringValue(env.Properties.GovernanceConfiguration.ProtectionLevel)
-	plan.IsUsageInsightsDisabled = types.BoolValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.ExcludeEnvironmentFromAnalysis == "true")
-	plan.IsGroupSharingDisabled = types.BoolValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.IsGroupSharingDisabled == "true")
-	plan.MaxLimitUserSharing = types.Int64Value(maxLimitUserSharing)
-	plan.LimitSharingMode = types.StringValue(strings.ToUpper(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.LimitSharingMode[:1]) + env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.LimitSharingMode[1:])
-	plan.SolutionCheckerMode = types.StringValue(strings.ToUpper(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.SolutionCheckerMode[:1]) + env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.SolutionCheckerMode[1:])
-	plan.SuppressValidationEmails = types.BoolValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.SuppressValidationEmails == "true")
-	//plan.SolutionCheckerRuleOverrides = types.StringValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.SolutionCheckerRuleOverrides)
-	plan.MakerOnboardingUrl = types.StringValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.MakerOnboardingUrl)
-	plan.MakerOnboardingMarkdown = types.StringValue(env.Properties.GovernanceConfiguration.Settings.ExtendedSettings.MakerOnboardingMarkdown)
+	ConvertFromGovernanceConfigurationDto(env.Properties.GovernanceConfiguration, plan)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 
@@ -0,0 +1,473 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	constants "github.com/microsoft/terraform-provider-power-platform/constants"
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+	solution "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/solution"
+)
+
+const (
+	DEPLOYMENT_ENVIRONMENT_VALIDATION_TIMEOUT = 10 * time.Minute
+	DEPLOYMENT_STAGE_RUN_TIMEOUT              = 2 * time.Hour
+)
+
+func NewPipelineClient(api *api.ApiClient) PipelineClient {
+	return PipelineClient{
+		solutionClient: solution.NewSolutionClient(api),
+		Api:            api,
+	}
+}
+
+type PipelineClient struct {
+	solutionClient solution.SolutionClient
+	Api            *api.ApiClient
+}
+
+func (client *PipelineClient) GetPipeline(ctx context.Context, environmentId, pipelineId string) (*DeploymentPipelineDto, error) {
+	values := url.Values{}
+	values.Add("$select", "deploymentpipelineid,name,description")
+	apiUrl, err := client.buildDataverseUrl(ctx, environmentId, fmt.Sprintf("deploymentpipelines(%s)", pipelineId), values)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := DeploymentPipelineDto{}
+	_, err = client.Api.Execute(ctx, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &pipeline)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil, powerplatform_helpers.WrapIntoProviderError(err, powerplatform_helpers.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("pipeline %s not found in %s", pipelineId, environmentId))
+		}
+		return nil, err
+	}
+	return &pipeline, nil
+}
+
+func (client *PipelineClient) CreatePipeline(ctx context.Context, environmentId string, pipelineToCreate DeploymentPipelineDto) (*DeploymentPipelineDto, error) {
+	apiUrl, err := client.buildDataverseUrl(ctx, environmentId, "deploymentpipelines", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pipelineId, err := client.createRecord(ctx, apiUrl, pipelineToCreate)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPipeline(ctx, environmentId, pipelineId)
+}
+
+func (client *PipelineClient) UpdatePipeline(ctx context.Context, environmentId, pipelineId string, pipelineToUpdate DeploymentPipelineDto) (*DeploymentPipelineDto, error) {
+	apiUrl, err := client.buildDataverseUrl(ctx, environmentId, fmt.Sprintf("deploymentpipelines(%s)", pipelineId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = client.Api.Execute(ctx, "PATCH", apiUrl, nil, pipelineToUpdate, []int{http.StatusOK, http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPipeline(ctx, environmentId, pipelineId)
+}
+
+func (client *PipelineClient) DeletePipeline(ctx context.Context, environmentId, pipelineId string) error {
+	return client.deleteRecord(ctx, environmentId, fmt.Sprintf("deploymentpipelines(%s)", pipelineId))
+}
+
+// GetPipelineStages returns the stages of a pipeline in deployment order.
+func (client *PipelineClient) GetPipelineStages(ctx context.Context, environmentId, pipelineId string) ([]DeploymentStageDto, error) {
+	values := url.Values{}
+	values.Add("$select", "deploymentstageid,name,description,_deploymentpipelineid_value,_targetdeploymentenvironmentid_value,_previousdeploymentstageid_value")
+	values.Add("$filter", fmt.Sprintf("_deploymentpipelineid_value eq %s", pipelineId))
+	apiUrl, err := client.buildDataverseUrl(ctx, environmentId, "deploymentstages", values)
+	if err != nil {
+		return nil, err
+	}
+
+	stageArray := DeploymentStageDtoArray{}
+	_, err = client.Api.Execute(ctx, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &stageArray)
+	if err != nil {
+		return nil, err
+	}
+
+	environments, err := client.getDeploymentEnvironments(ctx, environmentId, "")
+	if err != nil {
+		return nil, err
+	}
+	environmentIds := make(map[string]string)
+	for _, env := range environments {
+		environmentIds[env.Id] = env.EnvironmentId
+	}
+
+	//stages are linked to each other through the previous stage, the first stage has no previous stage
+	stages := make([]DeploymentStageDto, 0)
+	previousStageId := ""
+	for len(stages) < len(stageArray.Value) {
+		found := false
+		for _, stage := range stageArray.Value {
+			if stage.PreviousDeploymentStageId == previousStageId {
+				stage.TargetEnvironmentId = environmentIds[stage.TargetDeploymentEnvironmentId]
+				stages = append(stages, stage)
+				previousStageId = stage.Id
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("stages of pipeline %s do not form a single chain", pipelineId)
+		}
+	}
+	return stages, nil
+}
+
+func (client *PipelineClient) CreatePipelineStage(ctx context.Context, environmentId, pipelineId string, stageToCreate DeploymentStageDto) (*DeploymentStageDto, error) {
+	targetEnvironment, err := client.GetOrCreateDeploymentEnvironment(ctx, environmentId, stageToCreate.TargetEnvironmentId, DEPLOYMENT_ENVIRONMENT_TYPE_TARGET)
+	if err != nil {
+		return nil, err
+	}
+
+	apiUrl, err := client.buildDataverseUrl(ctx, environmentId, "deploymentstages", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	createStageRequestBody := CreateDeploymentStageDto{
+		Name:                          stageToCreate.Name,
+		Description:                   stageToCreate.Description,
+		PipelineId:                    fmt.Sprintf("/deploymentpipelines(%s)", pipelineId),
+		TargetDeploymentEnvironmentId: fmt.Sprintf("/deploymentenvironments(%s)", targetEnvironment.Id),
+	}
+	if stageToCreate.PreviousDeploymentStageId != "" {
+		createStageRequestBody.PreviousDeploymentStageId = fmt.Sprintf("/deploymentstages(%s)", stageToCreate.PreviousDeploymentStageId)
+	}
+
+	stageId, err := client.createRecord(ctx, apiUrl, createStageRequestBody)
+	if err != nil {
+		return nil, err
+	}
+
+	stageToCreate.Id = stageId
+	stageToCreate.PipelineId = pipelineId
+	stageToCreate.TargetDeploymentEnvironmentId = targetEnvironment.Id
+	return &stageToCreate, nil
+}
+
+func (client *PipelineClient) UpdatePipelineStage(ctx context.Context, environmentId string, stageToUpdate DeploymentStageDto) (*DeploymentStageDto, error) {
+	targetEnvironment, err := client.GetOrCreateDeploymentEnvironment(ctx, environmentId, stageToUpdate.TargetEnvironmentId, DEPLOYMENT_ENVIRONMENT_TYPE_TARGET)
+	if err != nil {
+		return nil, err
+	}
+
+	apiUrl, err := client.buildDataverseUrl(ctx, environmentId, fmt.Sprintf("deploymentstages(%s)", stageToUpdate.Id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	updateStageRequestBody := CreateDeploymentStageDto{
+		Name:                          stageToUpdate.Name,
+		Description:                   stageToUpdate.Description,
+		TargetDeploymentEnvironmentId: fmt.Sprintf("/deploymentenvironments(%s)", targetEnvironment.Id),
+	}
+	_, err = client.Api.Execute(ctx, "PATCH", apiUrl, nil, updateStageRequestBody, []int{http.StatusOK, http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	stageToUpdate.TargetDeploymentEnvironmentId = targetEnvironment.Id
+	return &stageToUpdate, nil
+}
+
+func (client *PipelineClient) DeletePipelineStage(ctx context.Context, environmentId, stageId string) error {
+	return client.deleteRecord(ctx, environmentId, fmt.Sprintf("deploymentstages(%s)", stageId))
+}
+
+// GetPipelineDevelopmentEnvironments returns the development environments linked to a pipeline.
+func (client *PipelineClient) GetPipelineDevelopmentEnvironments(ctx context.Context, environmentId, pipelineId string) ([]DeploymentEnvironmentDto, error) {
+	values := url.Values{}
+	values.Add("$select", "deploymentenvironmentid,name,environmentid,environmenttype")
+	apiUrl, err := client.buildDataverseUrl(ctx, environmentId, fmt.Sprintf("deploymentpipelines(%s)/deploymentpipeline_deploymentenvironment", pipelineId), values)
+	if err != nil {
+		return nil, err
+	}
+
+	environmentArray := DeploymentEnvironmentDtoArray{}
+	_, err = client.Api.Execute(ctx, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &environmentArray)
+	if err != nil {
+		return nil, err
+	}
+	return environmentArray.Value, nil
+}
+
+func (client *PipelineClient) LinkDevelopmentEnvironment(ctx context.Context, environmentId, pipelineId, developmentEnvironmentId string) error {
+	developmentEnvironment, err := client.GetOrCreateDeploymentEnvironment(ctx, environmentId, developmentEnvironmentId, DEPLOYMENT_ENVIRONMENT_TYPE_DEVELOPMENT)
+	if err != nil {
+		return err
+	}
+
+	environmentUrl, err := client.solutionClient.GetEnvironmentUrlById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+	apiUrl, err := client.buildDataverseUrl(ctx, environmentId, fmt.Sprintf("deploymentpipelines(%s)/deploymentpipeline_deploymentenvironment/$ref", pipelineId), nil)
+	if err != nil {
+		return err
+	}
+
+	reference := DataverseReferenceDto{
+		OdataId: fmt.Sprintf("%s/api/data/%s/deploymentenvironments(%s)", environmentUrl, constants.DATAVERSE_API_VERSION, developmentEnvironment.Id),
+	}
+	_, err = client.Api.Execute(ctx, "POST", apiUrl, nil, reference, []int{http.StatusOK, http.StatusNoContent}, nil)
+	return err
+}
+
+func (client *PipelineClient) UnlinkDevelopmentEnvironment(ctx context.Context, environmentId, pipelineId, developmentEnvironmentId string) error {
+	developmentEnvironment, err := client.GetDeploymentEnvironment(ctx, environmentId, developmentEnvironmentId, DEPLOYMENT_ENVIRONMENT_TYPE_DEVELOPMENT)
+	if err != nil {
+		if powerplatform_helpers.Code(err) == powerplatform_helpers.ERROR_OBJECT_NOT_FOUND {
+			return nil
+		}
+		return err
+	}
+	return client.deleteRecord(ctx, environmentId, fmt.Sprintf("deploymentpipelines(%s)/deploymentpipeline_deploymentenvironment(%s)/$ref", pipelineId, developmentEnvironment.Id))
+}
+
+// GetDeploymentEnvironment returns the deployment environment record of a Power Platform environment in the pipelines host environment.
+func (client *PipelineClient) GetDeploymentEnvironment(ctx context.Context, environmentId, deploymentEnvironmentId string, environmentType int) (*DeploymentEnvironmentDto, error) {
+	environments, err := client.getDeploymentEnvironments(ctx, environmentId, fmt.Sprintf("environmentid eq '%s' and environmenttype eq %d", deploymentEnvironmentId, environmentType))
+	if err != nil {
+		return nil, err
+	}
+	if len(environments) == 0 {
+		return nil, powerplatform_helpers.NewProviderError(powerplatform_helpers.ERROR_OBJECT_NOT_FOUND, "deployment environment for environment %s not found in %s", deploymentEnvironmentId, environmentId)
+	}
+	return &environments[0], nil
+}
+
+// GetOrCreateDeploymentEnvironment returns the deployment environment record of a Power Platform environment, creating it when it does not exist yet.
+// Deployment environments can be shared between pipelines, so they are never deleted by the provider.
+func (client *PipelineClient) GetOrCreateDeploymentEnvironment(ctx context.Context, environmentId, deploymentEnvironmentId string, environmentType int) (*DeploymentEnvironmentDto, error) {
+	deploymentEnvironment, err := client.GetDeploymentEnvironment(ctx, environmentId, deploymentEnvironmentId, environmentType)
+	if err == nil {
+		return deploymentEnvironment, nil
+	}
+	if powerplatform_helpers.Code(err) != powerplatform_helpers.ERROR_OBJECT_NOT_FOUND {
+		return nil, err
+	}
+
+	apiUrl, err := client.buildDataverseUrl(ctx, environmentId, "deploymentenvironments", nil)
+	if err != nil {
+		return nil, err
+	}
+	_, err = client.createRecord(ctx, apiUrl, DeploymentEnvironmentDto{
+		Name:            deploymentEnvironmentId,
+		EnvironmentId:   deploymentEnvironmentId,
+		EnvironmentType: environmentType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	//the host environment validates new deployment environments asynchronously
+	sleepDuration := 5 * time.Second
+	deadline := time.Now().Add(DEPLOYMENT_ENVIRONMENT_VALIDATION_TIMEOUT)
+	for {
+		deploymentEnvironment, err = client.GetDeploymentEnvironment(ctx, environmentId, deploymentEnvironmentId, environmentType)
+		if err != nil {
+			return nil, err
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Deployment Environment '%s' Validation Status: %d", deploymentEnvironmentId, deploymentEnvironment.ValidationStatus))
+
+		switch deploymentEnvironment.ValidationStatus {
+		case DEPLOYMENT_VALIDATION_STATUS_SUCCEEDED:
+			return deploymentEnvironment, nil
+		case DEPLOYMENT_VALIDATION_STATUS_FAILED:
+			return nil, fmt.Errorf("validation of deployment environment %s failed: %s", deploymentEnvironmentId, deploymentEnvironment.ErrorMessage)
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("validation of deployment environment %s did not finish within %s", deploymentEnvironmentId, DEPLOYMENT_ENVIRONMENT_VALIDATION_TIMEOUT)
+		}
+		err = client.Api.SleepWithContext(ctx, sleepDuration)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (client *PipelineClient) getDeploymentEnvironments(ctx context.Context, environmentId, filter string) ([]DeploymentEnvironmentDto, error) {
+	values := url.Values{}
+	values.Add("$select", "deploymentenvironmentid,name,environmentid,environmenttype,validationstatus,errormessage")
+	if filter != "" {
+		values.Add("$filter", filter)
+	}
+	apiUrl, err := client.buildDataverseUrl(ctx, environmentId, "deploymentenvironments", values)
+	if err != nil {
+		return nil, err
+	}
+
+	environmentArray := DeploymentEnvironmentDtoArray{}
+	_, err = client.Api.Execute(ctx, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &environmentArray)
+	if err != nil {
+		return nil, err
+	}
+	return environmentArray.Value, nil
+}
+
+func (client *PipelineClient) GetPipelineRun(ctx context.Context, environmentId, stageRunId string) (*DeploymentStageRunDto, error) {
+	values := url.Values{}
+	values.Add("$select", "deploymentstagerunid,artifactname,artifactversion,solutionid,deploymentnotes,_deploymentstageid_value,_devdeploymentenvironment_value,stagerunstatus,validationstatus,errormessage")
+	apiUrl, err := client.buildDataverseUrl(ctx, environmentId, fmt.Sprintf("deploymentstageruns(%s)", stageRunId), values)
+	if err != nil {
+		return nil, err
+	}
+
+	stageRun := DeploymentStageRunDto{}
+	_, err = client.Api.Execute(ctx, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &stageRun)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil, powerplatform_helpers.WrapIntoProviderError(err, powerplatform_helpers.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("pipeline run %s not found in %s", stageRunId, environmentId))
+		}
+		return nil, err
+	}
+	return &stageRun, nil
+}
+
+// RunPipeline deploys a solution from a development environment to the target environment of a pipeline stage and waits for the deployment to finish.
+func (client *PipelineClient) RunPipeline(ctx context.Context, environmentId, stageId, developmentEnvironmentId, solutionName, deploymentNotes string) (*DeploymentStageRunDto, error) {
+	developmentEnvironment, err := client.GetDeploymentEnvironment(ctx, environmentId, developmentEnvironmentId, DEPLOYMENT_ENVIRONMENT_TYPE_DEVELOPMENT)
+	if err != nil {
+		if powerplatform_helpers.Code(err) == powerplatform_helpers.ERROR_OBJECT_NOT_FOUND {
+			return nil, fmt.Errorf("environment %s is not linked as development environment to any pipeline in %s", developmentEnvironmentId, environmentId)
+		}
+		return nil, err
+	}
+
+	artifact, err := client.solutionClient.GetSolution(ctx, developmentEnvironmentId, solutionName)
+	if err != nil {
+		return nil, err
+	}
+
+	apiUrl, err := client.buildDataverseUrl(ctx, environmentId, "deploymentstageruns", nil)
+	if err != nil {
+		return nil, err
+	}
+	stageRunId, err := client.createRecord(ctx, apiUrl, CreateDeploymentStageRunDto{
+		StageId:                    fmt.Sprintf("/deploymentstages(%s)", stageId),
+		DevDeploymentEnvironmentId: fmt.Sprintf("/deploymentenvironments(%s)", developmentEnvironment.Id),
+		ArtifactName:               artifact.Name,
+		SolutionId:                 artifact.Id,
+		DeploymentNotes:            deploymentNotes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	//validate the solution artifact against the target environment
+	err = client.executeStageRunAction(ctx, environmentId, "ValidatePackageAsync", stageRunId)
+	if err != nil {
+		return nil, err
+	}
+	_, err = client.waitForStageRun(ctx, environmentId, stageRunId, func(stageRun *DeploymentStageRunDto) (bool, error) {
+		switch stageRun.ValidationStatus {
+		case DEPLOYMENT_VALIDATION_STATUS_SUCCEEDED:
+			return true, nil
+		case DEPLOYMENT_VALIDATION_STATUS_FAILED:
+			return false, fmt.Errorf("validation of pipeline run %s failed: %s", stageRunId, stageRun.ErrorMessage)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	//deploy the validated solution artifact
+	err = client.executeStageRunAction(ctx, environmentId, "DeployPackageAsync", stageRunId)
+	if err != nil {
+		return nil, err
+	}
+	return client.waitForStageRun(ctx, environmentId, stageRunId, func(stageRun *DeploymentStageRunDto) (bool, error) {
+		switch stageRun.StageRunStatus {
+		case DEPLOYMENT_STAGE_RUN_STATUS_SUCCEEDED:
+			return true, nil
+		case DEPLOYMENT_STAGE_RUN_STATUS_FAILED, DEPLOYMENT_STAGE_RUN_STATUS_CANCELED:
+			return false, fmt.Errorf("pipeline run %s finished with status '%s': %s", stageRunId, DeploymentStageRunStatuses[stageRun.StageRunStatus], stageRun.ErrorMessage)
+		}
+		return false, nil
+	})
+}
+
+func (client *PipelineClient) executeStageRunAction(ctx context.Context, environmentId, action, stageRunId string) error {
+	apiUrl, err := client.buildDataverseUrl(ctx, environmentId, action, nil)
+	if err != nil {
+		return err
+	}
+	_, err = client.Api.Execute(ctx, "POST", apiUrl, nil, DeploymentStageRunActionDto{StageRunId: stageRunId}, []int{http.StatusOK, http.StatusNoContent}, nil)
+	return err
+}
+
+func (client *PipelineClient) waitForStageRun(ctx context.Context, environmentId, stageRunId string, isFinished func(*DeploymentStageRunDto) (bool, error)) (*DeploymentStageRunDto, error) {
+	//pull for stage run completion
+	sleepDuration := 10 * time.Second
+	deadline := time.Now().Add(DEPLOYMENT_STAGE_RUN_TIMEOUT)
+	for {
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("pipeline run %s did not finish within %s", stageRunId, DEPLOYMENT_STAGE_RUN_TIMEOUT)
+		}
+		err := client.Api.SleepWithContext(ctx, sleepDuration)
+		if err != nil {
+			return nil, err
+		}
+
+		stageRun, err := client.GetPipelineRun(ctx, environmentId, stageRunId)
+		if err != nil {
+			return nil, err
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Pipeline Run '%s' Status: %d, Validation Status: %d", stageRunId, stageRun.StageRunStatus, stageRun.ValidationStatus))
+
+		finished, err := isFinished(stageRun)
+		if err != nil {
+			return nil, err
+		}
+		if finished {
+			return stageRun, nil
+		}
+	}
+}
+
+func (client *PipelineClient) buildDataverseUrl(ctx context.Context, environmentId, path string, values url.Values) (string, error) {
+	environmentUrl, err := client.solutionClient.GetEnvironmentUrlById(ctx, environmentId)
+	if err != nil {
+		return "", err
+	}
+	return api.BuildDataverseUrl(environmentUrl, path, values), nil
+}
+
+func (client *PipelineClient) createRecord(ctx context.Context, apiUrl string, body interface{}) (string, error) {
+	response, err := client.Api.Execute(ctx, "POST", apiUrl, nil, body, []int{http.StatusOK, http.StatusNoContent}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return api.GetCreatedEntityId(response, "entity record")
+}
+
+func (client *PipelineClient) deleteRecord(ctx context.Context, environmentId, path string) error {
+	apiUrl, err := client.buildDataverseUrl(ctx, environmentId, path, nil)
+	if err != nil {
+		return err
+	}
+	_, err = client.Api.Execute(ctx, "DELETE", apiUrl, nil, nil, []int{http.StatusOK, http.StatusNoContent}, nil)
+	if err != nil && !strings.Contains(err.Error(), "404") {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+const (
+	DEPLOYMENT_ENVIRONMENT_TYPE_DEVELOPMENT = 200000000
+	DEPLOYMENT_ENVIRONMENT_TYPE_TARGET      = 200000001
+
+	DEPLOYMENT_VALIDATION_STATUS_PENDING   = 200000000
+	DEPLOYMENT_VALIDATION_STATUS_SUCCEEDED = 200000001
+	DEPLOYMENT_VALIDATION_STATUS_FAILED    = 200000002
+
+	DEPLOYMENT_STAGE_RUN_STATUS_NOT_STARTED = 200000000
+	DEPLOYMENT_STAGE_RUN_STATUS_STARTED     = 200000001
+	DEPLOYMENT_STAGE_RUN_STATUS_SUCCEEDED   = 200000002
+	DEPLOYMENT_STAGE_RUN_STATUS_FAILED      = 200000003
+	DEPLOYMENT_STAGE_RUN_STATUS_CANCELED    = 200000004
+)
+
+// DeploymentStageRunStatuses maps the stage run status option set values to their names.
+var DeploymentStageRunStatuses = map[int]string{
+	DEPLOYMENT_STAGE_RUN_STATUS_NOT_STARTED: "NotStarted",
+	DEPLOYMENT_STAGE_RUN_STATUS_STARTED:     "Started",
+	DEPLOYMENT_STAGE_RUN_STATUS_SUCCEEDED:   "Succeeded",
+	DEPLOYMENT_STAGE_RUN_STATUS_FAILED:      "Failed",
+	DEPLOYMENT_STAGE_RUN_STATUS_CANCELED:    "Canceled",
+}
+
+type DeploymentPipelineDto struct {
+	Id          string `json:"deploymentpipelineid,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type DeploymentEnvironmentDto struct {
+	Id               string `json:"deploymentenvironmentid,omitempty"`
+	Name             string `json:"name"`
+	EnvironmentId    string `json:"environmentid"`
+	EnvironmentType  int    `json:"environmenttype"`
+	ValidationStatus int    `json:"validationstatus,omitempty"`
+	ErrorMessage     string `json:"errormessage,omitempty"`
+}
+
+type DeploymentEnvironmentDtoArray struct {
+	Value []DeploymentEnvironmentDto `json:"value"`
+}
+
+type DeploymentStageDto struct {
+	Id                            string `json:"deploymentstageid"`
+	Name                          string `json:"name"`
+	Description                   string `json:"description"`
+	PipelineId                    string `json:"_deploymentpipelineid_value"`
+	TargetDeploymentEnvironmentId string `json:"_targetdeploymentenvironmentid_value"`
+	PreviousDeploymentStageId     string `json:"_previousdeploymentstageid_value"`
+	// TargetEnvironmentId is the Power Platform environment id of the target deployment environment
+	TargetEnvironmentId string `json:"-"`
+}
+
+type DeploymentStageDtoArray struct {
+	Value []DeploymentStageDto `json:"value"`
+}
+
+type CreateDeploymentStageDto struct {
+	Name                          string `json:"name"`
+	Description                   string `json:"description"`
+	PipelineId                    string `json:"DeploymentPipelineId@odata.bind,omitempty"`
+	TargetDeploymentEnvironmentId string `json:"TargetDeploymentEnvironmentId@odata.bind"`
+	PreviousDeploymentStageId     string `json:"PreviousDeploymentStageId@odata.bind,omitempty"`
+}
+
+type DeploymentStageRunDto struct {
+	Id                         string `json:"deploymentstagerunid"`
+	ArtifactName               string `json:"artifactname"`
+	ArtifactVersion            string `json:"artifactversion"`
+	SolutionId                 string `json:"solutionid"`
+	DeploymentNotes            string `json:"deploymentnotes"`
+	StageId                    string `json:"_deploymentstageid_value"`
+	DevDeploymentEnvironmentId string `json:"_devdeploymentenvironment_value"`
+	StageRunStatus             int    `json:"stagerunstatus"`
+	ValidationStatus           int    `json:"validationstatus"`
+	ErrorMessage               string `json:"errormessage"`
+}
+
+type CreateDeploymentStageRunDto struct {
+	StageId                    string `json:"DeploymentStageId@odata.bind"`
+	DevDeploymentEnvironmentId string `json:"DevDeploymentEnvironment@odata.bind"`
+	ArtifactName               string `json:"artifactname"`
+	SolutionId                 string `json:"solutionid"`
+	DeploymentNotes            string `json:"deploymentnotes,omitempty"`
+}
+
+type DeploymentStageRunActionDto struct {
+	StageRunId string `json:"StageRunId"`
+}
+
+type DataverseReferenceDto struct {
+	OdataId string `json:"@odata.id"`
+}
@@ -0,0 +1,414 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+var _ resource.Resource = &PipelineResource{}
+var _ resource.ResourceWithImportState = &PipelineResource{}
+
+func NewPipelineResource() resource.Resource {
+	return &PipelineResource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_pipeline",
+	}
+}
+
+type PipelineResource struct {
+	PipelineClient   PipelineClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+type PipelineResourceModel struct {
+	Id                        types.String                 `tfsdk:"id"`
+	EnvironmentId             types.String                 `tfsdk:"environment_id"`
+	Name                      types.String                 `tfsdk:"name"`
+	Description               types.String                 `tfsdk:"description"`
+	DevelopmentEnvironmentIds types.Set                    `tfsdk:"development_environment_ids"`
+	Stages                    []PipelineStageResourceModel `tfsdk:"stages"`
+}
+
+type PipelineStageResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	TargetEnvironmentId types.String `tfsdk:"target_environment_id"`
+}
+
+func (r *PipelineResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.TypeName
+}
+
+func (r *PipelineResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Resource for managing a Power Platform pipeline in a pipelines host environment",
+		MarkdownDescription: "Resource for managing a [Power Platform pipeline](https://learn.microsoft.com/power-platform/alm/pipelines) in a pipelines host environment. A pipeline deploys solutions from linked development environments through an ordered list of stages, where every stage targets one environment. Deployments are triggered with the `powerplatform_pipeline_run` resource.\n\nDeployment environment records created in the host environment for linked and target environments are shared between pipelines and are therefore not removed when the pipeline is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the pipeline",
+				Description:         "Unique identifier of the pipeline",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the pipelines host environment",
+				Description:         "Id of the pipelines host environment",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the pipeline",
+				Description:         "Name of the pipeline",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the pipeline",
+				Description:         "Description of the pipeline",
+				Optional:            true,
+			},
+			"development_environment_ids": schema.SetAttribute{
+				MarkdownDescription: "Ids of the development environments linked to the pipeline. Solutions can only be deployed from linked development environments",
+				Description:         "Ids of the development environments linked to the pipeline. Solutions can only be deployed from linked development environments",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"stages": schema.ListNestedAttribute{
+				MarkdownDescription: "Ordered list of pipeline stages. A solution is deployed to the stages in the order they are defined",
+				Description:         "Ordered list of pipeline stages. A solution is deployed to the stages in the order they are defined",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the stage",
+							Description:         "Unique identifier of the stage",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the stage",
+							Description:         "Name of the stage",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the stage",
+							Description:         "Description of the stage",
+							Optional:            true,
+						},
+						"target_environment_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the environment the stage deploys to",
+							Description:         "Id of the environment the stage deploys to",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *PipelineResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientApi := req.ProviderData.(*api.ProviderClient).Api
+
+	if clientApi == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.PipelineClient = NewPipelineClient(clientApi)
+}
+
+func (r *PipelineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *PipelineResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentId := plan.EnvironmentId.ValueString()
+
+	pipeline, err := r.PipelineClient.CreatePipeline(ctx, environmentId, convertToDeploymentPipelineDto(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.ProviderTypeName), err.Error())
+		return
+	}
+	plan.Id = types.StringValue(pipeline.Id)
+
+	//persist the pipeline right away, so that a failing stage does not leave an untracked pipeline behind
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), plan.EnvironmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), plan.Name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), plan.Description)...)
+
+	previousStageId := ""
+	for inx := range plan.Stages {
+		stage, err := r.PipelineClient.CreatePipelineStage(ctx, environmentId, pipeline.Id, convertToDeploymentStageDto(plan.Stages[inx], "", previousStageId))
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating stage '%s' of %s", plan.Stages[inx].Name.ValueString(), r.ProviderTypeName), err.Error())
+			return
+		}
+		plan.Stages[inx].Id = types.StringValue(stage.Id)
+		previousStageId = stage.Id
+	}
+
+	for _, developmentEnvironmentId := range convertFromDevelopmentEnvironmentIdsSet(plan.DevelopmentEnvironmentIds) {
+		err := r.PipelineClient.LinkDevelopmentEnvironment(ctx, environmentId, pipeline.Id, developmentEnvironmentId)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when linking development environment %s to %s", developmentEnvironmentId, r.ProviderTypeName), err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *PipelineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *PipelineResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentId := state.EnvironmentId.ValueString()
+
+	pipeline, err := r.PipelineClient.GetPipeline(ctx, environmentId, state.Id.ValueString())
+	if err != nil {
+		if powerplatform_helpers.Code(err) == powerplatform_helpers.ERROR_OBJECT_NOT_FOUND {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.ProviderTypeName), err.Error())
+		return
+	}
+
+	stages, err := r.PipelineClient.GetPipelineStages(ctx, environmentId, pipeline.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading stages of %s", r.ProviderTypeName), err.Error())
+		return
+	}
+
+	developmentEnvironments, err := r.PipelineClient.GetPipelineDevelopmentEnvironments(ctx, environmentId, pipeline.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading development environments of %s", r.ProviderTypeName), err.Error())
+		return
+	}
+
+	convertFromDeploymentPipelineDto(state, pipeline, stages, developmentEnvironments)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *PipelineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *PipelineResourceModel
+	var state *PipelineResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentId := plan.EnvironmentId.ValueString()
+	pipelineId := state.Id.ValueString()
+	plan.Id = state.Id
+
+	_, err := r.PipelineClient.UpdatePipeline(ctx, environmentId, pipelineId, convertToDeploymentPipelineDto(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.ProviderTypeName), err.Error())
+		return
+	}
+
+	//stages are matched by position, so that existing stages keep their id and deployment history
+	previousStageId := ""
+	for inx := range plan.Stages {
+		if inx < len(state.Stages) {
+			stage, err := r.PipelineClient.UpdatePipelineStage(ctx, environmentId, convertToDeploymentStageDto(plan.Stages[inx], state.Stages[inx].Id.ValueString(), previousStageId))
+			if err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating stage '%s' of %s", plan.Stages[inx].Name.ValueString(), r.ProviderTypeName), err.Error())
+				return
+			}
+			plan.Stages[inx].Id = types.StringValue(stage.Id)
+		} else {
+			stage, err := r.PipelineClient.CreatePipelineStage(ctx, environmentId, pipelineId, convertToDeploymentStageDto(plan.Stages[inx], "", previousStageId))
+			if err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating stage '%s' of %s", plan.Stages[inx].Name.ValueString(), r.ProviderTypeName), err.Error())
+				return
+			}
+			plan.Stages[inx].Id = types.StringValue(stage.Id)
+		}
+		previousStageId = plan.Stages[inx].Id.ValueString()
+	}
+
+	//removed stages are deleted from the last one, as every stage references its previous stage
+	for inx := len(state.Stages) - 1; inx >= len(plan.Stages); inx-- {
+		err := r.PipelineClient.DeletePipelineStage(ctx, environmentId, state.Stages[inx].Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting stage '%s' of %s", state.Stages[inx].Name.ValueString(), r.ProviderTypeName), err.Error())
+			return
+		}
+	}
+
+	linkedEnvironmentIds, unlinkedEnvironmentIds := powerplatform_helpers.DiffArrays(convertFromDevelopmentEnvironmentIdsSet(plan.DevelopmentEnvironmentIds), convertFromDevelopmentEnvironmentIdsSet(state.DevelopmentEnvironmentIds))
+	for _, developmentEnvironmentId := range unlinkedEnvironmentIds {
+		err := r.PipelineClient.UnlinkDevelopmentEnvironment(ctx, environmentId, pipelineId, developmentEnvironmentId)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when unlinking development environment %s from %s", developmentEnvironmentId, r.ProviderTypeName), err.Error())
+			return
+		}
+	}
+	for _, developmentEnvironmentId := range linkedEnvironmentIds {
+		err := r.PipelineClient.LinkDevelopmentEnvironment(ctx, environmentId, pipelineId, developmentEnvironmentId)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when linking development environment %s to %s", developmentEnvironmentId, r.ProviderTypeName), err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *PipelineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *PipelineResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.PipelineClient.DeletePipeline(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *PipelineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// import id format: <environment_id>_<pipeline_id>
+	environmentId, pipelineId, found := strings.Cut(req.ID, "_")
+	if !found {
+		resp.Diagnostics.AddError("Invalid import id", fmt.Sprintf("Expected import id in format '<environment_id>_<pipeline_id>', got: '%s'", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), pipelineId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+}
+
+func convertToDeploymentPipelineDto(model *PipelineResourceModel) DeploymentPipelineDto {
+	return DeploymentPipelineDto{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+	}
+}
+
+func convertToDeploymentStageDto(model PipelineStageResourceModel, stageId, previousStageId string) DeploymentStageDto {
+	return DeploymentStageDto{
+		Id:                        stageId,
+		Name:                      model.Name.ValueString(),
+		Description:               model.Description.ValueString(),
+		PreviousDeploymentStageId: previousStageId,
+		TargetEnvironmentId:       model.TargetEnvironmentId.ValueString(),
+	}
+}
+
+func convertFromDeploymentPipelineDto(model *PipelineResourceModel, pipeline *DeploymentPipelineDto, stages []DeploymentStageDto, developmentEnvironments []DeploymentEnvironmentDto) {
+	model.Id = types.StringValue(pipeline.Id)
+	model.Name = types.StringValue(pipeline.Name)
+	model.Description = convertOptionalString(pipeline.Description)
+
+	model.Stages = make([]PipelineStageResourceModel, 0, len(stages))
+	for _, stage := range stages {
+		model.Stages = append(model.Stages, PipelineStageResourceModel{
+			Id:                  types.StringValue(stage.Id),
+			Name:                types.StringValue(stage.Name),
+			Description:         convertOptionalString(stage.Description),
+			TargetEnvironmentId: types.StringValue(stage.TargetEnvironmentId),
+		})
+	}
+
+	//an explicitly configured empty set is kept, otherwise no linked environments are reported as null
+	if len(developmentEnvironments) == 0 && (model.DevelopmentEnvironmentIds.IsNull() || model.DevelopmentEnvironmentIds.IsUnknown()) {
+		model.DevelopmentEnvironmentIds = types.SetNull(types.StringType)
+		return
+	}
+	values := []attr.Value{}
+	for _, developmentEnvironment := range developmentEnvironments {
+		values = append(values, types.StringValue(developmentEnvironment.EnvironmentId))
+	}
+	model.DevelopmentEnvironmentIds = types.SetValueMust(types.StringType, values)
+}
+
+func convertFromDevelopmentEnvironmentIdsSet(developmentEnvironmentIds types.Set) []string {
+	ids := []string{}
+	if developmentEnvironmentIds.IsNull() || developmentEnvironmentIds.IsUnknown() {
+		return ids
+	}
+	for _, id := range developmentEnvironmentIds.Elements() {
+		ids = append(ids, id.(types.String).ValueString())
+	}
+	return ids
+}
+
+func convertOptionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
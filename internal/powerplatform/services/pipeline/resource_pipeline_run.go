@@ -0,0 +1,235 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+var _ resource.Resource = &PipelineRunResource{}
+
+func NewPipelineRunResource() resource.Resource {
+	return &PipelineRunResource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_pipeline_run",
+	}
+}
+
+type PipelineRunResource struct {
+	PipelineClient   PipelineClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+type PipelineRunResourceModel struct {
+	Id                       types.String `tfsdk:"id"`
+	EnvironmentId            types.String `tfsdk:"environment_id"`
+	StageId                  types.String `tfsdk:"stage_id"`
+	DevelopmentEnvironmentId types.String `tfsdk:"development_environment_id"`
+	SolutionName             types.String `tfsdk:"solution_name"`
+	DeploymentNotes          types.String `tfsdk:"deployment_notes"`
+	Triggers                 types.Map    `tfsdk:"triggers"`
+	ArtifactVersion          types.String `tfsdk:"artifact_version"`
+	Status                   types.String `tfsdk:"status"`
+}
+
+func (r *PipelineRunResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.TypeName
+}
+
+func (r *PipelineRunResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Resource for deploying a solution with a Power Platform pipeline",
+		MarkdownDescription: "Resource for deploying a solution from a development environment to the target environment of a [Power Platform pipeline](https://learn.microsoft.com/power-platform/alm/run-pipeline) stage. The solution is validated against the target environment first and the resource waits until the deployment has finished.\n\nA new deployment is triggered whenever any of the arguments change. Destroying the resource does not undo the deployment, the run is kept in the deployment history of the pipelines host environment.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the pipeline run",
+				Description:         "Unique identifier of the pipeline run",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the pipelines host environment",
+				Description:         "Id of the pipelines host environment",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"stage_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the pipeline stage to deploy to",
+				Description:         "Id of the pipeline stage to deploy to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"development_environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the development environment the solution is deployed from. The environment has to be linked to the pipeline",
+				Description:         "Id of the development environment the solution is deployed from. The environment has to be linked to the pipeline",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"solution_name": schema.StringAttribute{
+				MarkdownDescription: "Unique name of the solution to deploy",
+				Description:         "Unique name of the solution to deploy",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deployment_notes": schema.StringAttribute{
+				MarkdownDescription: "Deployment notes of the pipeline run",
+				Description:         "Deployment notes of the pipeline run",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will trigger a new deployment",
+				Description:         "Arbitrary map of values that, when changed, will trigger a new deployment",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"artifact_version": schema.StringAttribute{
+				MarkdownDescription: "Version of the deployed solution artifact",
+				Description:         "Version of the deployed solution artifact",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the pipeline run",
+				Description:         "Status of the pipeline run",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PipelineRunResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientApi := req.ProviderData.(*api.ProviderClient).Api
+
+	if clientApi == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.PipelineClient = NewPipelineClient(clientApi)
+}
+
+func (r *PipelineRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *PipelineRunResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stageRun, err := r.PipelineClient.RunPipeline(ctx, plan.EnvironmentId.ValueString(), plan.StageId.ValueString(), plan.DevelopmentEnvironmentId.ValueString(), plan.SolutionName.ValueString(), plan.DeploymentNotes.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deploying solution %s", plan.SolutionName.ValueString()), err.Error())
+		return
+	}
+
+	convertFromDeploymentStageRunDto(plan, stageRun)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *PipelineRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *PipelineRunResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stageRun, err := r.PipelineClient.GetPipelineRun(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		if powerplatform_helpers.Code(err) == powerplatform_helpers.ERROR_OBJECT_NOT_FOUND {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.ProviderTypeName), err.Error())
+		return
+	}
+
+	convertFromDeploymentStageRunDto(state, stageRun)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *PipelineRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// all configurable attributes require replacement, so there is nothing to update in place
+	var plan *PipelineRunResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *PipelineRunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE START: %s", r.ProviderTypeName))
+
+	// a deployment cannot be undone, the pipeline run is kept in the deployment history of the host environment
+	resp.Diagnostics.AddWarning("Pipeline run is not reverted", "Destroying a pipeline run only removes it from the Terraform state. The deployed solution stays in the target environment and the run is kept in the deployment history of the pipeline.")
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func convertFromDeploymentStageRunDto(model *PipelineRunResourceModel, stageRun *DeploymentStageRunDto) {
+	model.Id = types.StringValue(stageRun.Id)
+	model.ArtifactVersion = types.StringValue(stageRun.ArtifactVersion)
+	model.Status = types.StringValue(DeploymentStageRunStatuses[stageRun.StageRunStatus])
+}
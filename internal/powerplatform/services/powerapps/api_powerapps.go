@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 
+	constants "github.com/microsoft/terraform-provider-power-platform/constants"
 	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
 	environment "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/services/environment"
 )
@@ -30,8 +31,8 @@ func (client *PowerAppssClient) GetPowerApps(ctx context.Context, environmentId
 	if err != nil {
 		return nil, err
 	}
-	apps := make([]PowerAppBapi, 0)
-	for _, env := range envs {
+	//apps of all environments are fetched concurrently, the order of the environments is kept
+	appsPerEnvironment, err := api.ExecuteConcurrently(ctx, envs, constants.MAX_CONCURRENT_REQUESTS, func(ctx context.Context, env environment.EnvironmentDto) ([]PowerAppBapi, error) {
 		apiUrl := &url.URL{
 			Scheme: "https",
 			Host:   client.Api.GetConfig().Urls.PowerAppsUrl,
@@ -46,8 +47,15 @@ func (client *PowerAppssClient) GetPowerApps(ctx context.Context, environmentId
 		if err != nil {
 			return nil, err
 		}
-		apps = append(apps, appsArray.Value...)
+		return appsArray.Value, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
+	apps := make([]PowerAppBapi, 0)
+	for _, environmentApps := range appsPerEnvironment {
+		apps = append(apps, environmentApps...)
 	}
 	return apps, nil
-}
\ No newline at end of file
+}
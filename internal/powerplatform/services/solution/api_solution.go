@@ -60,8 +60,16 @@ func (client *SolutionClient) GetSolutions(ctx context.Context, environmentId st
 		return nil, err
 	}
 
+	solutionNames := make(map[string]string)
+	for _, solution := range solutionArray.Value {
+		solutionNames[solution.Id] = solution.Name
+	}
+
 	for inx := range solutionArray.Value {
 		solutionArray.Value[inx].EnvironmentId = environmentId
+		if parentSolutionId := solutionArray.Value[inx].ParentSolutionId; parentSolutionId != "" {
+			solutionArray.Value[inx].ParentSolutionName = solutionNames[parentSolutionId]
+		}
 	}
 
 	solutions := make([]SolutionDto, 0)
@@ -181,6 +189,62 @@ func (client *SolutionClient) GetSolution(ctx context.Context, environmentId str
 	return nil, fmt.Errorf("solution %s not found in %s", solutionName, environmentId)
 }
 
+func (client *SolutionClient) GetSolutionById(ctx context.Context, environmentId string, solutionId string) (*SolutionDto, error) {
+	solutions, err := client.GetSolutions(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, solution := range solutions {
+		if strings.EqualFold(solution.Id, solutionId) {
+			return &solution, nil
+		}
+	}
+	return nil, powerplatform_helpers.NewProviderError(powerplatform_helpers.ERROR_OBJECT_NOT_FOUND, "solution %s not found in %s", solutionId, environmentId)
+}
+
+// CloneAsPatch creates a new patch of an unmanaged parent solution.
+func (client *SolutionClient) CloneAsPatch(ctx context.Context, environmentId string, patchToCreate CloneSolutionDto) (*SolutionDto, error) {
+	return client.cloneSolution(ctx, environmentId, "CloneAsPatch", patchToCreate)
+}
+
+// CloneAsSolution rolls up all patches of an unmanaged parent solution into a new version of the parent solution.
+func (client *SolutionClient) CloneAsSolution(ctx context.Context, environmentId string, solutionToCreate CloneSolutionDto) (*SolutionDto, error) {
+	return client.cloneSolution(ctx, environmentId, "CloneAsSolution", solutionToCreate)
+}
+
+func (client *SolutionClient) cloneSolution(ctx context.Context, environmentId string, action string, cloneRequestBody CloneSolutionDto) (*SolutionDto, error) {
+	parentSolution, err := client.GetSolution(ctx, environmentId, cloneRequestBody.ParentSolutionUniqueName)
+	if err != nil {
+		return nil, err
+	}
+	if parentSolution.IsManaged {
+		return nil, fmt.Errorf("%s requires an unmanaged parent solution, but solution '%s' is managed", action, parentSolution.Name)
+	}
+	if parentSolution.ParentSolutionId != "" {
+		return nil, fmt.Errorf("%s requires a parent solution, but solution '%s' is a patch of '%s'", action, parentSolution.Name, parentSolution.ParentSolutionName)
+	}
+
+	environmentUrl, err := client.GetEnvironmentUrlById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   strings.TrimPrefix(environmentUrl, "https://"),
+		Path:   fmt.Sprintf("/api/data/v9.2/%s", action),
+	}
+
+	cloneSolutionResponse := CloneSolutionResponseDto{}
+	_, err = client.Api.Execute(ctx, "POST", apiUrl.String(), nil, cloneRequestBody, []int{http.StatusOK}, &cloneSolutionResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetSolutionById(ctx, environmentId, cloneSolutionResponse.SolutionId)
+}
+
 func (client *SolutionClient) createSolutionComponentParameters(ctx context.Context, settings []byte) ([]interface{}, error) {
 	if len(settings) == 0 {
 		return nil, nil
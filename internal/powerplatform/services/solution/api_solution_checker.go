@@ -0,0 +1,243 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	constants "github.com/microsoft/terraform-provider-power-platform/constants"
+)
+
+const (
+	SOLUTION_CHECKER_DEFAULT_RULE_SET_ID = "0ad12346-e108-40b8-a956-9a8f95ea18c9"
+	SOLUTION_CHECKER_ADVISOR_ENDPOINT    = "microsoft.PowerAppsAdvisor"
+	SOLUTION_CHECKER_TOP_ISSUES_COUNT    = 10
+	SOLUTION_CHECKER_ANALYSIS_TIMEOUT    = 1 * time.Hour
+)
+
+// SolutionCheckerSeverities lists the solution checker severities from the most to the least severe.
+var SolutionCheckerSeverities = []string{"Critical", "High", "Medium", "Low", "Informational"}
+
+func (client *SolutionClient) RunSolutionChecker(ctx context.Context, environmentId string, fileName string, content []byte, ruleSetId string) (*SolutionCheckerStatusDto, error) {
+	env, err := client.getEnvironment(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	advisorUrl, err := client.getAdvisorUrl(env)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := http.Header{}
+	headers.Set("x-ms-tenant-id", env.Properties.TenantId)
+	headers.Set("x-ms-correlation-id", uuid.New().String())
+	headers.Set("Accept-Language", "en")
+
+	//upload solution file
+	uploadHeaders := headers.Clone()
+	uploadHeaders.Set("Content-Type", "application/octet-stream")
+	uploadHeaders.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", fileName))
+
+	apiUrl := &url.URL{
+		Scheme: "https",
+		Host:   advisorUrl.Host,
+		Path:   "/api/upload",
+	}
+	values := url.Values{}
+	values.Add("api-version", "1.0")
+	apiUrl.RawQuery = values.Encode()
+
+	sasUriList := make([]string, 0)
+	_, err = client.Api.Execute(ctx, "POST", apiUrl.String(), uploadHeaders, content, []int{http.StatusOK}, &sasUriList)
+	if err != nil {
+		return nil, err
+	}
+
+	//start analysis
+	analyzeRequestBody := SolutionCheckerAnalyzeDto{
+		RuleSets:   []SolutionCheckerRuleSetDto{{Id: ruleSetId}},
+		SasUriList: sasUriList,
+	}
+
+	apiUrl.Path = "/api/analyze"
+	response, err := client.Api.Execute(ctx, "POST", apiUrl.String(), headers.Clone(), analyzeRequestBody, []int{http.StatusAccepted}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	statusUrl := response.GetHeader(constants.HEADER_LOCATION)
+	tflog.Debug(ctx, "Solution Checker Status Location Header: "+statusUrl)
+
+	//pull for analysis completion
+	sleepDuration := 10 * time.Second
+	deadline := time.Now().Add(SOLUTION_CHECKER_ANALYSIS_TIMEOUT)
+	for {
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("solution checker analysis of %s did not finish within %s", fileName, SOLUTION_CHECKER_ANALYSIS_TIMEOUT)
+		}
+		err = client.Api.SleepWithContext(ctx, sleepDuration)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err = client.Api.Execute(ctx, "GET", statusUrl, headers.Clone(), nil, []int{http.StatusOK, http.StatusAccepted}, nil)
+		if err != nil {
+			return nil, err
+		}
+		if response.Response.StatusCode == http.StatusAccepted {
+			continue
+		}
+
+		status := SolutionCheckerStatusDto{}
+		err = response.MarshallTo(&status)
+		if err != nil {
+			return nil, err
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Solution Checker Status: '%s', Progress: %d", status.Status, status.Progress))
+
+		switch status.Status {
+		case "Finished", "FinishedWithErrors":
+			return &status, nil
+		case "Failed":
+			return nil, fmt.Errorf("solution checker analysis '%s' failed", status.RunCorrelationId)
+		case "NotStarted", "Queued", "Started", "InProgress":
+			continue
+		default:
+			return nil, fmt.Errorf("solution checker analysis '%s' returned unknown status '%s'", status.RunCorrelationId, status.Status)
+		}
+	}
+}
+
+// GetSolutionCheckerIssues downloads the SARIF result files of a finished analysis and returns the reported issues ordered by severity.
+func (client *SolutionClient) GetSolutionCheckerIssues(ctx context.Context, status *SolutionCheckerStatusDto) ([]SolutionCheckerIssueDto, error) {
+	issues := make([]SolutionCheckerIssueDto, 0)
+	for _, resultFileUri := range status.ResultFileUris {
+		//result files are downloaded from pre-signed storage urls that don't accept the provider token
+		response, err := client.Api.ExecuteWithoutToken(ctx, "GET", resultFileUri, nil, nil, []int{http.StatusOK}, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		sarifFiles, err := readSarifFiles(response.BodyAsBytes)
+		if err != nil {
+			return nil, err
+		}
+		for _, sarif := range sarifFiles {
+			issues = append(issues, convertFromSarifDto(sarif)...)
+		}
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		return severityRank(issues[i].Severity) < severityRank(issues[j].Severity)
+	})
+	return issues, nil
+}
+
+func (client *SolutionClient) getAdvisorUrl(env *EnvironmentIdDto) (*url.URL, error) {
+	advisorEndpoint := env.Properties.RuntimeEndpoints[SOLUTION_CHECKER_ADVISOR_ENDPOINT]
+	if advisorEndpoint == "" {
+		advisorEndpoint = fmt.Sprintf("https://%s.%s", env.Location, client.Api.GetConfig().Urls.PowerAppsAdvisorUrl)
+	}
+	return url.Parse(advisorEndpoint)
+}
+
+func readSarifFiles(content []byte) ([]SolutionCheckerSarifDto, error) {
+	sarifFiles := make([]SolutionCheckerSarifDto, 0)
+
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		//result file is not zipped
+		sarif := SolutionCheckerSarifDto{}
+		err = json.Unmarshal(content, &sarif)
+		if err != nil {
+			return nil, err
+		}
+		return append(sarifFiles, sarif), nil
+	}
+
+	for _, file := range archive.File {
+		if !strings.HasSuffix(strings.ToLower(file.Name), ".sarif") {
+			continue
+		}
+		reader, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		sarif := SolutionCheckerSarifDto{}
+		err = json.NewDecoder(reader).Decode(&sarif)
+		reader.Close()
+		if err != nil {
+			return nil, err
+		}
+		sarifFiles = append(sarifFiles, sarif)
+	}
+	return sarifFiles, nil
+}
+
+func convertFromSarifDto(sarif SolutionCheckerSarifDto) []SolutionCheckerIssueDto {
+	issues := make([]SolutionCheckerIssueDto, 0)
+	for _, run := range sarif.Runs {
+		ruleDescriptions := make(map[string]string)
+		for _, rule := range run.Tool.Driver.Rules {
+			ruleDescriptions[rule.Id] = rule.ShortDescription.Text
+		}
+
+		for _, result := range run.Results {
+			message := result.Message.Text
+			if message == "" {
+				message = ruleDescriptions[result.RuleId]
+			}
+			location := ""
+			if len(result.Locations) > 0 {
+				location = result.Locations[0].PhysicalLocation.ArtifactLocation.Uri
+			}
+			issues = append(issues, SolutionCheckerIssueDto{
+				RuleId:   result.RuleId,
+				Severity: result.Properties.Severity,
+				Message:  message,
+				Location: location,
+			})
+		}
+	}
+	return issues
+}
+
+func severityRank(severity string) int {
+	for inx, s := range SolutionCheckerSeverities {
+		if strings.EqualFold(s, severity) {
+			return inx
+		}
+	}
+	return len(SolutionCheckerSeverities)
+}
+
+// CountSolutionCheckerIssuesAtOrAbove returns the number of issues that are at least as severe as the given severity.
+func CountSolutionCheckerIssuesAtOrAbove(summary SolutionCheckerIssueSummaryDto, severity string) int64 {
+	counts := []int64{
+		summary.CriticalIssueCount,
+		summary.HighIssueCount,
+		summary.MediumIssueCount,
+		summary.LowIssueCount,
+		summary.InformationalIssueCount,
+	}
+
+	total := int64(0)
+	for inx := 0; inx <= severityRank(severity) && inx < len(counts); inx++ {
+		total += counts[inx]
+	}
+	return total
+}
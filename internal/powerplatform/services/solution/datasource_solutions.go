@@ -49,6 +49,8 @@ type SolutionsDataSourceModel struct {
 	InstallTime   types.String `tfsdk:"install_time"`
 	Version       types.String `tfsdk:"version"`
 	IsManaged     types.Bool   `tfsdk:"is_managed"`
+
+	ParentSolutionName types.String `tfsdk:"parent_solution_name"`
 }
 
 func ConvertFromSolutionDto(solutionDto SolutionDto) SolutionsDataSourceModel {
@@ -62,6 +64,8 @@ func ConvertFromSolutionDto(solutionDto SolutionDto) SolutionsDataSourceModel {
 		InstallTime:   types.StringValue(solutionDto.InstallTime),
 		Version:       types.StringValue(solutionDto.Version),
 		IsManaged:     types.BoolValue(solutionDto.IsManaged),
+
+		ParentSolutionName: convertParentSolutionName(&solutionDto),
 	}
 }
 
@@ -135,6 +139,11 @@ func (d *SolutionsDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 							Description:         "Is managed",
 							Computed:            true,
 						},
+						"parent_solution_name": schema.StringAttribute{
+							MarkdownDescription: "Unique name of the parent solution when the solution is a patch",
+							Description:         "Unique name of the parent solution when the solution is a patch",
+							Computed:            true,
+						},
 					},
 				},
 			},
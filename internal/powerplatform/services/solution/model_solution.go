@@ -29,6 +29,9 @@ type SolutionDto struct {
 	Version       string `json:"version"`
 	ModifiedTime  string `json:"modifiedon"`
 	InstallTime   string `json:"installedon"`
+
+	ParentSolutionId   string `json:"_parentsolutionid_value"`
+	ParentSolutionName string `json:"-"`
 }
 
 type SolutionDtoArray struct {
@@ -131,13 +134,107 @@ type ValidateSolutionImportResponseSolutionOperationResultDto struct {
 type EnvironmentIdDto struct {
 	Id         string                     `json:"id"`
 	Name       string                     `json:"name"`
+	Location   string                     `json:"location"`
 	Properties EnvironmentIdPropertiesDto `json:"properties"`
 }
 
 type EnvironmentIdPropertiesDto struct {
+	TenantId                  string                         `json:"tenantId"`
+	RuntimeEndpoints          map[string]string              `json:"runtimeEndpoints"`
 	LinkedEnvironmentMetadata LinkedEnvironmentIdMetadataDto `json:"linkedEnvironmentMetadata"`
 }
 
 type LinkedEnvironmentIdMetadataDto struct {
 	InstanceURL string
 }
+
+type CloneSolutionDto struct {
+	ParentSolutionUniqueName string `json:"ParentSolutionUniqueName"`
+	DisplayName              string `json:"DisplayName"`
+	VersionNumber            string `json:"VersionNumber"`
+}
+
+type CloneSolutionResponseDto struct {
+	SolutionId string `json:"SolutionId"`
+}
+
+type SolutionCheckerAnalyzeDto struct {
+	RuleSets   []SolutionCheckerRuleSetDto `json:"ruleSets"`
+	SasUriList []string                    `json:"sasUriList"`
+}
+
+type SolutionCheckerRuleSetDto struct {
+	Id string `json:"id"`
+}
+
+type SolutionCheckerStatusDto struct {
+	Progress         int                            `json:"progress"`
+	RunCorrelationId string                         `json:"runCorrelationId"`
+	Status           string                         `json:"status"`
+	ResultFileUris   []string                       `json:"resultFileUris"`
+	IssueSummary     SolutionCheckerIssueSummaryDto `json:"issueSummary"`
+}
+
+type SolutionCheckerIssueSummaryDto struct {
+	CriticalIssueCount      int64 `json:"criticalIssueCount"`
+	HighIssueCount          int64 `json:"highIssueCount"`
+	MediumIssueCount        int64 `json:"mediumIssueCount"`
+	LowIssueCount           int64 `json:"lowIssueCount"`
+	InformationalIssueCount int64 `json:"informationalIssueCount"`
+}
+
+type SolutionCheckerIssueDto struct {
+	RuleId   string
+	Severity string
+	Message  string
+	Location string
+}
+
+type SolutionCheckerSarifDto struct {
+	Runs []SolutionCheckerSarifRunDto `json:"runs"`
+}
+
+type SolutionCheckerSarifRunDto struct {
+	Tool    SolutionCheckerSarifToolDto     `json:"tool"`
+	Results []SolutionCheckerSarifResultDto `json:"results"`
+}
+
+type SolutionCheckerSarifToolDto struct {
+	Driver SolutionCheckerSarifDriverDto `json:"driver"`
+}
+
+type SolutionCheckerSarifDriverDto struct {
+	Rules []SolutionCheckerSarifRuleDto `json:"rules"`
+}
+
+type SolutionCheckerSarifRuleDto struct {
+	Id               string                         `json:"id"`
+	ShortDescription SolutionCheckerSarifMessageDto `json:"shortDescription"`
+}
+
+type SolutionCheckerSarifResultDto struct {
+	RuleId     string                            `json:"ruleId"`
+	Message    SolutionCheckerSarifMessageDto    `json:"message"`
+	Locations  []SolutionCheckerSarifLocationDto `json:"locations"`
+	Properties SolutionCheckerSarifPropertiesDto `json:"properties"`
+}
+
+type SolutionCheckerSarifMessageDto struct {
+	Text string `json:"text"`
+}
+
+type SolutionCheckerSarifLocationDto struct {
+	PhysicalLocation SolutionCheckerSarifPhysicalLocationDto `json:"physicalLocation"`
+}
+
+type SolutionCheckerSarifPhysicalLocationDto struct {
+	ArtifactLocation SolutionCheckerSarifArtifactLocationDto `json:"artifactLocation"`
+}
+
+type SolutionCheckerSarifArtifactLocationDto struct {
+	Uri string `json:"uri"`
+}
+
+type SolutionCheckerSarifPropertiesDto struct {
+	Severity string `json:"severity"`
+}
@@ -7,7 +7,11 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
@@ -49,6 +54,21 @@ type SolutionResourceModel struct {
 	SettingsFile         types.String `tfsdk:"settings_file"`
 	IsManaged            types.Bool   `tfsdk:"is_managed"`
 	DisplayName          types.String `tfsdk:"display_name"`
+	ParentSolutionName   types.String `tfsdk:"parent_solution_name"`
+
+	RunSolutionChecker            types.Bool   `tfsdk:"run_solution_checker"`
+	SolutionCheckerRuleSetId      types.String `tfsdk:"solution_checker_rule_set_id"`
+	SolutionCheckerFailOnSeverity types.String `tfsdk:"solution_checker_fail_on_severity"`
+	SolutionCheckerResults        types.Object `tfsdk:"solution_checker_results"`
+}
+
+var attrTypesSolutionCheckerResults = map[string]attr.Type{
+	"run_correlation_id":        types.StringType,
+	"critical_issue_count":      types.Int64Type,
+	"high_issue_count":          types.Int64Type,
+	"medium_issue_count":        types.Int64Type,
+	"low_issue_count":           types.Int64Type,
+	"informational_issue_count": types.Int64Type,
 }
 
 func (r *SolutionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -132,6 +152,69 @@ func (r *SolutionResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"parent_solution_name": schema.StringAttribute{
+				MarkdownDescription: "Unique name of the parent solution when the imported solution is a [patch](https://learn.microsoft.com/power-platform/alm/create-patches-simplify-solution-updates)",
+				Description:         "Unique name of the parent solution when the imported solution is a patch",
+				Computed:            true,
+			},
+			"run_solution_checker": schema.BoolAttribute{
+				MarkdownDescription: "Run the [solution checker](https://learn.microsoft.com/power-apps/maker/data-platform/use-powerapps-checker) against the solution file before it is imported",
+				Description:         "Run the solution checker against the solution file before it is imported",
+				Optional:            true,
+			},
+			"solution_checker_rule_set_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the solution checker rule set used for the analysis. Default is the `Solution Checker` rule set",
+				Description:         "Id of the solution checker rule set used for the analysis. Default is the `Solution Checker` rule set",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(powerplatform_helpers.GuidRegex), "solution_checker_rule_set_id must be a valid GUID"),
+				},
+			},
+			"solution_checker_fail_on_severity": schema.StringAttribute{
+				MarkdownDescription: "When set, the import is blocked if the solution checker reports at least one issue of this severity or higher. Valid values are `Critical`, `High`, `Medium`, `Low`, `Informational`",
+				Description:         "When set, the import is blocked if the solution checker reports at least one issue of this severity or higher. Valid values are `Critical`, `High`, `Medium`, `Low`, `Informational`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(SolutionCheckerSeverities...),
+				},
+			},
+			"solution_checker_results": schema.SingleNestedAttribute{
+				MarkdownDescription: "Issue counts by severity reported by the solution checker for the last imported solution file",
+				Description:         "Issue counts by severity reported by the solution checker for the last imported solution file",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"run_correlation_id": schema.StringAttribute{
+						MarkdownDescription: "Correlation id of the solution checker run",
+						Description:         "Correlation id of the solution checker run",
+						Computed:            true,
+					},
+					"critical_issue_count": schema.Int64Attribute{
+						MarkdownDescription: "Number of critical issues",
+						Description:         "Number of critical issues",
+						Computed:            true,
+					},
+					"high_issue_count": schema.Int64Attribute{
+						MarkdownDescription: "Number of high severity issues",
+						Description:         "Number of high severity issues",
+						Computed:            true,
+					},
+					"medium_issue_count": schema.Int64Attribute{
+						MarkdownDescription: "Number of medium severity issues",
+						Description:         "Number of medium severity issues",
+						Computed:            true,
+					},
+					"low_issue_count": schema.Int64Attribute{
+						MarkdownDescription: "Number of low severity issues",
+						Description:         "Number of low severity issues",
+						Computed:            true,
+					},
+					"informational_issue_count": schema.Int64Attribute{
+						MarkdownDescription: "Number of informational issues",
+						Description:         "Number of informational issues",
+						Computed:            true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -176,6 +259,7 @@ func (r *SolutionResource) Create(ctx context.Context, req resource.CreateReques
 	plan.SolutionVersion = types.StringValue(solution.Version)
 	plan.IsManaged = types.BoolValue(solution.IsManaged)
 	plan.DisplayName = types.StringValue(solution.DisplayName)
+	plan.ParentSolutionName = convertParentSolutionName(solution)
 	plan.Id = types.StringValue(fmt.Sprintf("%s_%s", plan.EnvironmentId.ValueString(), solution.Name))
 
 	plan.SettingsFileChecksum = types.StringUnknown()
@@ -237,6 +321,7 @@ func (r *SolutionResource) Read(ctx context.Context, req resource.ReadRequest, r
 			state.SolutionVersion = types.StringValue(solution.Version)
 			state.IsManaged = types.BoolValue(solution.IsManaged)
 			state.DisplayName = types.StringValue(solution.DisplayName)
+			state.ParentSolutionName = convertParentSolutionName(&solution)
 			solutionFound = true
 			break
 		}
@@ -249,6 +334,7 @@ func (r *SolutionResource) Read(ctx context.Context, req resource.ReadRequest, r
 		state.SolutionVersion = types.StringNull()
 		state.IsManaged = types.BoolNull()
 		state.DisplayName = types.StringNull()
+		state.ParentSolutionName = types.StringNull()
 		state.SettingsFileChecksum = types.StringNull()
 		state.SolutionFileChecksum = types.StringNull()
 
@@ -295,6 +381,14 @@ func (r *SolutionResource) importSolution(ctx context.Context, plan *SolutionRes
 		return nil
 	}
 
+	plan.SolutionCheckerResults = types.ObjectNull(attrTypesSolutionCheckerResults)
+	if plan.RunSolutionChecker.ValueBool() && !diagnostics.HasError() {
+		r.checkSolution(ctx, plan, solutionContent, diagnostics)
+		if diagnostics.HasError() {
+			return nil
+		}
+	}
+
 	solution, err := r.SolutionClient.CreateSolution(ctx, plan.EnvironmentId.ValueString(), s, solutionContent, settingsContent)
 	if err != nil {
 		diagnostics.AddError(fmt.Sprintf("Client error when importing solution %s", plan.SolutionFile), err.Error())
@@ -302,6 +396,64 @@ func (r *SolutionResource) importSolution(ctx context.Context, plan *SolutionRes
 	return solution
 }
 
+func (r *SolutionResource) checkSolution(ctx context.Context, plan *SolutionResourceModel, solutionContent []byte, diagnostics *diag.Diagnostics) {
+	ruleSetId := SOLUTION_CHECKER_DEFAULT_RULE_SET_ID
+	if !plan.SolutionCheckerRuleSetId.IsNull() && !plan.SolutionCheckerRuleSetId.IsUnknown() {
+		ruleSetId = plan.SolutionCheckerRuleSetId.ValueString()
+	}
+
+	status, err := r.SolutionClient.RunSolutionChecker(ctx, plan.EnvironmentId.ValueString(), filepath.Base(plan.SolutionFile.ValueString()), solutionContent, ruleSetId)
+	if err != nil {
+		diagnostics.AddError(fmt.Sprintf("Client error when running solution checker for solution file %s", plan.SolutionFile.ValueString()), err.Error())
+		return
+	}
+
+	plan.SolutionCheckerResults = types.ObjectValueMust(attrTypesSolutionCheckerResults, map[string]attr.Value{
+		"run_correlation_id":        types.StringValue(status.RunCorrelationId),
+		"critical_issue_count":      types.Int64Value(status.IssueSummary.CriticalIssueCount),
+		"high_issue_count":          types.Int64Value(status.IssueSummary.HighIssueCount),
+		"medium_issue_count":        types.Int64Value(status.IssueSummary.MediumIssueCount),
+		"low_issue_count":           types.Int64Value(status.IssueSummary.LowIssueCount),
+		"informational_issue_count": types.Int64Value(status.IssueSummary.InformationalIssueCount),
+	})
+
+	if plan.SolutionCheckerFailOnSeverity.IsNull() || plan.SolutionCheckerFailOnSeverity.IsUnknown() {
+		return
+	}
+
+	failOnSeverity := plan.SolutionCheckerFailOnSeverity.ValueString()
+	blockingIssueCount := CountSolutionCheckerIssuesAtOrAbove(status.IssueSummary, failOnSeverity)
+	if blockingIssueCount == 0 {
+		return
+	}
+
+	summary := fmt.Sprintf("Solution checker reported %d issue(s) of severity '%s' or higher", blockingIssueCount, failOnSeverity)
+	detail := fmt.Sprintf("Import of solution file %s was blocked. Critical: %d, High: %d, Medium: %d, Low: %d, Informational: %d.",
+		plan.SolutionFile.ValueString(),
+		status.IssueSummary.CriticalIssueCount,
+		status.IssueSummary.HighIssueCount,
+		status.IssueSummary.MediumIssueCount,
+		status.IssueSummary.LowIssueCount,
+		status.IssueSummary.InformationalIssueCount)
+
+	issues, err := r.SolutionClient.GetSolutionCheckerIssues(ctx, status)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Unable to download solution checker results: %s", err.Error()))
+	} else if len(issues) > 0 {
+		detail += "\n\nTop issues:"
+		for inx, issue := range issues {
+			if inx == SOLUTION_CHECKER_TOP_ISSUES_COUNT {
+				break
+			}
+			detail += fmt.Sprintf("\n- [%s] %s: %s", issue.Severity, issue.RuleId, issue.Message)
+			if issue.Location != "" {
+				detail += fmt.Sprintf(" (%s)", issue.Location)
+			}
+		}
+	}
+	diagnostics.AddAttributeError(path.Root("solution_checker_fail_on_severity"), summary, detail)
+}
+
 func (r *SolutionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE START: %s", r.ProviderTypeName))
 
@@ -317,12 +469,17 @@ func (r *SolutionResource) Update(ctx context.Context, req resource.UpdateReques
 
 	solution := r.importSolution(ctx, plan, &resp.Diagnostics)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	plan.Id = types.StringValue(fmt.Sprintf("%s_%s", plan.EnvironmentId.ValueString(), solution.Name))
 
 	plan.SolutionName = types.StringValue(solution.Name)
 	plan.SolutionVersion = types.StringValue(solution.Version)
 	plan.IsManaged = types.BoolValue(solution.IsManaged)
 	plan.DisplayName = types.StringValue(solution.DisplayName)
+	plan.ParentSolutionName = convertParentSolutionName(solution)
 
 	plan.SettingsFileChecksum = types.StringUnknown()
 	if !plan.SettingsFile.IsNull() && !plan.SettingsFile.IsUnknown() {
@@ -374,3 +531,10 @@ func (r *SolutionResource) Delete(ctx context.Context, req resource.DeleteReques
 func (r *SolutionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func convertParentSolutionName(solution *SolutionDto) types.String {
+	if solution.ParentSolutionName == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(solution.ParentSolutionName)
+}
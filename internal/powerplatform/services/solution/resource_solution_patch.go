@@ -0,0 +1,270 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+var _ resource.Resource = &SolutionPatchResource{}
+var _ resource.ResourceWithImportState = &SolutionPatchResource{}
+
+func NewSolutionPatchResource() resource.Resource {
+	return &SolutionPatchResource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_solution_patch",
+	}
+}
+
+type SolutionPatchResource struct {
+	SolutionClient   SolutionClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+type SolutionPatchResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	EnvironmentId      types.String `tfsdk:"environment_id"`
+	ParentSolutionName types.String `tfsdk:"parent_solution_name"`
+	DisplayName        types.String `tfsdk:"display_name"`
+	SolutionVersion    types.String `tfsdk:"solution_version"`
+	SolutionId         types.String `tfsdk:"solution_id"`
+	SolutionName       types.String `tfsdk:"solution_name"`
+	IsManaged          types.Bool   `tfsdk:"is_managed"`
+}
+
+func (r *SolutionPatchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.TypeName
+}
+
+func (r *SolutionPatchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Resource for creating a patch of an unmanaged solution in a Power Platform environment",
+		MarkdownDescription: "Resource for creating a [patch](https://learn.microsoft.com/power-platform/alm/create-patches-simplify-solution-updates) of an unmanaged solution in a Power Platform development environment. This is the equivalent of the [`pac solution clone-as-patch`](https://learn.microsoft.com/power-platform/developer/cli/reference/solution) command in the Power Platform CLI. Patches are rolled up into a new version of the parent solution with the `powerplatform_solution_rollup` resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the solution patch",
+				Description:         "Unique identifier of the solution patch",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment where the parent solution is located",
+				Description:         "Id of the environment where the parent solution is located",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent_solution_name": schema.StringAttribute{
+				MarkdownDescription: "Unique name of the unmanaged parent solution",
+				Description:         "Unique name of the unmanaged parent solution",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the solution patch",
+				Description:         "Display name of the solution patch",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"solution_version": schema.StringAttribute{
+				MarkdownDescription: "Version of the solution patch. Major and minor version have to match the parent solution, while the build or revision number has to be greater than the parent solution version",
+				Description:         "Version of the solution patch. Major and minor version have to match the parent solution, while the build or revision number has to be greater than the parent solution version",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(powerplatform_helpers.VersionRegex), "solution_version must be in the format 'major.minor.build.revision'"),
+				},
+			},
+			"solution_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the solution patch",
+				Description:         "Id of the solution patch",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"solution_name": schema.StringAttribute{
+				MarkdownDescription: "Unique name of the solution patch generated by Dataverse",
+				Description:         "Unique name of the solution patch generated by Dataverse",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_managed": schema.BoolAttribute{
+				MarkdownDescription: "Indicates whether the solution patch is managed or not",
+				Description:         "Indicates whether the solution patch is managed or not",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *SolutionPatchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientApi := req.ProviderData.(*api.ProviderClient).Api
+
+	if clientApi == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.SolutionClient = NewSolutionClient(clientApi)
+}
+
+func (r *SolutionPatchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *SolutionPatchResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patch, err := r.SolutionClient.CloneAsPatch(ctx, plan.EnvironmentId.ValueString(), CloneSolutionDto{
+		ParentSolutionUniqueName: plan.ParentSolutionName.ValueString(),
+		DisplayName:              plan.DisplayName.ValueString(),
+		VersionNumber:            plan.SolutionVersion.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating patch of solution %s", plan.ParentSolutionName.ValueString()), err.Error())
+		return
+	}
+
+	convertFromSolutionPatchDto(plan, patch)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *SolutionPatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *SolutionPatchResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patch, err := r.SolutionClient.GetSolutionById(ctx, state.EnvironmentId.ValueString(), state.SolutionId.ValueString())
+	if err != nil {
+		if powerplatform_helpers.Code(err) == powerplatform_helpers.ERROR_OBJECT_NOT_FOUND {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.ProviderTypeName), err.Error())
+		return
+	}
+
+	convertFromSolutionPatchDto(state, patch)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *SolutionPatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// all configurable attributes require replacement, so there is nothing to update in place
+	var plan *SolutionPatchResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *SolutionPatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *SolutionPatchResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.SolutionClient.DeleteSolution(ctx, state.EnvironmentId.ValueString(), state.SolutionName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *SolutionPatchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// import id format: <environment_id>_<solution_id>
+	environmentId, solutionId, found := strings.Cut(req.ID, "_")
+	if !found {
+		resp.Diagnostics.AddError("Invalid import id", fmt.Sprintf("Expected import id in format '<environment_id>_<solution_id>', got: '%s'", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("solution_id"), solutionId)...)
+}
+
+func convertFromSolutionPatchDto(model *SolutionPatchResourceModel, patch *SolutionDto) {
+	model.Id = types.StringValue(fmt.Sprintf("%s_%s", model.EnvironmentId.ValueString(), patch.Id))
+	model.SolutionId = types.StringValue(patch.Id)
+	model.SolutionName = types.StringValue(patch.Name)
+	model.IsManaged = types.BoolValue(patch.IsManaged)
+
+	//configured values are kept as they are, the values of the patch are only read when the patch is imported
+	if model.ParentSolutionName.IsNull() {
+		model.ParentSolutionName = types.StringValue(patch.ParentSolutionName)
+	}
+	if model.DisplayName.IsNull() {
+		model.DisplayName = types.StringValue(patch.DisplayName)
+	}
+	if model.SolutionVersion.IsNull() {
+		model.SolutionVersion = types.StringValue(patch.Version)
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerplatform
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/api"
+	powerplatform_helpers "github.com/microsoft/terraform-provider-power-platform/internal/powerplatform/helpers"
+)
+
+var _ resource.Resource = &SolutionRollupResource{}
+
+func NewSolutionRollupResource() resource.Resource {
+	return &SolutionRollupResource{
+		ProviderTypeName: "powerplatform",
+		TypeName:         "_solution_rollup",
+	}
+}
+
+type SolutionRollupResource struct {
+	SolutionClient   SolutionClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+type SolutionRollupResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	EnvironmentId      types.String `tfsdk:"environment_id"`
+	ParentSolutionName types.String `tfsdk:"parent_solution_name"`
+	DisplayName        types.String `tfsdk:"display_name"`
+	SolutionVersion    types.String `tfsdk:"solution_version"`
+	SolutionId         types.String `tfsdk:"solution_id"`
+}
+
+func (r *SolutionRollupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.TypeName
+}
+
+func (r *SolutionRollupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Resource for rolling up all patches of an unmanaged solution into a new version of the solution",
+		MarkdownDescription: "Resource for rolling up all [patches](https://learn.microsoft.com/power-platform/alm/create-patches-simplify-solution-updates) of an unmanaged solution into a new version of the parent solution. This is the equivalent of the [`pac solution clone`](https://learn.microsoft.com/power-platform/developer/cli/reference/solution) operation using `CloneAsSolution`. Dataverse deletes the rolled up patches, so any `powerplatform_solution_patch` resources of the parent solution should be removed from the configuration in the same change. Destroying this resource does not revert the parent solution to its previous version.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the solution rollup",
+				Description:         "Unique identifier of the solution rollup",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment where the parent solution is located",
+				Description:         "Id of the environment where the parent solution is located",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent_solution_name": schema.StringAttribute{
+				MarkdownDescription: "Unique name of the unmanaged parent solution",
+				Description:         "Unique name of the unmanaged parent solution",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the new version of the parent solution",
+				Description:         "Display name of the new version of the parent solution",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"solution_version": schema.StringAttribute{
+				MarkdownDescription: "New version of the parent solution. The major or minor version has to be greater than the current version of the parent solution",
+				Description:         "New version of the parent solution. The major or minor version has to be greater than the current version of the parent solution",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(powerplatform_helpers.VersionRegex), "solution_version must be in the format 'major.minor.build.revision'"),
+				},
+			},
+			"solution_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the parent solution",
+				Description:         "Id of the parent solution",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SolutionRollupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientApi := req.ProviderData.(*api.ProviderClient).Api
+
+	if clientApi == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.SolutionClient = NewSolutionClient(clientApi)
+}
+
+func (r *SolutionRollupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *SolutionRollupResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	solution, err := r.SolutionClient.CloneAsSolution(ctx, plan.EnvironmentId.ValueString(), CloneSolutionDto{
+		ParentSolutionUniqueName: plan.ParentSolutionName.ValueString(),
+		DisplayName:              plan.DisplayName.ValueString(),
+		VersionNumber:            plan.SolutionVersion.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when rolling up patches of solution %s", plan.ParentSolutionName.ValueString()), err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s_%s", plan.EnvironmentId.ValueString(), solution.Id))
+	plan.SolutionId = types.StringValue(solution.Id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *SolutionRollupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *SolutionRollupResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.SolutionClient.GetSolutionById(ctx, state.EnvironmentId.ValueString(), state.SolutionId.ValueString())
+	if err != nil {
+		if powerplatform_helpers.Code(err) == powerplatform_helpers.ERROR_OBJECT_NOT_FOUND {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.ProviderTypeName), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *SolutionRollupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// all configurable attributes require replacement, so there is nothing to update in place
+	var plan *SolutionRollupResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE START: %s", r.ProviderTypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("UPDATE RESOURCE END: %s", r.ProviderTypeName))
+}
+
+func (r *SolutionRollupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE START: %s", r.ProviderTypeName))
+
+	// a rolled up solution version cannot be reverted, the parent solution is left as is
+	resp.Diagnostics.AddWarning("Solution rollup is not reverted", "Destroying a solution rollup only removes it from the Terraform state. The parent solution keeps the rolled up version and the deleted patches are not restored.")
+
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.ProviderTypeName))
+}